@@ -1,8 +1,12 @@
 package godateparser
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
+
+	"github.com/coredds/godateparser/translations"
 )
 
 // Tests for absolute date parsing (ISO 8601, numeric formats, month names)
@@ -55,6 +59,272 @@ func TestParseAbsolute_ISO8601WithTime(t *testing.T) {
 	}
 }
 
+func TestParseAbsolute_ISO8601Separators(t *testing.T) {
+	want := time.Date(2024, 12, 15, 10, 30, 45, 0, time.UTC)
+	inputs := []string{
+		"2024-12-15T10:30:45",
+		"2024-12-15t10:30:45",
+		"2024-12-15 10:30:45",
+		"2024-12-15  10:30:45",
+		"2024-12-15T10:30:45Z",
+		"2024-12-15 10:30:45Z",
+		"2024-12-15 10:30:45 UTC",
+		"2024-12-15T10:30:45 UTC",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			result, err := ParseDate(input, nil)
+			if err != nil {
+				t.Fatalf("ParseDate() error = %v", err)
+			}
+			if !result.Equal(want) {
+				t.Errorf("ParseDate() = %v, want %v", result, want)
+			}
+		})
+	}
+
+	// Offsets behave the same with either separator
+	est := time.FixedZone("", -5*3600)
+	wantOffset := time.Date(2024, 12, 15, 10, 30, 45, 0, est)
+	for _, input := range []string{"2024-12-15T10:30:45-05:00", "2024-12-15 10:30:45-05:00", "2024-12-15 10:30:45 -0500"} {
+		t.Run(input, func(t *testing.T) {
+			result, err := ParseDate(input, nil)
+			if err != nil {
+				t.Fatalf("ParseDate() error = %v", err)
+			}
+			if !result.Equal(wantOffset) {
+				t.Errorf("ParseDate() = %v, want %v", result, wantOffset)
+			}
+		})
+	}
+}
+
+func TestParseAbsolute_ISO8601WithOtherTimes(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"2024-12-31 3pm", time.Date(2024, 12, 31, 15, 0, 0, 0, time.UTC)},
+		{"2024-12-31 at 10:00", time.Date(2024, 12, 31, 10, 0, 0, 0, time.UTC)},
+		{"2024-12-31, 10:00", time.Date(2024, 12, 31, 10, 0, 0, 0, time.UTC)},
+		{"2024-12-31 10:00 PM", time.Date(2024, 12, 31, 22, 0, 0, 0, time.UTC)},
+		{"2024-12-31 at noon", time.Date(2024, 12, 31, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, nil)
+			if err != nil {
+				t.Fatalf("ParseDate() error = %v", err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate() = %v, want %v", result, tt.want)
+			}
+		})
+	}
+
+	t.Run("invalid date", func(t *testing.T) {
+		var invalid *ErrInvalidDate
+		if _, err := ParseDate("2024-02-30 3pm", nil); !errors.As(err, &invalid) {
+			t.Errorf("ParseDate() error = %v, want ErrInvalidDate", err)
+		}
+	})
+}
+
+func TestParseAbsolute_ISO8601FractionalSeconds(t *testing.T) {
+	tests := []struct {
+		fraction  string
+		wantNanos int
+	}{
+		{"1", 100000000},
+		{"12", 120000000},
+		{"123", 123000000},
+		{"1234", 123400000},
+		{"12345", 123450000},
+		{"123456", 123456000},
+		{"1234567", 123456700},
+		{"12345678", 123456780},
+		{"123456789", 123456789},
+	}
+
+	for _, tt := range tests {
+		for _, layout := range []string{"2024-12-15T10:30:45.%sZ", "2024-12-15 10:30:45.%s", "2024-12-15T10:30:45,%s"} {
+			input := fmt.Sprintf(layout, tt.fraction)
+			t.Run(input, func(t *testing.T) {
+				result, err := ParseDate(input, nil)
+				if err != nil {
+					t.Fatalf("ParseDate() error = %v", err)
+				}
+				want := time.Date(2024, 12, 15, 10, 30, 45, tt.wantNanos, time.UTC)
+				if !result.Equal(want) {
+					t.Errorf("ParseDate() = %v, want %v", result, want)
+				}
+			})
+		}
+	}
+}
+
+func TestParseAbsolute_CompactISO(t *testing.T) {
+	settings := &Settings{AllowCompactISO: true}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"20241231", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"20240229", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"20241231T153045", time.Date(2024, 12, 31, 15, 30, 45, 0, time.UTC)},
+		{"20241231T1530", time.Date(2024, 12, 31, 15, 30, 0, 0, time.UTC)},
+		{"20241231T153045Z", time.Date(2024, 12, 31, 15, 30, 45, 0, time.UTC)},
+		{"20241231T153045.5", time.Date(2024, 12, 31, 15, 30, 45, 500000000, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("8-digit numbers need the flag", func(t *testing.T) {
+		if result, err := ParseDate("20241231", nil); err == nil {
+			t.Errorf("ParseDate(\"20241231\") = %v, want error without AllowCompactISO", result)
+		}
+	})
+
+	t.Run("date-times do not need the flag", func(t *testing.T) {
+		if _, err := ParseDate("20241231T153045", nil); err != nil {
+			t.Errorf("ParseDate(\"20241231T153045\") error = %v", err)
+		}
+	})
+
+	t.Run("8-digit non-dates are rejected", func(t *testing.T) {
+		for _, input := range []string{"12345678", "20241301", "20240230"} {
+			if result, err := ParseDate(input, settings); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+	})
+}
+
+func TestParseAbsolute_RomanMonths(t *testing.T) {
+	settings := &Settings{AllowRomanMonths: true}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"15.XII.2024", time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC)},
+		{"1.I.2024", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"3-IV-2024", time.Date(2024, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{"9 ix 2024", time.Date(2024, 9, 9, 0, 0, 0, 0, time.UTC)},
+		{"15. XII. 2024", time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC)},
+		{"15.XII.24", time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("month past XII", func(t *testing.T) {
+		_, err := ParseDate("15.XIII.2024", settings)
+		var invalid *ErrInvalidDate
+		if !errors.As(err, &invalid) {
+			t.Fatalf("ParseDate(\"15.XIII.2024\") error = %v, want ErrInvalidDate", err)
+		}
+		if invalid.Month != 13 {
+			t.Errorf("ErrInvalidDate.Month = %d, want 13", invalid.Month)
+		}
+		if invalid.Fragment != "XIII" || invalid.Position != 3 {
+			t.Errorf("ErrInvalidDate Fragment, Position = %q, %d, want \"XIII\", 3", invalid.Fragment, invalid.Position)
+		}
+
+		// The numeral is located even when the day has the same value
+		_, err = ParseDate("13.XIII.2024", settings)
+		if !errors.As(err, &invalid) || invalid.Fragment != "XIII" || invalid.Position != 3 {
+			t.Errorf("ParseDate(\"13.XIII.2024\") error = %v, want XIII located at 3", err)
+		}
+	})
+
+	t.Run("single letters need punctuation", func(t *testing.T) {
+		for _, input := range []string{"1 V 2024", "5 I 2024", "3 x 2024", "1 V. 2024"} {
+			if result, err := ParseDate(input, settings); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+		for _, text := range []string{"code 1 V 2024", "room 5 I 2024 rules", "see 3 x 2024"} {
+			results, err := ExtractDates(text, settings)
+			if err != nil {
+				t.Fatalf("ExtractDates(%q) error = %v", text, err)
+			}
+			if len(results) != 0 {
+				t.Errorf("ExtractDates(%q) = %+v, want no dates", text, results)
+			}
+		}
+		if result, err := ParseDate("1.V.2024", settings); err != nil || !result.Equal(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("ParseDate(\"1.V.2024\") = %v, %v, want May 1", result, err)
+		}
+	})
+
+	t.Run("malformed numerals are rejected", func(t *testing.T) {
+		for _, input := range []string{"15.IIII.2024", "15.VX.2024", "15.IC.2024"} {
+			if result, err := ParseDate(input, settings); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+	})
+
+	t.Run("needs the flag", func(t *testing.T) {
+		if result, err := ParseDate("15.XII.2024", nil); err == nil {
+			t.Errorf("ParseDate(\"15.XII.2024\") = %v, want error without AllowRomanMonths", result)
+		}
+	})
+
+	t.Run("extraction", func(t *testing.T) {
+		results, err := ExtractDates("Signed in Warsaw on 15.XII.2024 by both parties.", settings)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 1 || results[0].MatchedText != "15.XII.2024" {
+			t.Fatalf("ExtractDates() = %+v, want 15.XII.2024", results)
+		}
+		if !results[0].Date.Equal(tests[0].want) {
+			t.Errorf("ExtractDates() date = %v, want %v", results[0].Date, tests[0].want)
+		}
+	})
+}
+
+func TestExtractDates_ISO8601WithFractionAndZone(t *testing.T) {
+	text := "2024-12-15T10:30:45.123456Z [INFO] started; 2024-12-15 10:31:00,5 done"
+	results, err := ExtractDates(text, nil)
+	if err != nil {
+		t.Fatalf("ExtractDates() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("ExtractDates() found %d dates, want 2", len(results))
+	}
+	if results[0].MatchedText != "2024-12-15T10:30:45.123456Z" {
+		t.Errorf("first match = %q, want full ISO timestamp", results[0].MatchedText)
+	}
+	if results[0].Date.Nanosecond() != 123456000 {
+		t.Errorf("first match nanos = %d, want 123456000", results[0].Date.Nanosecond())
+	}
+}
+
 func TestParseAbsolute_TwoDigitYear(t *testing.T) {
 	tests := []struct {
 		input string
@@ -229,6 +499,50 @@ func TestParseAbsolute_LeapYear(t *testing.T) {
 	}
 }
 
+func TestParseAbsolute_ImpossibleDates(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		langs []string
+	}{
+		{"feb 30 iso", "2024-02-30", nil},
+		{"april 31 iso", "2024-04-31", nil},
+		{"non-leap feb 29 iso", "2023-02-29", nil},
+		{"iso datetime", "2023-02-29T10:00:00", nil},
+		{"two-digit year", "24-02-30", nil},
+		{"numeric", "04/31/2024", nil},
+		{"month name first", "April 31, 2024", nil},
+		{"day first", "31 April 2024", nil},
+		{"ordinal suffix", "February 30th, 2024", nil},
+		{"incomplete date", "Feb 30", nil},
+		{"spanish", "31 de abril de 2024", []string{"es"}},
+		{"french", "30 février 2024", []string{"fr"}},
+		{"cjk", "2023年2月29日", []string{"ja"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{Languages: tt.langs})
+			var invalid *ErrInvalidDate
+			if !errors.As(err, &invalid) {
+				t.Fatalf("ParseDate(%q) = %v, %v; want ErrInvalidDate", tt.input, result, err)
+			}
+			if invalid.Input != tt.input {
+				t.Errorf("ErrInvalidDate.Input = %q, want %q", invalid.Input, tt.input)
+			}
+		})
+	}
+
+	// The leap day itself is valid
+	result, err := ParseDate("2024-02-29", nil)
+	if err != nil {
+		t.Fatalf("ParseDate(\"2024-02-29\") error = %v", err)
+	}
+	if result.Month() != time.February || result.Day() != 29 {
+		t.Errorf("ParseDate(\"2024-02-29\") = %v, want 2024-02-29", result)
+	}
+}
+
 func BenchmarkParseAbsolute_ISO8601(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		_, _ = ParseDate("2024-12-31", nil)
@@ -247,3 +561,430 @@ func BenchmarkParseAbsolute_MonthName(b *testing.B) {
 		_, _ = ParseDate("December 31, 2024", nil)
 	}
 }
+
+func TestParseAbsolute_OrdinalSuffixes(t *testing.T) {
+	tests := []struct {
+		input string
+		langs []string
+		want  time.Time
+	}{
+		{"March 1st, 2024", []string{"en"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"March 1st 2024", []string{"en"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"Dec 21st, 2024", []string{"en"}, time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC)},
+		{"Dec 22nd, 2024", []string{"en"}, time.Date(2024, 12, 22, 0, 0, 0, 0, time.UTC)},
+		{"Dec 23rd, 2024", []string{"en"}, time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC)},
+		{"Dec 31st, 2024", []string{"en"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"31st Dec 2024", []string{"en"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"1st/2/2024", []string{"en"}, time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)},
+		{"1er mars 2024", []string{"fr"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"1º de marzo de 2024", []string{"es"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"1º de março de 2024", []string{"pt"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"1° marzo 2024", []string{"it"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"1. März 2024", []string{"de"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"1e maart 2024", []string{"nl"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"1-го марта 2024", []string{"ru"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{Languages: tt.langs})
+			if err != nil {
+				t.Fatalf("ParseDate() error = %v", err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate() = %v, want %v", result, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseAbsolute_MonthNameCommaOptional(t *testing.T) {
+	want := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		input string
+		langs []string
+	}{
+		{"December 31 2024", []string{"en"}},
+		{"December 31, 2024", []string{"en"}},
+		{"Dec 31 2024", []string{"en"}},
+		{"31 December 2024", []string{"en"}},
+		{"31 December, 2024", []string{"en"}},
+		{"31 Dec 2024", []string{"en"}},
+		{"diciembre 31 2024", []string{"es"}},
+		{"31 diciembre, 2024", []string{"es"}},
+		{"31 décembre 2024", []string{"fr"}},
+		{"Dezember 31, 2024", []string{"de"}},
+		{"31 dicembre 2024", []string{"it"}},
+		{"31 december 2024", []string{"nl"}},
+	}
+
+	// The month name anchors day and year, so every DateOrder gives the same result
+	for _, order := range []string{"MDY", "DMY", "YMD"} {
+		for _, tt := range tests {
+			t.Run(order+"/"+tt.input, func(t *testing.T) {
+				result, err := ParseDate(tt.input, &Settings{DateOrder: order, Languages: tt.langs})
+				if err != nil {
+					t.Fatalf("ParseDate() error = %v", err)
+				}
+				if !result.Equal(want) {
+					t.Errorf("ParseDate() = %v, want %v", result, want)
+				}
+			})
+		}
+	}
+}
+
+func TestParseAbsolute_MonthNameRequiresSeparator(t *testing.T) {
+	for _, input := range []string{"Dec 312024", "31 Dec2024x"} {
+		if result, err := ParseDate(input, nil); err == nil {
+			t.Errorf("ParseDate(%q) = %v, want error", input, result)
+		}
+	}
+}
+
+func TestStripOrdinalSuffixes_KeepsNumericDates(t *testing.T) {
+	// German uses "." as ordinal marker; dotted dates must survive untouched
+	langs := []*translations.Language{translations.GetLanguage("de")}
+	for _, input := range []string{"31.12.2024", "2024-12-31T10:30:00", "12/31/2024"} {
+		if got := stripOrdinalSuffixes(input, langs); got != input {
+			t.Errorf("stripOrdinalSuffixes(%q) = %q, want unchanged", input, got)
+		}
+	}
+}
+
+func TestParseAbsolute_WeekdayCrossCheck(t *testing.T) {
+	want := time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		langs   []string
+		strict  bool
+		wantErr bool
+	}{
+		{"matching weekday", "Monday, December 30, 2024", nil, true, false},
+		{"abbreviated weekday", "Mon. 30 Dec 2024", nil, true, false},
+		{"numeric date", "Monday 2024-12-30", nil, true, false},
+		{"spanish weekday", "lunes 30 de diciembre de 2024", []string{"es"}, true, false},
+		{"mismatch non-strict", "Tuesday, December 30, 2024", nil, false, false},
+		{"mismatch strict", "Tuesday, December 30, 2024", nil, true, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{Strict: tt.strict, Languages: tt.langs})
+			if tt.wantErr {
+				var invalid *ErrInvalidDate
+				if !errors.As(err, &invalid) {
+					t.Fatalf("ParseDate(%q) error = %v, want ErrInvalidDate", tt.input, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, want)
+			}
+		})
+	}
+}
+
+func TestExtractDates_WeekdayMismatchLowersConfidence(t *testing.T) {
+	results, err := ExtractDates("Held Monday, December 30, 2024 and Friday, December 30, 2024", nil)
+	if err != nil {
+		t.Fatalf("ExtractDates() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("ExtractDates() found %d dates, want 2", len(results))
+	}
+	if results[0].MatchedText != "Monday, December 30, 2024" {
+		t.Errorf("MatchedText = %q, want weekday included", results[0].MatchedText)
+	}
+	if results[1].Confidence >= results[0].Confidence {
+		t.Errorf("mismatched weekday confidence %v should be below %v", results[1].Confidence, results[0].Confidence)
+	}
+}
+
+func TestParseAbsolute_YearFirstIgnoresDateOrder(t *testing.T) {
+	dec31 := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input     string
+		dateOrder string
+		want      time.Time
+	}{
+		{"2024/12/31", "MDY", dec31},
+		{"2024/12/31", "DMY", dec31},
+		{"2024.12.31", "MDY", dec31},
+		{"2024.12.31", "DMY", dec31},
+		{"2024/1/5", "DMY", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"12/31/2024", "MDY", dec31},
+		{"31/12/2024", "DMY", dec31},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input+" "+tt.dateOrder, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{DateOrder: tt.dateOrder})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("MDY still rejects day-first", func(t *testing.T) {
+		if _, err := ParseDate("31/12/2024", &Settings{DateOrder: "MDY"}); err == nil {
+			t.Error("expected error for 31/12/2024 under MDY")
+		}
+	})
+
+	t.Run("year-first month is validated", func(t *testing.T) {
+		var invalidErr *ErrInvalidDate
+		if _, err := ParseDate("2024/31/12", &Settings{DateOrder: "DMY"}); !errors.As(err, &invalidErr) {
+			t.Errorf("expected ErrInvalidDate, got %v", err)
+		}
+	})
+
+	t.Run("extracted", func(t *testing.T) {
+		results, err := ExtractDates("Released 2024.12.31 worldwide", nil)
+		if err != nil || len(results) != 1 || !results[0].Date.Equal(dec31) {
+			t.Fatalf("ExtractDates() = %+v, %v", results, err)
+		}
+	})
+}
+
+func TestParseAbsolute_DottedDates(t *testing.T) {
+	dec31 := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		settings *Settings
+		want     time.Time
+	}{
+		{"DMY", "31.12.2024", &Settings{DateOrder: "DMY"}, dec31},
+		{"DMY two-digit year", "31.12.24", &Settings{DateOrder: "DMY"}, dec31},
+		{"DMY single digits", "5.1.2024", &Settings{DateOrder: "DMY"}, time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+		{"German default order", "31.12.2024", &Settings{Languages: []string{"de"}}, dec31},
+		{"Russian default order", "01.02.2024", &Settings{Languages: []string{"ru"}}, time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"MDY", "12.31.2024", &Settings{DateOrder: "MDY"}, dec31},
+		{"YMD leading year", "2024.12.31", &Settings{DateOrder: "YMD"}, dec31},
+		{"leading year under DMY", "2024.12.31", &Settings{DateOrder: "DMY"}, dec31},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, tt.settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("decimal number is not a date", func(t *testing.T) {
+		for _, input := range []string{"3.14", "12.5"} {
+			if result, err := ParseDate(input, &Settings{DateOrder: "DMY"}); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+		results, err := ExtractDates("pi is roughly 3.14 and e is 2.718", &Settings{DateOrder: "DMY"})
+		if err != nil || len(results) != 0 {
+			t.Errorf("ExtractDates() = %+v, %v, want no dates", results, err)
+		}
+	})
+
+	t.Run("separators must match", func(t *testing.T) {
+		for _, input := range []string{"12/31-2024", "31.12/2024", "31-12.2024", "1.2/24", "12-31/24"} {
+			if result, err := ParseDate(input, &Settings{DateOrder: "DMY"}); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+		results, err := ExtractDates("see 31.12/2024 and 12/31-2024", &Settings{DateOrder: "DMY"})
+		if err != nil || len(results) != 0 {
+			t.Errorf("ExtractDates() = %+v, %v, want no dates", results, err)
+		}
+	})
+
+	t.Run("version number is not a date", func(t *testing.T) {
+		for _, input := range []string{"1.2.24", "2.10.13", "10.1.24"} {
+			if result, err := ParseDate(input, &Settings{DateOrder: "DMY"}); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+		want := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+		if result, err := ParseDate("01.02.24", &Settings{DateOrder: "DMY"}); err != nil || !result.Equal(want) {
+			t.Errorf("ParseDate(%q) = %v, %v, want %v", "01.02.24", result, err, want)
+		}
+	})
+
+	t.Run("extracted", func(t *testing.T) {
+		results, err := ExtractDates("Frist: 31.12.2024.", &Settings{Languages: []string{"de"}})
+		if err != nil || len(results) != 1 || !results[0].Date.Equal(dec31) || results[0].MatchedText != "31.12.2024" {
+			t.Fatalf("ExtractDates() = %+v, %v", results, err)
+		}
+	})
+}
+
+func TestParseAbsolute_LeapSecond(t *testing.T) {
+	base := time.Date(2016, 12, 31, 12, 0, 0, 0, time.UTC)
+	settings := &Settings{AllowLeapSecond: true, RelativeBase: base}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"2016-12-31T23:59:60Z", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2016-12-31 23:59:60", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2016-12-31T23:59:60.5Z", time.Date(2017, 1, 1, 0, 0, 0, 500000000, time.UTC)},
+		{"2015-06-30T23:59:60Z", time.Date(2015, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"23:59:60", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"11:59:60 PM", time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("rejected without the flag", func(t *testing.T) {
+		_, err := ParseDate("2016-12-31T23:59:60Z", &Settings{RelativeBase: base})
+		var invalidErr *ErrInvalidDate
+		if !errors.As(err, &invalidErr) {
+			t.Errorf("ParseDate(\"2016-12-31T23:59:60Z\") error = %v, want ErrInvalidDate", err)
+		}
+	})
+
+	t.Run("seconds past 60 are still rejected", func(t *testing.T) {
+		if result, err := ParseDate("2016-12-31T23:59:61Z", settings); err == nil {
+			t.Errorf("ParseDate(\"2016-12-31T23:59:61Z\") = %v, want error", result)
+		}
+	})
+
+	t.Run("extraction flags the leap second", func(t *testing.T) {
+		results, err := ExtractDates("The leap second 2016-12-31T23:59:60Z was inserted", settings)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("ExtractDates() found %d dates, want 1", len(results))
+		}
+		if !results[0].LeapSecond {
+			t.Errorf("LeapSecond = false, want true for %q", results[0].MatchedText)
+		}
+		if want := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC); !results[0].Date.Equal(want) {
+			t.Errorf("Date = %v, want %v", results[0].Date, want)
+		}
+	})
+}
+
+func TestParseAbsolute_ExtendedHours(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := &Settings{AllowExtendedHours: true, RelativeBase: base}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"24:00", time.Date(2024, 10, 16, 0, 0, 0, 0, time.UTC)},
+		{"26:15", time.Date(2024, 10, 16, 2, 15, 0, 0, time.UTC)},
+		{"25:30:15", time.Date(2024, 10, 16, 1, 30, 15, 0, time.UTC)},
+		{"47:59", time.Date(2024, 10, 16, 23, 59, 0, 0, time.UTC)},
+		{"23:59", time.Date(2024, 10, 15, 23, 59, 0, 0, time.UTC)},
+		{"2024-12-31T24:00:00", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"2024-12-31 26:15", time.Date(2025, 1, 1, 2, 15, 0, 0, time.UTC)},
+		{"tomorrow at 25:30", time.Date(2024, 10, 17, 1, 30, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	for _, input := range []string{"24:00", "26:15", "2024-12-31T24:00:00"} {
+		t.Run("rejected without the flag "+input, func(t *testing.T) {
+			_, err := ParseDate(input, &Settings{RelativeBase: base})
+			var invalidErr *ErrInvalidDate
+			if !errors.As(err, &invalidErr) {
+				t.Errorf("ParseDate(%q) error = %v, want ErrInvalidDate", input, err)
+			}
+		})
+	}
+
+	t.Run("hours past 47 are still rejected", func(t *testing.T) {
+		_, err := ParseDate("48:00", settings)
+		var invalidErr *ErrInvalidDate
+		if !errors.As(err, &invalidErr) {
+			t.Errorf("ParseDate(\"48:00\") error = %v, want ErrInvalidDate", err)
+		}
+	})
+}
+
+func TestParseAbsolute_AbbreviationPeriods(t *testing.T) {
+	base := time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input     string
+		languages []string
+		want      time.Time
+	}{
+		{"Dec. 31, 2024", nil, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"31 Dec. 2024", nil, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"Sept. 5, 2024", nil, time.Date(2024, 9, 5, 0, 0, 0, 0, time.UTC)},
+		{"Mon., Dec. 30, 2024", nil, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{"Dec.", nil, time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{"next Mon.", nil, time.Date(2024, 10, 21, 9, 0, 0, 0, time.UTC)},
+		{"31 dic. 2024", []string{"es"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"31 de dic. de 2024", []string{"es"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"lun. 30 déc. 2024", []string{"fr"}, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{"31. Dez. 2024", []string{"de"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"31.12.2024", []string{"de"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, Languages: tt.languages})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("extraction", func(t *testing.T) {
+		results, err := ExtractDates("Due Dec. 31, 2024 or Mon., Dec. 30, 2024, billed Sept. 2024.", nil)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		want := []string{"Dec. 31, 2024", "Mon., Dec. 30, 2024", "Sept. 2024"}
+		if len(results) != len(want) {
+			t.Fatalf("ExtractDates() found %d dates, want %d", len(results), len(want))
+		}
+		for i, w := range want {
+			if results[i].MatchedText != w {
+				t.Errorf("results[%d].MatchedText = %q, want %q", i, results[i].MatchedText, w)
+			}
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package godateparser
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/coredds/godateparser/translations"
+)
+
+// Age patterns (English)
+// Examples: "34 years old", "thirty-four year-old", "aged 34", "born on 1990-06-15"
+
+var (
+	// "34 years old", "a 34-year-old", "thirty four yrs old"
+	ageYearsOldPattern = regexp.MustCompile(`(?i)\b(\d{1,3}|[a-z]+(?:[\s-][a-z]+)?)[\s-]+(?:years?|yrs?)[\s-]+old\b`)
+
+	// "aged 34", "age 34", "age: 34"
+	ageLeadPattern = regexp.MustCompile(`(?i)\bage(?:d\s+|\s*:\s*|\s+)(\d{1,3})\b`)
+
+	// "born 1990", "born on 1990-06-15", "born on June 15, 1990": the date
+	// runs to the next comma not followed by a year, semicolon or parenthesis
+	ageBornPattern = regexp.MustCompile(`(?i)\bborn(?:\s+(?:on|in))?\s+([^,;()]+(?:,\s*\d{4}\b)?)`)
+)
+
+// ParseAge returns the age in years stated in input, as in "34 years old" or
+// "aged 34", or else computed from a birthdate ("born on 1990-06-15") at
+// RelativeBase. A stated age wins over a birthdate: "born 1990, 34 years
+// old" is 34. Ages may be spelled out in the configured languages' number
+// words ("thirty-four years old"); the phrases themselves are English.
+// If opts is nil, DefaultSettings() is used.
+func ParseAge(input string, opts *Settings) (years int, ok bool) {
+	if isBlank(input) {
+		return 0, false
+	}
+
+	if opts == nil {
+		opts = DefaultSettings()
+	}
+
+	settings := normalizeSettings(opts)
+
+	if err := checkInputLength(input, settings); err != nil {
+		return 0, false
+	}
+
+	input = normalizeCharacters(input, settings)
+	ctx := &parserContext{
+		input:     input,
+		settings:  settings,
+		languages: translations.GlobalRegistry.GetMultiple(settings.Languages),
+	}
+
+	for _, m := range ageYearsOldPattern.FindAllStringSubmatch(input, -1) {
+		if age, ok := parseStatedAge(ctx, m[1]); ok {
+			return age, true
+		}
+	}
+	if m := ageLeadPattern.FindStringSubmatch(input); m != nil {
+		age, err := strconv.Atoi(m[1])
+		return age, err == nil
+	}
+
+	if m := ageBornPattern.FindStringSubmatch(input); m != nil {
+		text := strings.TrimRight(strings.TrimSpace(m[1]), ".")
+		birth, err := parseDate(text, opts, nil)
+		if err != nil {
+			return 0, false
+		}
+		return ageAt(birth, settings.RelativeBase)
+	}
+
+	return 0, false
+}
+
+// parseStatedAge parses the number before "years old": digits or number
+// words. The pattern may take in a word before a spelled-out number ("am
+// thirty"), so the last word is tried on its own too.
+func parseStatedAge(ctx *parserContext, s string) (int, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, true
+	}
+	candidates := []string{s}
+	if _, last, found := strings.Cut(strings.ReplaceAll(s, "-", " "), " "); found {
+		candidates = append(candidates, last)
+	}
+	for _, candidate := range candidates {
+		for _, lang := range ctx.languages {
+			if n, ok := translations.ParseNumberWord(candidate, lang); ok && n > 0 {
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// ageAt returns the number of full years from birth to at, counting a
+// birthday as reached on its calendar date. It reports ok == false if birth
+// is after at.
+func ageAt(birth, at time.Time) (int, bool) {
+	at = at.In(birth.Location())
+	if at.Before(birth) {
+		return 0, false
+	}
+	years := at.Year() - birth.Year()
+	if at.Month() < birth.Month() || at.Month() == birth.Month() && at.Day() < birth.Day() {
+		years--
+	}
+	return years, true
+}
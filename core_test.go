@@ -1,9 +1,13 @@
 package godateparser
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
+	"unicode/utf8"
 )
 
 // Tests for core functionality (API, settings, error handling, validation, extraction)
@@ -65,6 +69,47 @@ func TestParseDate_EmptyInput(t *testing.T) {
 	}
 }
 
+func TestBlankInput(t *testing.T) {
+	inputs := map[string]string{
+		"spaces":           "   ",
+		"tab":              "\t",
+		"newlines":         "\n\r\n",
+		"mixed whitespace": " \t\n ",
+		"no-break space":   "\u00a0",
+		"control":          "\x00\x07",
+		"zero-width space": "\u200b",
+		"byte order mark":  "\ufeff ",
+	}
+
+	for name, input := range inputs {
+		t.Run(name, func(t *testing.T) {
+			var emptyErr *ErrEmptyInput
+			if _, err := ParseDate(input, nil); !errors.As(err, &emptyErr) {
+				t.Errorf("ParseDate(%q) error = %v, want ErrEmptyInput", input, err)
+			}
+			if _, err := ExtractDates(input, nil); !errors.As(err, &emptyErr) {
+				t.Errorf("ExtractDates(%q) error = %v, want ErrEmptyInput", input, err)
+			}
+			if _, err := ParseDateRange(input, nil); !errors.As(err, &emptyErr) {
+				t.Errorf("ParseDateRange(%q) error = %v, want ErrEmptyInput", input, err)
+			}
+			if _, _, err := ParseTimeRange(input, nil); !errors.As(err, &emptyErr) {
+				t.Errorf("ParseTimeRange(%q) error = %v, want ErrEmptyInput", input, err)
+			}
+		})
+	}
+
+	t.Run("surrounding whitespace still parses", func(t *testing.T) {
+		result, err := ParseDate("\t 2024-12-31\n", nil)
+		if err != nil {
+			t.Fatalf("ParseDate() error = %v", err)
+		}
+		if want := time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC); !result.Equal(want) {
+			t.Errorf("ParseDate() = %v, want %v", result, want)
+		}
+	})
+}
+
 func TestParseDate_InvalidFormat(t *testing.T) {
 	_, err := ParseDate("not a date at all", nil)
 	if err == nil {
@@ -273,6 +318,71 @@ func TestExtractDates_MultipleOccurrences(t *testing.T) {
 	}
 }
 
+func TestExtractDates_PositionOrder(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := DefaultSettings()
+	settings.RelativeBase = base
+
+	inputs := []string{
+		// Later patterns in extractionPatterns appear earlier in the text
+		"Call me tomorrow, then on 2024-12-31 and again 3 days ago.",
+		"Q3 2024 closed; 1700000000 was logged before 12/31/2024 at noon.",
+		// A date list among other dates
+		"Visits on Dec 1, 2 and 3, then 2025-01-15, then next March.",
+		"Started 2 weeks ago, released December 31, 2024 at 3:30 PM PST, patched 2025-01-02T08:00:00Z.",
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			first, err := ExtractDates(input, settings)
+			if err != nil {
+				t.Fatalf("ExtractDates() error = %v", err)
+			}
+			if len(first) < 3 {
+				t.Fatalf("ExtractDates() found %d dates, want at least 3", len(first))
+			}
+			for i := 1; i < len(first); i++ {
+				if first[i].Position <= first[i-1].Position {
+					t.Errorf("result %d %q at %d does not follow %q at %d",
+						i, first[i].MatchedText, first[i].Position, first[i-1].MatchedText, first[i-1].Position)
+				}
+			}
+
+			// Repeated scans return the same dates in the same order
+			for run := 0; run < 5; run++ {
+				again, err := ExtractDates(input, settings)
+				if err != nil {
+					t.Fatalf("ExtractDates() error = %v", err)
+				}
+				if len(again) != len(first) {
+					t.Fatalf("run %d found %d dates, want %d", run, len(again), len(first))
+				}
+				for i := range first {
+					if again[i].Position != first[i].Position || again[i].MatchedText != first[i].MatchedText {
+						t.Fatalf("run %d result %d = %q at %d, want %q at %d",
+							run, i, again[i].MatchedText, again[i].Position, first[i].MatchedText, first[i].Position)
+					}
+				}
+			}
+		})
+	}
+
+	t.Run("ties put the longest match first", func(t *testing.T) {
+		dates := []ParsedDate{
+			{Position: 10, Length: 4, MatchedText: "2024"},
+			{Position: 0, Length: 3, MatchedText: "Dec"},
+			{Position: 10, Length: 10, MatchedText: "2024-12-31"},
+		}
+		sortByPosition(dates)
+		want := []string{"Dec", "2024-12-31", "2024"}
+		for i, w := range want {
+			if dates[i].MatchedText != w {
+				t.Errorf("dates[%d] = %q, want %q", i, dates[i].MatchedText, w)
+			}
+		}
+	})
+}
+
 func TestExtractDates_NoDates(t *testing.T) {
 	text := "This text has no dates in it at all."
 	results, err := ExtractDates(text, nil)
@@ -297,6 +407,1035 @@ func TestExtractDates_VariousFormats(t *testing.T) {
 	}
 }
 
+func TestExtractDates_AmbiguousNumeric(t *testing.T) {
+	tests := []struct {
+		text          string
+		dateOrder     string
+		wantAmbiguous bool
+	}{
+		{"Due 03/04/2024", "DMY", true},
+		{"Due 03/04/2024", "MDY", true},
+		{"Due 25/04/2024", "DMY", false},
+		{"Due 04/25/2024", "MDY", false},
+		{"Due 04/04/2024", "DMY", false},
+		{"Due 2024-03-04", "DMY", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.dateOrder+"/"+tt.text, func(t *testing.T) {
+			results, err := ExtractDates(tt.text, &Settings{DateOrder: tt.dateOrder})
+			if err != nil {
+				t.Fatalf("ExtractDates() error = %v", err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("ExtractDates() found %d dates, want 1", len(results))
+			}
+			got := results[0]
+			if got.Ambiguous != tt.wantAmbiguous {
+				t.Errorf("Ambiguous = %v, want %v", got.Ambiguous, tt.wantAmbiguous)
+			}
+			if tt.wantAmbiguous && got.Confidence >= calculateConfidence(got.MatchedText) {
+				t.Errorf("Confidence = %v, want lower than unambiguous %v", got.Confidence, calculateConfidence(got.MatchedText))
+			}
+		})
+	}
+}
+
+func TestExtractDates_InferOrderFromSiblings(t *testing.T) {
+	date := func(month time.Month, day int) time.Time { return time.Date(2024, month, day, 0, 0, 0, 0, time.UTC) }
+
+	tests := []struct {
+		name          string
+		text          string
+		dateOrder     string
+		want          []time.Time
+		wantAmbiguous bool
+	}{
+		{"DMY sibling", "Arrive 03/04/2024, leave 05/06/2024, invoice 15/06/2024", "MDY",
+			[]time.Time{date(time.April, 3), date(time.June, 5), date(time.June, 15)}, false},
+		{"MDY sibling", "Arrive 03/04/2024, leave 05/06/2024, invoice 06/15/2024", "DMY",
+			[]time.Time{date(time.March, 4), date(time.May, 6), date(time.June, 15)}, false},
+		{"sibling before", "Invoice 31.12.2024 paid 02.01.2024", "MDY",
+			[]time.Time{date(time.December, 31), date(time.January, 2)}, false},
+		{"two-digit year sibling", "Arrive 03/04/2024 (booked 15/01/24)", "MDY",
+			[]time.Time{date(time.April, 3)}, false},
+		// "15/06/2024" does not parse as MDY
+		{"siblings disagree", "Arrive 03/04/2024, 15/06/2024 or 06/15/2024", "MDY",
+			[]time.Time{date(time.March, 4), date(time.June, 15)}, true},
+		{"no sibling", "Arrive 03/04/2024, leave 05/06/2024", "DMY",
+			[]time.Time{date(time.April, 3), date(time.June, 5)}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ExtractDates(tt.text, &Settings{DateOrder: tt.dateOrder, InferOrderFromSiblings: true})
+			if err != nil {
+				t.Fatalf("ExtractDates() error = %v", err)
+			}
+			if len(results) != len(tt.want) {
+				t.Fatalf("ExtractDates() = %+v, want %d dates", results, len(tt.want))
+			}
+			for i, want := range tt.want {
+				if !results[i].Date.Equal(want) {
+					t.Errorf("results[%d] (%q) = %v, want %v", i, results[i].MatchedText, results[i].Date, want)
+				}
+			}
+			for i, got := range results {
+				if !isAmbiguousNumericText(got.MatchedText) {
+					continue
+				}
+				if got.Ambiguous != tt.wantAmbiguous {
+					t.Errorf("results[%d].Ambiguous = %v, want %v", i, got.Ambiguous, tt.wantAmbiguous)
+				} else if !got.Ambiguous && got.Confidence >= calculateConfidence(got.MatchedText) {
+					t.Errorf("results[%d].Confidence = %v, want lower than unambiguous %v", i, got.Confidence, calculateConfidence(got.MatchedText))
+				}
+			}
+		})
+	}
+
+	t.Run("yearless siblings", func(t *testing.T) {
+		base := time.Date(2024, 1, 10, 12, 0, 0, 0, time.UTC)
+		text := "Pick 03/04, 05/06 and 15/06, not 3.14 or 2024/12/31"
+		results, err := ExtractDates(text, &Settings{RelativeBase: base, InferOrderFromSiblings: true})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		want := []struct {
+			matched string
+			date    time.Time
+		}{
+			{"03/04", date(time.April, 3)},
+			{"05/06", date(time.June, 5)},
+			{"15/06", date(time.June, 15)},
+			{"2024/12/31", date(time.December, 31)},
+		}
+		if len(results) != len(want) {
+			t.Fatalf("ExtractDates() = %+v, want %d dates", results, len(want))
+		}
+		for i, w := range want {
+			if results[i].MatchedText != w.matched || !results[i].Date.Equal(w.date) {
+				t.Errorf("results[%d] = %q %v, want %q %v", i, results[i].MatchedText, results[i].Date, w.matched, w.date)
+			}
+		}
+
+		// Yearless dates are read only when the setting is on and a sibling proves the order
+		for _, tt := range []struct {
+			input string
+			infer bool
+		}{
+			{"Pick 03/04 and 05/06", true},
+			{"Pick 03/04 and 15/06", false},
+		} {
+			results, err := ExtractDates(tt.input, &Settings{RelativeBase: base, InferOrderFromSiblings: tt.infer})
+			if err != nil || len(results) != 0 {
+				t.Errorf("ExtractDates(%q) = %+v, %v, want no dates", tt.input, results, err)
+			}
+		}
+	})
+
+	t.Run("off by default", func(t *testing.T) {
+		results, err := ExtractDates("Arrive 03/04/2024, invoice 15/06/2024", &Settings{DateOrder: "MDY"})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) == 0 || !results[0].Date.Equal(date(time.March, 4)) || !results[0].Ambiguous {
+			t.Errorf("ExtractDates() = %+v, want an ambiguous March 4 first", results)
+		}
+	})
+}
+
+func TestExtractDates_MergeDateTime(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		text      string
+		languages []string
+		wantText  string
+		want      time.Time
+	}{
+		{"at connector", "Meet on December 31, 2024 at 3:30 PM sharp", nil, "December 31, 2024 at 3:30 PM", time.Date(2024, 12, 31, 15, 30, 0, 0, time.UTC)},
+		{"no connector", "Meet on December 31, 2024 3:30 PM sharp", nil, "December 31, 2024 3:30 PM", time.Date(2024, 12, 31, 15, 30, 0, 0, time.UTC)},
+		{"comma", "Due 12/31/2024, 15:30.", nil, "12/31/2024, 15:30", time.Date(2024, 12, 31, 15, 30, 0, 0, time.UTC)},
+		{"symbol connector", "Call 2024-12-31 @ 3pm", nil, "2024-12-31 @ 3pm", time.Date(2024, 12, 31, 15, 0, 0, 0, time.UTC)},
+		{"relative day", "Lunch tomorrow at noon!", nil, "tomorrow at noon", time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)},
+		{"localized connector", "Cita el 2024-12-31 a las 15:00", []string{"es"}, "2024-12-31 a las 15:00", time.Date(2024, 12, 31, 15, 0, 0, 0, time.UTC)},
+		{"time already present", "2024-12-31T10:00 at 3pm", nil, "2024-12-31T10:00", time.Date(2024, 12, 31, 10, 0, 0, 0, time.UTC)},
+		{"not a time", "On 2024-12-31 at 3 people came", nil, "2024-12-31", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"invalid time", "December 31, 2024 at 25:00", nil, "December 31, 2024", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ExtractDates(tt.text, &Settings{RelativeBase: base, Languages: tt.languages, MergeDateTime: true})
+			if err != nil {
+				t.Fatalf("ExtractDates() error = %v", err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("ExtractDates() found %d dates, want 1", len(results))
+			}
+			got := results[0]
+			if got.MatchedText != tt.wantText {
+				t.Errorf("MatchedText = %q, want %q", got.MatchedText, tt.wantText)
+			}
+			if got.Length != len(tt.wantText) || tt.text[got.Position:got.Position+got.Length] != tt.wantText {
+				t.Errorf("Position/Length = %d/%d do not span %q", got.Position, got.Length, tt.wantText)
+			}
+			if !got.Date.Equal(tt.want) {
+				t.Errorf("Date = %v, want %v", got.Date, tt.want)
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		results, err := ExtractDates("December 31, 2024 at 3:30 PM", &Settings{RelativeBase: base})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 1 || results[0].MatchedText != "December 31, 2024" {
+			t.Errorf("ExtractDates() = %+v, want only the date", results)
+		}
+	})
+
+	t.Run("enabled by default", func(t *testing.T) {
+		results, err := ExtractDates("December 31, 2024 at 3:30 PM", nil)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 1 || results[0].Date.Hour() != 15 {
+			t.Errorf("ExtractDates() = %+v, want the merged date and time", results)
+		}
+	})
+}
+
+func TestExtractDates_Granularity(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		text            string
+		wantGranularity string
+		wantDate        time.Time
+		wantStart       time.Time
+		wantEnd         time.Time
+	}{
+		{"quarter", "Revenue grew in Q3 2024.", "quarter", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 9, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"first half", "Targets for H1 2024 were met.", "half", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 6, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"second half", "Plans for h2 2024", "half", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{"day", "Due December 31, 2024", "day", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), time.Time{}, time.Time{}},
+		{"time", "Due 2024-12-31 15:30", "time", time.Date(2024, 12, 31, 15, 30, 0, 0, time.UTC), time.Time{}, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ExtractDates(tt.text, &Settings{RelativeBase: base})
+			if err != nil {
+				t.Fatalf("ExtractDates() error = %v", err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("ExtractDates() found %d dates, want 1", len(results))
+			}
+			got := results[0]
+			if got.Granularity != tt.wantGranularity {
+				t.Errorf("Granularity = %q, want %q", got.Granularity, tt.wantGranularity)
+			}
+			if !got.Date.Equal(tt.wantDate) {
+				t.Errorf("Date = %v, want %v", got.Date, tt.wantDate)
+			}
+			if !got.PeriodStart.Equal(tt.wantStart) || !got.PeriodEnd.Equal(tt.wantEnd) {
+				t.Errorf("Period = [%v, %v], want [%v, %v]", got.PeriodStart, got.PeriodEnd, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestParsedDate_Interval(t *testing.T) {
+	date := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+	day := func(year int, month time.Month, d int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name        string
+		granularity string
+		date        time.Time
+		wantStart   time.Time
+		wantEnd     time.Time
+	}{
+		{"year", "year", date, day(2024, 1, 1), day(2025, 1, 1)},
+		{"first half", "half", date, day(2024, 1, 1), day(2024, 7, 1)},
+		{"second half", "half", day(2024, 9, 1), day(2024, 7, 1), day(2025, 1, 1)},
+		{"quarter", "quarter", date, day(2024, 1, 1), day(2024, 4, 1)},
+		{"last quarter", "quarter", day(2024, 11, 5), day(2024, 10, 1), day(2025, 1, 1)},
+		{"month", "month", date, day(2024, 3, 1), day(2024, 4, 1)},
+		{"leap February", "month", day(2024, 2, 10), day(2024, 2, 1), day(2024, 3, 1)},
+		{"February", "month", day(2023, 2, 10), day(2023, 2, 1), day(2023, 3, 1)},
+		{"December", "month", day(2024, 12, 31), day(2024, 12, 1), day(2025, 1, 1)},
+		{"day", "day", date, day(2024, 3, 15), day(2024, 3, 16)},
+		{"leap day", "day", day(2024, 2, 29), day(2024, 2, 29), day(2024, 3, 1)},
+		{"time", "time", date, date, date},
+		{"unknown", "", date, date, date},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end := ParsedDate{Date: tt.date, Granularity: tt.granularity}.Interval()
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Interval() = [%v, %v), want [%v, %v)", start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+
+	t.Run("keeps the location", func(t *testing.T) {
+		loc := time.FixedZone("UTC+9", 9*3600)
+		start, end := ParsedDate{Date: time.Date(2024, 3, 15, 1, 0, 0, 0, loc), Granularity: "day"}.Interval()
+		if !start.Equal(time.Date(2024, 3, 15, 0, 0, 0, 0, loc)) || !end.Equal(time.Date(2024, 3, 16, 0, 0, 0, 0, loc)) {
+			t.Errorf("Interval() = [%v, %v), want March 15 in UTC+9", start, end)
+		}
+	})
+
+	t.Run("extracted month", func(t *testing.T) {
+		results, err := ExtractDates("Billing closes in February 2024.", nil)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("ExtractDates() found %d dates, want 1", len(results))
+		}
+		start, end := results[0].Interval()
+		event := time.Date(2024, 2, 29, 18, 0, 0, 0, time.UTC)
+		if event.Before(start) || !event.Before(end) {
+			t.Errorf("Interval() = [%v, %v), want it to contain %v", start, end, event)
+		}
+	})
+}
+
+func TestParsedDate_Canonical(t *testing.T) {
+	date := time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		granularity string
+		date        time.Time
+		want        string
+	}{
+		{"year", date, "2024"},
+		{"half", date, "2024-H1"},
+		{"half", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), "2024-H2"},
+		{"quarter", date, "2024-Q1"},
+		{"month", date, "2024-03"},
+		{"day", date, "2024-03-15"},
+		{"time", date, "2024-03-15T10:30:00Z"},
+		{"time", time.Date(2024, 3, 15, 10, 30, 0, 250000000, time.FixedZone("", 5*3600+1800)), "2024-03-15T10:30:00.25+05:30"},
+		{"", date, "2024-03-15T10:30:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.granularity+"/"+tt.want, func(t *testing.T) {
+			got := ParsedDate{Date: tt.date, Granularity: tt.granularity}.Canonical()
+			if got != tt.want {
+				t.Errorf("Canonical() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("extracted", func(t *testing.T) {
+		results, err := ExtractDates("Q3 2024 review on 2024-09-30 at 3pm", nil)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		got := make(map[string]bool)
+		for _, r := range results {
+			got[r.Canonical()] = true
+		}
+		if len(got) != 2 || !got["2024-Q3"] || !got["2024-09-30T15:00:00Z"] {
+			t.Errorf("Canonical() of extracted dates = %v, want 2024-Q3 and 2024-09-30T15:00:00Z", got)
+		}
+	})
+}
+
+func TestExtractDates_MonthWords(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		text          string
+		requireCtx    bool
+		wantMatches   []string
+		wantCanonical []string
+	}{
+		{"modal verb", "You may go now.", false, nil, nil},
+		{"verb march", "They march at dawn.", false, nil, nil},
+		{"month and year", "It shipped in May 2024.", false, []string{"May 2024"}, []string{"2024-05"}},
+		{"month after preposition", "We launch in May.", false, []string{"May"}, []string{"2025-05"}},
+		{"capitalized modal", "May I come in?", false, nil, nil},
+		{"unambiguous bare month", "December was busy.", false, []string{"December"}, []string{"2024-12"}},
+		{"required context missing", "December was busy.", true, nil, nil},
+		{"required context present", "Busy since December.", true, []string{"December"}, []string{"2024-12"}},
+		{"day and month not repeated", "Due 31 Dec 2024.", true, []string{"31 Dec 2024"}, []string{"2024-12-31"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ExtractDates(tt.text, &Settings{RelativeBase: base, AllowBareMonths: true, RequireMonthContext: tt.requireCtx})
+			if err != nil {
+				t.Fatalf("ExtractDates() error = %v", err)
+			}
+			if len(results) != len(tt.wantMatches) {
+				t.Fatalf("ExtractDates(%q) found %d dates, want %d: %+v", tt.text, len(results), len(tt.wantMatches), results)
+			}
+			for i, r := range results {
+				if r.MatchedText != tt.wantMatches[i] {
+					t.Errorf("MatchedText = %q, want %q", r.MatchedText, tt.wantMatches[i])
+				}
+				if got := r.Canonical(); got != tt.wantCanonical[i] {
+					t.Errorf("Canonical() = %q, want %q", got, tt.wantCanonical[i])
+				}
+			}
+		})
+	}
+
+	t.Run("bare months off by default", func(t *testing.T) {
+		for text, want := range map[string]int{"We launch in May.": 0, "December was busy.": 0, "It shipped in May 2024.": 1} {
+			results, err := ExtractDates(text, &Settings{RelativeBase: base})
+			if err != nil || len(results) != want {
+				t.Errorf("ExtractDates(%q) = %+v, %v, want %d dates", text, results, err, want)
+			}
+		}
+	})
+}
+
+func TestParseDate_AmbiguousNumericStrict(t *testing.T) {
+	_, err := ParseDate("03/04/2024", &Settings{Strict: true})
+	var ambigErr *ErrAmbiguousDate
+	if !errors.As(err, &ambigErr) {
+		t.Errorf("ParseDate() error = %v, want ErrAmbiguousDate", err)
+	}
+
+	if _, err := ParseDate("25/04/2024", &Settings{Strict: true}); err != nil {
+		t.Errorf("ParseDate(\"25/04/2024\") error = %v, want nil", err)
+	}
+}
+
+func TestParseDate_LocaleDefaultDateOrder(t *testing.T) {
+	january2 := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC)
+	february1 := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		languages []string
+		dateOrder string
+		want      time.Time
+	}{
+		{"english MDY", []string{"en"}, "", january2},
+		{"german DMY", []string{"de"}, "", february1},
+		{"french DMY", []string{"fr"}, "", february1},
+		{"spanish DMY", []string{"es"}, "", february1},
+		{"japanese YMD", []string{"ja"}, "", january2},
+		{"chinese YMD", []string{"zh"}, "", january2},
+		{"several languages fall back to MDY", []string{"de", "fr"}, "", january2},
+		{"en-US region MDY", []string{"en-US"}, "", january2},
+		{"en-GB region DMY", []string{"en-GB"}, "", february1},
+		{"underscore and case", []string{"en_gb"}, "", february1},
+		{"pt-BR", []string{"pt-BR"}, "", february1},
+		{"es-419 numeric region", []string{"es-419"}, "", february1},
+		{"zh-Hant without region", []string{"zh-Hant"}, "", january2},
+		{"several tags fall back to MDY", []string{"en-GB", "fr-FR"}, "", january2},
+		{"explicit order wins", []string{"de"}, "MDY", january2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate("01/02/2024", &Settings{Languages: tt.languages, DateOrder: tt.dateOrder})
+			if err != nil {
+				t.Fatalf("ParseDate() error = %v", err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(\"01/02/2024\") = %v, want %v", result, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDate_LanguageTags(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		tag   string
+		input string
+		want  time.Time
+	}{
+		{"pt-BR", "3 de fevereiro de 2024", time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)},
+		{"pt-PT", "3 de fevereiro de 2024", time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)},
+		{"de-AT", "3. Februar 2024", time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)},
+		{"zh-Hant-TW", "2024年2月3日", time.Date(2024, 2, 3, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, Languages: []string{tt.tag}})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("New accepts tags", func(t *testing.T) {
+		if _, err := New(&Settings{Languages: []string{"en-GB", "pt-BR"}}); err != nil {
+			t.Errorf("New() error = %v", err)
+		}
+	})
+
+	t.Run("New rejects unknown base language", func(t *testing.T) {
+		if _, err := New(&Settings{Languages: []string{"xx-GB"}}); err == nil {
+			t.Error("New() expected error for unsupported language")
+		}
+	})
+}
+
+// cancelAfterContext reports context.Canceled once Err has been called more than n times,
+// which lets tests cancel deterministically in the middle of a scan.
+type cancelAfterContext struct {
+	context.Context
+	n     int
+	calls int
+}
+
+func (c *cancelAfterContext) Err() error {
+	c.calls++
+	if c.calls > c.n {
+		return context.Canceled
+	}
+	return nil
+}
+
+func TestExtractDatesContext_CanceledMidScan(t *testing.T) {
+	text := strings.Repeat("Due 2024-12-31, then 12/31/2024 or tomorrow. ", 50)
+	ctx := &cancelAfterContext{Context: context.Background(), n: 5}
+
+	results, err := ExtractDatesContext(ctx, text, nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("ExtractDatesContext() error = %v, want context.Canceled", err)
+	}
+	if results != nil {
+		t.Errorf("ExtractDatesContext() returned %d results after cancellation, want nil", len(results))
+	}
+	if ctx.calls != ctx.n+1 {
+		t.Errorf("scan continued after cancellation: Err called %d times, want %d", ctx.calls, ctx.n+1)
+	}
+}
+
+func TestExtractDates_MaxResults(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	// Patterns run in a different order than the dates appear
+	text := "Call me tomorrow, then on 12/31/2024, 2024-01-15 and in May 2025, or 1700000000."
+
+	all, err := ExtractDates(text, &Settings{RelativeBase: base})
+	if err != nil {
+		t.Fatalf("ExtractDates() error = %v", err)
+	}
+	if len(all) != 5 {
+		t.Fatalf("ExtractDates() found %d dates, want 5: %+v", len(all), all)
+	}
+	for i := 1; i < len(all); i++ {
+		if all[i].Position < all[i-1].Position {
+			t.Fatalf("ExtractDates() results not in position order: %+v", all)
+		}
+	}
+
+	for _, limit := range []int{1, 2, 3, 5, 10} {
+		t.Run(fmt.Sprintf("limit %d", limit), func(t *testing.T) {
+			results, err := ExtractDates(text, &Settings{RelativeBase: base, MaxResults: limit})
+			if err != nil {
+				t.Fatalf("ExtractDates() error = %v", err)
+			}
+			want := all
+			if limit < len(all) {
+				want = all[:limit]
+			}
+			if len(results) != len(want) {
+				t.Fatalf("ExtractDates() found %d dates, want %d", len(results), len(want))
+			}
+			for i := range want {
+				if results[i].MatchedText != want[i].MatchedText || results[i].Position != want[i].Position {
+					t.Errorf("result %d = %q at %d, want %q at %d", i, results[i].MatchedText, results[i].Position, want[i].MatchedText, want[i].Position)
+				}
+			}
+		})
+	}
+
+	t.Run("stops scanning early", func(t *testing.T) {
+		long := strings.Repeat("Due 2024-12-31, then 12/31/2024 or tomorrow. ", 50)
+
+		unlimited := &cancelAfterContext{Context: context.Background(), n: 1 << 30}
+		if _, err := ExtractDatesContext(unlimited, long, &Settings{RelativeBase: base}); err != nil {
+			t.Fatalf("ExtractDatesContext() error = %v", err)
+		}
+
+		limited := &cancelAfterContext{Context: context.Background(), n: 1 << 30}
+		results, err := ExtractDatesContext(limited, long, &Settings{RelativeBase: base, MaxResults: 2})
+		if err != nil {
+			t.Fatalf("ExtractDatesContext() error = %v", err)
+		}
+		if len(results) != 2 {
+			t.Fatalf("ExtractDatesContext() found %d dates, want 2", len(results))
+		}
+		if limited.calls > 3 || limited.calls >= unlimited.calls {
+			t.Errorf("scan examined %d candidates with MaxResults 2 (%d without), want it to stop after the second date", limited.calls, unlimited.calls)
+		}
+	})
+}
+
+func TestExtractDates_MinMatchLength(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	text := "Meet in May or today, then file on 2024-01-15 and December 31, 2024."
+
+	all, err := ExtractDates(text, &Settings{RelativeBase: base, AllowBareMonths: true})
+	if err != nil {
+		t.Fatalf("ExtractDates() error = %v", err)
+	}
+	defaults, err := ExtractDates(text, &Settings{RelativeBase: base, AllowBareMonths: true, MinMatchLength: 0})
+	if err != nil {
+		t.Fatalf("ExtractDates() error = %v", err)
+	}
+	if len(defaults) != len(all) {
+		t.Fatalf("MinMatchLength 0 found %d dates, want all %d", len(defaults), len(all))
+	}
+
+	results, err := ExtractDates(text, &Settings{RelativeBase: base, AllowBareMonths: true, MinMatchLength: 6})
+	if err != nil {
+		t.Fatalf("ExtractDates() error = %v", err)
+	}
+	var kept []string
+	for _, result := range all {
+		if len([]rune(result.MatchedText)) >= 6 {
+			kept = append(kept, result.MatchedText)
+		}
+	}
+	if len(kept) == len(all) || len(kept) == 0 {
+		t.Fatalf("test text should mix short and long matches: %+v", all)
+	}
+	if len(results) != len(kept) {
+		t.Fatalf("MinMatchLength 6 found %d dates, want %d (%v): %+v", len(results), len(kept), kept, results)
+	}
+	for i, result := range results {
+		if result.MatchedText != kept[i] {
+			t.Errorf("result %d = %q, want %q", i, result.MatchedText, kept[i])
+		}
+	}
+
+	t.Run("before MaxResults", func(t *testing.T) {
+		results, err := ExtractDates(text, &Settings{RelativeBase: base, AllowBareMonths: true, MinMatchLength: 6, MaxResults: 1})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 1 || results[0].MatchedText != kept[0] {
+			t.Errorf("ExtractDates() = %+v, want only %q", results, kept[0])
+		}
+	})
+
+	t.Run("ContainsDate agrees", func(t *testing.T) {
+		opts := &Settings{RelativeBase: base, AllowBareMonths: true, MinMatchLength: 6}
+		for _, text := range []string{
+			"see you today",
+			"back in May",
+			"due 3/4",
+			"1, 2 and 3 May",
+			"see you tomorrow",
+			"filed on 2024-01-15",
+			"nothing here",
+		} {
+			results, err := ExtractDates(text, opts)
+			if err != nil {
+				t.Fatalf("ExtractDates(%q) error = %v", text, err)
+			}
+			if got, want := ContainsDate(text, opts), len(results) > 0; got != want {
+				t.Errorf("ContainsDate(%q) = %v, but ExtractDates found %+v", text, got, results)
+			}
+		}
+	})
+}
+
+func TestExtractDates_ContextWindow(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		text    string
+		window  int
+		context string
+	}{
+		{"no window", "The launch is on 2024-12-31 at the latest.", 0, ""},
+		{"both sides", "The launch is on 2024-12-31 at the latest.", 6, "is on 2024-12-31 at th"},
+		{"clamped at start", "2024-12-31 is the launch.", 5, "2024-12-31 is t"},
+		{"clamped at end", "Launch: 2024-12-31", 5, "nch: 2024-12-31"},
+		{"wider than text", "On 2024-12-31.", 100, "On 2024-12-31."},
+		{"multibyte", "Café rendez-vous 2024-12-31 à l'hôtel", 12, "rendez-vous 2024-12-31 à l'hôtel"},
+		{"multibyte cut", "ééééé 2024-12-31 ààààà", 3, "éé 2024-12-31 àà"},
+		{"CJK", "会议定于2024-12-31举行。", 3, "议定于2024-12-31举行。"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ExtractDates(tt.text, &Settings{RelativeBase: base, ContextWindow: tt.window})
+			if err != nil {
+				t.Fatalf("ExtractDates() error = %v", err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("ExtractDates() = %+v, want 1 result", results)
+			}
+			got := results[0].Context
+			if got != tt.context {
+				t.Errorf("Context = %q, want %q", got, tt.context)
+			}
+			if !utf8.ValidString(got) {
+				t.Errorf("Context %q splits a multibyte character", got)
+			}
+		})
+	}
+
+	t.Run("resolved references", func(t *testing.T) {
+		text := "The meeting is on March 3, 2025. Send the agenda 2 days before the meeting."
+		results, err := ExtractDates(text, &Settings{RelativeBase: base, ResolveReferences: true, ContextWindow: 5})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 2 {
+			t.Fatalf("ExtractDates() = %+v, want 2 results", results)
+		}
+		if want := "enda 2 days before the meeting."; results[1].Context != want {
+			t.Errorf("Context = %q, want %q", results[1].Context, want)
+		}
+	})
+}
+
+func TestPreprocessors(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	xmas := PreprocessorFunc(func(s string) string { return strings.ReplaceAll(s, "Xmas", "December 25") })
+	markdown := PreprocessorFunc(func(s string) string { return strings.NewReplacer("**", "", "_", "").Replace(s) })
+	settings := &Settings{RelativeBase: base, Preprocessors: []Preprocessor{markdown, xmas}}
+
+	t.Run("ParseDate", func(t *testing.T) {
+		result, err := ParseDate("**Xmas 2024**", settings)
+		if err != nil {
+			t.Fatalf("ParseDate() error = %v", err)
+		}
+		if want := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC); !result.Equal(want) {
+			t.Errorf("ParseDate() = %v, want %v", result, want)
+		}
+	})
+
+	t.Run("ParseDateRange", func(t *testing.T) {
+		result, err := ParseDateRange("from Xmas 2024 to 2025-01-05", settings)
+		if err != nil {
+			t.Fatalf("ParseDateRange() error = %v", err)
+		}
+		if want := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC); !result.Start.Equal(want) {
+			t.Errorf("ParseDateRange() start = %v, want %v", result.Start, want)
+		}
+	})
+
+	t.Run("applied in order", func(t *testing.T) {
+		// Stripping markdown first joins "X_mas" into "Xmas" for the next step
+		if _, err := ParseDate("X_mas 2024", settings); err != nil {
+			t.Errorf("ParseDate() error = %v", err)
+		}
+		reversed := &Settings{RelativeBase: base, Preprocessors: []Preprocessor{xmas, markdown}}
+		if _, err := ParseDate("X_mas 2024", reversed); err == nil {
+			t.Error("ParseDate() with the steps reversed succeeded, want error")
+		}
+	})
+
+	t.Run("positions map to the original text", func(t *testing.T) {
+		text := "Party on **Xmas 2024**, returning _2025-01-05_ or Jan 7, 2025."
+		want := []string{"Xmas 2024", "2025-01-05", "Jan 7, 2025"}
+
+		results, err := ExtractDates(text, settings)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != len(want) {
+			t.Fatalf("ExtractDates() = %+v, want %d results", results, len(want))
+		}
+		for i, r := range results {
+			if got := text[r.Position : r.Position+r.Length]; got != want[i] {
+				t.Errorf("results[%d] covers %q, want %q", i, got, want[i])
+			}
+		}
+		if results[0].MatchedText != "December 25 2024" {
+			t.Errorf("results[0].MatchedText = %q, want the preprocessed text", results[0].MatchedText)
+		}
+
+		var streamed []ParsedDate
+		if err := ExtractDatesFunc(text, settings, func(r ParsedDate) bool {
+			streamed = append(streamed, r)
+			return true
+		}); err != nil {
+			t.Fatalf("ExtractDatesFunc() error = %v", err)
+		}
+		for i, r := range streamed {
+			if r.Position != results[i].Position || r.Length != results[i].Length {
+				t.Errorf("ExtractDatesFunc() result %d at %d+%d, want %d+%d", i, r.Position, r.Length, results[i].Position, results[i].Length)
+			}
+		}
+
+		var joined strings.Builder
+		for _, token := range Tokenize(text, settings) {
+			joined.WriteString(token.Text)
+		}
+		if joined.String() != text {
+			t.Errorf("Tokenize() tokens join to %q, want %q", joined.String(), text)
+		}
+	})
+
+	t.Run("multibyte replacements", func(t *testing.T) {
+		hyphens := PreprocessorFunc(func(s string) string { return strings.ReplaceAll(s, "‐", "-") })
+		text := "Réunion le 2024‐12‐31 à l'hôtel"
+		results, err := ExtractDates(text, &Settings{RelativeBase: base, Preprocessors: []Preprocessor{hyphens}})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 1 {
+			t.Fatalf("ExtractDates() = %+v, want 1 result", results)
+		}
+		if got := text[results[0].Position : results[0].Position+results[0].Length]; got != "2024‐12‐31" {
+			t.Errorf("result covers %q, want %q", got, "2024‐12‐31")
+		}
+	})
+
+	t.Run("run once per call", func(t *testing.T) {
+		calls := 0
+		counting := PreprocessorFunc(func(s string) string {
+			calls++
+			return s
+		})
+
+		tests := []struct {
+			name string
+			run  func(*Settings) error
+		}{
+			{"ParseDate", func(s *Settings) error { _, err := ParseDate("2024-12-31", s); return err }},
+			{"ParseDate SelectBest", func(s *Settings) error {
+				s.SelectBest = true
+				_, err := ParseDate("due 2024-12-31 or so", s)
+				return err
+			}},
+			{"ParseDate MinDate", func(s *Settings) error {
+				s.MinDate = base.AddDate(-1, 0, 0)
+				_, err := ParseDate("2024-12-31", s)
+				return err
+			}},
+			{"ParseDateRange", func(s *Settings) error { _, err := ParseDateRange("from 2024-12-01 to 2024-12-31", s); return err }},
+			{"ExtractDates", func(s *Settings) error { _, err := ExtractDates("due 2024-12-31", s); return err }},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				calls = 0
+				if err := tt.run(&Settings{RelativeBase: base, Preprocessors: []Preprocessor{counting}}); err != nil {
+					t.Fatalf("error = %v", err)
+				}
+				if calls != 1 {
+					t.Errorf("preprocessor ran %d times, want 1", calls)
+				}
+			})
+		}
+	})
+
+	t.Run("input length checked before and after", func(t *testing.T) {
+		shrink := PreprocessorFunc(func(s string) string { return strings.TrimSpace(s) })
+		grow := PreprocessorFunc(func(s string) string { return strings.Repeat(" ", 100) + s })
+		long := strings.Repeat(" ", 100) + "2024-12-31"
+
+		for _, tt := range []struct {
+			name  string
+			text  string
+			steps []Preprocessor
+		}{
+			{"too long before", long, []Preprocessor{shrink}},
+			{"too long after", "2024-12-31", []Preprocessor{grow}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				settings := &Settings{RelativeBase: base, MaxInputLength: 50, Preprocessors: tt.steps}
+				var tooLong *ErrInputTooLong
+				if _, err := ParseDate(tt.text, settings); !errors.As(err, &tooLong) {
+					t.Errorf("ParseDate() error = %v, want ErrInputTooLong", err)
+				}
+				if _, err := ExtractDates(tt.text, settings); !errors.As(err, &tooLong) {
+					t.Errorf("ExtractDates() error = %v, want ErrInputTooLong", err)
+				}
+				if _, err := ParseDateRange(tt.text, settings); !errors.As(err, &tooLong) {
+					t.Errorf("ParseDateRange() error = %v, want ErrInputTooLong", err)
+				}
+			})
+		}
+	})
+
+	t.Run("nil preprocessor", func(t *testing.T) {
+		if _, err := New(&Settings{Preprocessors: []Preprocessor{nil}}); err == nil {
+			t.Error("New() with a nil preprocessor succeeded, want error")
+		}
+	})
+}
+
+func TestAlignOffsets(t *testing.T) {
+	long := strings.Repeat("ab", 1000)
+	tests := []struct {
+		name                string
+		original, processed string
+		match               string // substring of processed
+		want                string // what it covers in original
+	}{
+		{"unchanged", "on 2024-12-31.", "on 2024-12-31.", "2024-12-31", "2024-12-31"},
+		{"replaced", "on Xmas 2024.", "on December 25 2024.", "December 25 2024", "Xmas 2024"},
+		{"deleted around", "on **2024-12-31**.", "on 2024-12-31.", "2024-12-31", "2024-12-31"},
+		{"multibyte", "le 2024‐12‐31 à", "le 2024-12-31 à", "2024-12-31", "2024‐12‐31"},
+		{"past the edit bound", long + " 2024-12-31", strings.Repeat("ba", 1000) + " 2024-12-31", "2024-12-31", "2024-12-31"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := alignOffsets(tt.original, tt.processed)
+			start, length := m.original(strings.Index(tt.processed, tt.match), len(tt.match))
+			if got := tt.original[start : start+length]; got != tt.want {
+				t.Errorf("%q maps to %q, want %q", tt.match, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractDatesFunc(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	text := "Call me tomorrow, then on 12/31/2024, on 1, 2 and 3 December 2024, and in May 2025, or 1700000000."
+	settings := &Settings{RelativeBase: base}
+
+	want, err := ExtractDates(text, settings)
+	if err != nil {
+		t.Fatalf("ExtractDates() error = %v", err)
+	}
+	if len(want) < 6 {
+		t.Fatalf("ExtractDates() found %d dates, want at least 6: %+v", len(want), want)
+	}
+
+	t.Run("position order", func(t *testing.T) {
+		var got []ParsedDate
+		err := ExtractDatesFunc(text, settings, func(date ParsedDate) bool {
+			got = append(got, date)
+			return true
+		})
+		if err != nil {
+			t.Fatalf("ExtractDatesFunc() error = %v", err)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("ExtractDatesFunc() passed %d dates, want %d", len(got), len(want))
+		}
+		for i := range want {
+			if got[i].MatchedText != want[i].MatchedText || got[i].Position != want[i].Position || !got[i].Date.Equal(want[i].Date) {
+				t.Errorf("date %d = %q at %d, want %q at %d", i, got[i].MatchedText, got[i].Position, want[i].MatchedText, want[i].Position)
+			}
+		}
+	})
+
+	t.Run("returning false stops", func(t *testing.T) {
+		for _, stop := range []int{1, 3} {
+			calls := 0
+			err := ExtractDatesFunc(text, settings, func(date ParsedDate) bool {
+				calls++
+				if date.Position != want[calls-1].Position {
+					t.Errorf("call %d got date at %d, want %d", calls, date.Position, want[calls-1].Position)
+				}
+				return calls < stop
+			})
+			if err != nil {
+				t.Fatalf("ExtractDatesFunc() error = %v", err)
+			}
+			if calls != stop {
+				t.Errorf("fn called %d times after returning false on call %d", calls, stop)
+			}
+		}
+	})
+
+	t.Run("MaxResults", func(t *testing.T) {
+		calls := 0
+		err := ExtractDatesFunc(text, &Settings{RelativeBase: base, MaxResults: 2}, func(ParsedDate) bool {
+			calls++
+			return true
+		})
+		if err != nil || calls != 2 {
+			t.Errorf("ExtractDatesFunc() called fn %d times (error %v), want 2", calls, err)
+		}
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		var emptyErr *ErrEmptyInput
+		if err := ExtractDatesFunc("", nil, func(ParsedDate) bool { return true }); !errors.As(err, &emptyErr) {
+			t.Errorf("ExtractDatesFunc() error = %v, want ErrEmptyInput", err)
+		}
+	})
+}
+
+func TestContainsDate(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		text     string
+		settings *Settings
+		want     bool
+	}{
+		{"relative", "see you tomorrow", &Settings{RelativeBase: base}, true},
+		{"absolute late in text", "nothing here, nothing there, but due 2024-12-31", nil, true},
+		{"month with context", "we ship in May", &Settings{AllowBareMonths: true}, true},
+		{"bare month off by default", "we ship in May", nil, false},
+		{"ambiguous month word", "I may come", &Settings{AllowBareMonths: true}, false},
+		{"date list", "on 1, 2 and 3 December 2024", nil, true},
+		{"numbers only", "version 3.5 of 12 items", nil, false},
+		{"no date", "the quick brown fox", nil, false},
+		{"blank", "  ", nil, false},
+		{"relative parser disabled", "see you tomorrow", &Settings{RelativeBase: base, EnableParsers: []string{"absolute"}}, false},
+		{"absolute parser disabled", "due 2024-12-31", &Settings{EnableParsers: []string{"relative"}}, false},
+		{"English fallback with other languages", "see you tomorrow", &Settings{RelativeBase: base, Languages: []string{"es"}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ContainsDate(tt.text, tt.settings); got != tt.want {
+				t.Errorf("ContainsDate(%q) = %v, want %v", tt.text, got, tt.want)
+			}
+
+			// Always agrees with ExtractDates
+			results, _ := ExtractDates(tt.text, tt.settings)
+			if found := len(results) > 0; found != tt.want {
+				t.Errorf("ExtractDates(%q) found %d dates, ContainsDate = %v", tt.text, len(results), tt.want)
+			}
+		})
+	}
+}
+
+func TestExtractDatesContext_AlreadyCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := ExtractDatesContext(ctx, "Meeting on 2024-12-31", nil)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("ExtractDatesContext() error = %v, want context.Canceled", err)
+	}
+}
+
+func TestExtractDatesContext_Background(t *testing.T) {
+	results, err := ExtractDatesContext(context.Background(), "Meeting on 2024-12-31 and 2025-01-15", nil)
+	if err != nil {
+		t.Fatalf("ExtractDatesContext() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("ExtractDatesContext() found %d dates, want 2", len(results))
+	}
+}
+
 // Case Sensitivity Tests
 
 func TestParseDate_CaseInsensitive(t *testing.T) {
@@ -372,3 +1511,426 @@ func BenchmarkExtractDates(b *testing.B) {
 		_, _ = ExtractDates(text, nil)
 	}
 }
+
+// containsDateBenchText is a long text with a date near the start.
+var containsDateBenchText = "Meeting on 2024-12-31. " + strings.Repeat("Notes from the call, with 3 items and a 1.5 hour slot, in May or June. ", 10)
+
+func BenchmarkContainsDate(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		_ = ContainsDate(containsDateBenchText, nil)
+	}
+}
+
+func BenchmarkContainsDate_ExtractDates(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		results, _ := ExtractDates(containsDateBenchText, nil)
+		_ = len(results) > 0
+	}
+}
+
+func TestExtractDatesFromHTML_PositionsInOriginal(t *testing.T) {
+	page := `<html><head><title>Events</title>
+<style>.d { content: "2020-01-01"; }</style>
+<script>var launch = "2021-06-01";</script></head>
+<body><!-- updated 2019-05-05 -->
+<p class="when">Launch on <b>2024-12-31</b> &amp; review on December&nbsp;15, 2024.</p>
+</body></html>`
+
+	results, err := ExtractDatesFromHTML(page, nil)
+	if err != nil {
+		t.Fatalf("ExtractDatesFromHTML() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("ExtractDatesFromHTML() found %d dates, want 2 (script, style and comments skipped): %+v", len(results), results)
+	}
+
+	tests := []struct {
+		raw  string
+		want time.Time
+	}{
+		{"2024-12-31", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"December&nbsp;15, 2024", time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC)},
+	}
+	for i, tt := range tests {
+		got := results[i]
+		if wantPos := strings.Index(page, tt.raw); got.Position != wantPos || got.Length != len(tt.raw) {
+			t.Errorf("result %d span = [%d, +%d], want [%d, +%d]", i, got.Position, got.Length, wantPos, len(tt.raw))
+		}
+		if raw := page[got.Position : got.Position+got.Length]; raw != tt.raw {
+			t.Errorf("result %d raw html = %q, want %q", i, raw, tt.raw)
+		}
+		if !got.Date.Equal(tt.want) {
+			t.Errorf("result %d date = %v, want %v", i, got.Date, tt.want)
+		}
+	}
+}
+
+func TestExtractDatesFromHTML_Empty(t *testing.T) {
+	var emptyErr *ErrEmptyInput
+	if _, err := ExtractDatesFromHTML("", nil); !errors.As(err, &emptyErr) {
+		t.Errorf("ExtractDatesFromHTML(\"\") error = %v, want ErrEmptyInput", err)
+	}
+}
+
+func TestParseDate_SelectBest(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"month name beats ambiguous numeric", "moved from 03/04/2024 to March 5, 2024", time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)},
+		{"ISO beats relative", "tomorrow or 2024-12-31", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"earliest wins a tie", "2024-01-10 then 2024-02-20", time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)},
+		{"single date", "2024-06-01", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, SelectBest: true}
+			got, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	t.Run("without SelectBest", func(t *testing.T) {
+		if _, err := ParseDate("tomorrow or 2024-12-31", &Settings{RelativeBase: base}); err == nil {
+			t.Error("expected error parsing two dates as one")
+		}
+	})
+
+	t.Run("no date falls back to whole input", func(t *testing.T) {
+		if _, err := ParseDate("no dates here", &Settings{RelativeBase: base, SelectBest: true}); err == nil {
+			t.Error("expected error for input without dates")
+		}
+	})
+}
+
+func TestTrace(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	t.Run("records attempts in parser order", func(t *testing.T) {
+		entries := Trace("2024-12-31", &Settings{RelativeBase: base})
+		want := []string{"timestamp", "absolute", "relative", "time", "incomplete", "ordinal", "week"}
+		if len(entries) != len(want) {
+			t.Fatalf("Trace() returned %d entries, want %d", len(entries), len(want))
+		}
+
+		selected := 0
+		for i, entry := range entries {
+			if entry.Parser != want[i] {
+				t.Errorf("entry %d parser = %q, want %q", i, entry.Parser, want[i])
+			}
+			if entry.Matched == (entry.Err != nil) {
+				t.Errorf("entry %q: Matched = %v but Err = %v", entry.Parser, entry.Matched, entry.Err)
+			}
+			if entry.Selected {
+				selected++
+			}
+		}
+		if selected != 1 {
+			t.Errorf("%d entries selected, want 1", selected)
+		}
+
+		if entries[0].Matched {
+			t.Error("timestamp parser should not match")
+		}
+		absolute := entries[1]
+		if !absolute.Matched || !absolute.Selected {
+			t.Errorf("absolute entry = %+v, want matched and selected", absolute)
+		}
+		parsed, _ := ParseDate("2024-12-31", &Settings{RelativeBase: base})
+		if !absolute.Result.Equal(parsed) {
+			t.Errorf("absolute result = %v, want %v", absolute.Result, parsed)
+		}
+	})
+
+	t.Run("only enabled parsers", func(t *testing.T) {
+		entries := Trace("tomorrow", &Settings{RelativeBase: base, EnableParsers: []string{"time", "relative"}})
+		if len(entries) != 2 || entries[0].Parser != "relative" || entries[1].Parser != "time" {
+			t.Fatalf("Trace() = %+v, want relative then time", entries)
+		}
+		if !entries[0].Selected || entries[1].Selected {
+			t.Errorf("relative entry should be the selected one: %+v", entries)
+		}
+	})
+
+	t.Run("invalid date error is selected", func(t *testing.T) {
+		entries := Trace("2024-02-30", &Settings{RelativeBase: base})
+		for _, entry := range entries {
+			if !entry.Selected {
+				continue
+			}
+			var invalidErr *ErrInvalidDate
+			if entry.Parser != "absolute" || !errors.As(entry.Err, &invalidErr) {
+				t.Errorf("selected entry = %+v, want absolute ErrInvalidDate", entry)
+			}
+			return
+		}
+		t.Error("no entry selected")
+	})
+
+	t.Run("empty input", func(t *testing.T) {
+		if entries := Trace("", nil); entries != nil {
+			t.Errorf("Trace(\"\") = %+v, want nil", entries)
+		}
+	})
+}
+
+func TestTrace_MatchesParseDate(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	// Inputs from across the tests, covering every step ParseDate takes
+	inputs := []string{
+		// Steps run before the parsers
+		"3pm New York time", "noon in London", "week of March 3, 2024", "week of 2024-02-30",
+		"45 minutes after 3pm", "2 days before 2024-02-30", "5 days after banana",
+		"10 days until December 25", "10 days until February 30, 2025", "by December 31",
+		"on or about March 3", "due 2024-12-31 or so", "around 3pm", "noonish", "3pm sharp",
+		"exactly noon", "tomorrow or 2024-12-31",
+		// Parsers
+		"1609459200", "2024-12-31", "2024-12-31T10:30:00+05:30", "2024-12-31 3pm", "20241231T153045",
+		"December 31, 2024", "31/12/2024", "01/02/2024", "15 de marzo de 2024", "31\u00a0December\u00a02024",
+		"２０２４-０３-０５", "15.XII.2024", "**Xmas 2024**", "Decembr 25, 2024", "FY24",
+		"tomorrow", "yesterday", "3 days ago", "in 1.5 months", "in 12,5 hours", "next month",
+		"next quarter", "next friday", "this Monday", "a week from Tuesday", "1.5 weeks ago",
+		"quarter past 3", "10 to 6", "48:00", "June 15", "2024", "'99", "3rd June",
+		"next month on the 10th", "the 31st of next month", "2024-W15", "Monday",
+		// Errors
+		"", "   ", "2024-02-30", "2024-02-30 3pm", "2016-12-31T23:59:61Z", "15.XIII.2024",
+		"2024/31/12", "not a date at all",
+	}
+
+	settings := map[string]*Settings{
+		"default":    {RelativeBase: base},
+		"utc":        {RelativeBase: base, NormalizeToUTC: true, PreferredTimezone: time.FixedZone("UTC+9", 9*3600)},
+		"selectBest": {RelativeBase: base, SelectBest: true},
+		"clamped":    {RelativeBase: base, MinDate: base, ClampToRange: true},
+		"noFuture":   {RelativeBase: base, RejectFuture: true},
+		"preprocessed": {RelativeBase: base, Preprocessors: []Preprocessor{
+			PreprocessorFunc(func(s string) string { return strings.TrimPrefix(s, "Re: ") }),
+		}},
+	}
+
+	for name, opts := range settings {
+		t.Run(name, func(t *testing.T) {
+			for _, input := range inputs {
+				want, wantErr := ParseDate(input, opts)
+
+				var selected *TraceEntry
+				entries := Trace(input, opts)
+				for i := range entries {
+					if entries[i].Selected {
+						selected = &entries[i]
+						break
+					}
+				}
+
+				switch {
+				case wantErr == nil && (selected == nil || !selected.Matched):
+					t.Errorf("Trace(%q) selected %+v, ParseDate() = %v", input, selected, want)
+				case wantErr == nil && !selected.Result.Equal(want):
+					t.Errorf("Trace(%q) selected %s result %v, ParseDate() = %v", input, selected.Parser, selected.Result, want)
+				case wantErr != nil && selected != nil && (selected.Err == nil || selected.Err.Error() != wantErr.Error()):
+					t.Errorf("Trace(%q) selected %+v, ParseDate() error = %v", input, selected, wantErr)
+				}
+			}
+		})
+	}
+}
+
+func TestExtractDates_ElidedLists(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	type want struct {
+		matched string
+		day     int
+	}
+	tests := []struct {
+		name      string
+		text      string
+		languages []string
+		month     time.Month
+		year      int
+		want      []want
+	}{
+		{"English month first", "meetings on Dec 1, 2, and 3", nil, time.December, 2024, []want{{"Dec 1", 1}, {"2", 2}, {"3", 3}}},
+		{"English with year", "Closed March 4 & 5, 2025.", nil, time.March, 2025, []want{{"March 4", 4}, {"5", 5}}},
+		{"English day first", "on 7, 8 and 9 January 2025", nil, time.January, 2025, []want{{"7", 7}, {"8", 8}, {"9", 9}}},
+		{"Spanish", "las reuniones son el 1, 2 y 3 de diciembre de 2024", []string{"es"}, time.December, 2024, []want{{"1", 1}, {"2", 2}, {"3", 3}}},
+		{"German ordinals", "am 1., 2. und 3. Dezember 2024", []string{"de"}, time.December, 2024, []want{{"1", 1}, {"2", 2}, {"3", 3}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ExtractDates(tt.text, &Settings{RelativeBase: base, Languages: tt.languages})
+			if err != nil {
+				t.Fatalf("ExtractDates(%q) error = %v", tt.text, err)
+			}
+			if len(results) != len(tt.want) {
+				t.Fatalf("ExtractDates(%q) returned %d dates, want %d: %+v", tt.text, len(results), len(tt.want), results)
+			}
+
+			for i, w := range tt.want {
+				r := results[i]
+				if r.MatchedText != w.matched {
+					t.Errorf("result %d MatchedText = %q, want %q", i, r.MatchedText, w.matched)
+				}
+				if got := tt.text[r.Position : r.Position+r.Length]; got != r.MatchedText {
+					t.Errorf("result %d position points at %q, want %q", i, got, r.MatchedText)
+				}
+				if r.Date.Day() != w.day || r.Date.Month() != tt.month || r.Date.Year() != tt.year {
+					t.Errorf("result %d date = %v, want %d %v %d", i, r.Date, w.day, tt.month, tt.year)
+				}
+			}
+		})
+	}
+
+	t.Run("no conjunction is not a list", func(t *testing.T) {
+		results, err := ExtractDates("Dec 1, 2024", &Settings{RelativeBase: base})
+		if err != nil || len(results) != 1 || results[0].MatchedText != "Dec 1, 2024" {
+			t.Errorf("ExtractDates() = %+v, %v", results, err)
+		}
+	})
+}
+
+func TestTokenize(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := &Settings{RelativeBase: base}
+
+	tests := []struct {
+		name      string
+		text      string
+		wantDates []string
+	}{
+		{"dates inside text", "Shipped 2024-12-31, arriving tomorrow.", []string{"2024-12-31", "tomorrow"}},
+		{"date at both ends", "2024-01-10 until 2024-02-20", []string{"2024-01-10", "2024-02-20"}},
+		{"list", "meetings on Dec 1, 2, and 3", []string{"Dec 1", "2", "3"}},
+		{"no dates", "nothing to see here", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := Tokenize(tt.text, settings)
+
+			var joined strings.Builder
+			var dates []string
+			for _, token := range tokens {
+				if token.Text == "" {
+					t.Errorf("empty token at %d", token.Position)
+				}
+				if token.Position != joined.Len() {
+					t.Errorf("token %q position = %d, want %d", token.Text, token.Position, joined.Len())
+				}
+				joined.WriteString(token.Text)
+				if token.IsDate {
+					dates = append(dates, token.Text)
+					if token.Date.MatchedText != token.Text {
+						t.Errorf("date token %q has MatchedText %q", token.Text, token.Date.MatchedText)
+					}
+				}
+			}
+
+			if joined.String() != tt.text {
+				t.Errorf("joined tokens = %q, want %q", joined.String(), tt.text)
+			}
+			if strings.Join(dates, "|") != strings.Join(tt.wantDates, "|") {
+				t.Errorf("date tokens = %q, want %q", dates, tt.wantDates)
+			}
+		})
+	}
+
+	t.Run("empty", func(t *testing.T) {
+		if tokens := Tokenize("", nil); tokens != nil {
+			t.Errorf("Tokenize(\"\") = %+v, want nil", tokens)
+		}
+	})
+
+	t.Run("too long is one literal", func(t *testing.T) {
+		tokens := Tokenize("2024-12-31 and more", &Settings{MaxInputLength: 5})
+		if len(tokens) != 1 || tokens[0].IsDate || tokens[0].Text != "2024-12-31 and more" {
+			t.Errorf("Tokenize() = %+v", tokens)
+		}
+	})
+}
+
+func TestExtractDates_References(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := &Settings{RelativeBase: base, ResolveReferences: true}
+
+	tests := []struct {
+		name    string
+		text    string
+		matched string
+		want    time.Time
+	}{
+		{
+			"backward reference",
+			"The meeting is on March 3, 2025. Prepare the slides 3 days before the meeting.",
+			"3 days before the meeting",
+			time.Date(2025, 2, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"forward reference",
+			"Submit the draft 2 weeks before the deadline. The deadline is Dec. 31, 2024.",
+			"2 weeks before the deadline",
+			time.Date(2024, 12, 17, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			"nearest mention wins",
+			"The launch was on May 1, 2024. The launch is now June 3, 2025. Announce it a week after the launch.",
+			"a week after the launch",
+			time.Date(2025, 6, 10, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ExtractDates(tt.text, settings)
+			if err != nil {
+				t.Fatalf("ExtractDates() error = %v", err)
+			}
+			var found *ParsedDate
+			for i := range results {
+				if results[i].MatchedText == tt.matched {
+					found = &results[i]
+				}
+			}
+			if found == nil {
+				t.Fatalf("ExtractDates() = %+v, want a match for %q", results, tt.matched)
+			}
+			if !found.Date.Equal(tt.want) {
+				t.Errorf("Date = %v, want %v", found.Date, tt.want)
+			}
+			if found.Position != strings.Index(tt.text, tt.matched) {
+				t.Errorf("Position = %d, want %d", found.Position, strings.Index(tt.text, tt.matched))
+			}
+		})
+	}
+
+	t.Run("unresolved reference", func(t *testing.T) {
+		results, err := ExtractDates("Send invites a week after the launch.", settings)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 0 {
+			t.Errorf("ExtractDates() = %+v, want no dates without an anchor", results)
+		}
+	})
+
+	t.Run("off by default", func(t *testing.T) {
+		results, err := ExtractDates("The meeting is on March 3, 2025. Prepare 3 days before the meeting.", &Settings{RelativeBase: base})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 1 {
+			t.Errorf("ExtractDates() = %+v, want only the anchor date", results)
+		}
+	})
+}
@@ -0,0 +1,141 @@
+package godateparser
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/coredds/godateparser/translations"
+)
+
+// Countdown is a duration stated as remaining until a date, as in
+// "10 days until December 25" or "3 weeks till Friday".
+type Countdown struct {
+	// Target is the date counted down to.
+	Target time.Time
+
+	// Amount and Unit are the stated duration ("day", "week", ...), and
+	// Stated is RelativeBase offset by it.
+	Amount float64
+	Unit   string
+	Stated time.Time
+}
+
+// Consistent reports whether the stated duration agrees with Target:
+// Stated must fall within one Unit of Target for hours, minutes and
+// seconds, and on the same calendar day otherwise.
+func (c *Countdown) Consistent() bool {
+	switch c.Unit {
+	case "second", "minute", "hour":
+		diff := c.Stated.Sub(c.Target)
+		if diff < 0 {
+			diff = -diff
+		}
+		return diff < unitDuration(c.Unit)
+	}
+	stated := c.Stated.In(c.Target.Location())
+	return stated.Year() == c.Target.Year() && stated.YearDay() == c.Target.YearDay()
+}
+
+// unitDuration returns the length of a second, minute or hour unit.
+func unitDuration(unit string) time.Duration {
+	switch unit {
+	case "hour":
+		return time.Hour
+	case "minute":
+		return time.Minute
+	}
+	return time.Second
+}
+
+// ParseCountdown parses a countdown phrase such as "10 days until December
+// 25" and returns its target date along with the stated duration, so callers
+// can check one against the other with Countdown.Consistent. ParseDate
+// returns the target of such a phrase.
+func ParseCountdown(input string, opts *Settings) (*Countdown, error) {
+	if isBlank(input) {
+		return nil, &ErrEmptyInput{}
+	}
+
+	if opts == nil {
+		opts = DefaultSettings()
+	}
+
+	settings := normalizeSettings(opts)
+
+	if err := checkInputLength(input, settings); err != nil {
+		return nil, err
+	}
+
+	countdown, ok, err := parseCountdown(normalizeCharacters(input, settings), opts, settings, nil)
+	if err != nil {
+		return nil, withErrorInput(err, input)
+	}
+	if !ok {
+		return nil, &ErrInvalidFormat{
+			Input:      input,
+			Suggestion: "countdowns are written as 'N units until DATE', e.g. '10 days until December 25'",
+		}
+	}
+	return &countdown, nil
+}
+
+// parseCountdownTarget is parseCountdown returning only the target date,
+// which is what ParseDate gives for a countdown.
+func parseCountdownTarget(input string, opts, settings *Settings, cache *regexCache) (time.Time, bool, error) {
+	countdown, ok, err := parseCountdown(input, opts, settings, cache)
+	return countdown.Target, ok, err
+}
+
+// parseCountdown resolves "10 days until December 25" or "3 semanas para el
+// viernes": the target after the localized Countdown term is a holiday or
+// Settings.NamedDates entry ("Christmas", "the deadline") or is parsed by the
+// regular parser chain, and the duration is applied to RelativeBase to give
+// Countdown.Stated. It reports ok == false if input has no such form or the
+// target cannot be parsed; errors such as an invalid target date are
+// returned as-is.
+func parseCountdown(input string, opts, settings *Settings, cache *regexCache) (countdown Countdown, ok bool, err error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	ctx := &parserContext{settings: settings, cache: cache}
+
+	for _, lang := range translations.GlobalRegistry.GetMultiple(settings.Languages) {
+		terms := lang.RelativeTerms
+		if terms == nil || len(terms.Countdown) == 0 {
+			continue
+		}
+		units := buildTimeUnitPattern(lang)
+		if units == "" {
+			continue
+		}
+
+		pattern := fmt.Sprintf(`^(%s)\s+(%s)\s+(%s)\s+(.+)$`, amountPattern, units, termAlternation(terms.Countdown))
+		matches := ctx.compile(pattern).FindStringSubmatch(input)
+		if matches == nil {
+			continue
+		}
+
+		target, ok := resolveNamedDate(matches[4], settings)
+		if !ok {
+			target, err = parseDateInZone(matches[4], opts, cache)
+			if err != nil {
+				if isSpecificError(err) {
+					return Countdown{}, false, err
+				}
+				continue
+			}
+		}
+
+		amount, err := parseRelativeAmount(ctx, matches[1])
+		if err != nil {
+			return Countdown{}, false, err
+		}
+		unit := normalizeTimeUnit(matches[2], lang)
+		stated, err := addRelativeAmount(ctx, amount, unit)
+		if err != nil {
+			return Countdown{}, false, err
+		}
+		return Countdown{Target: target, Amount: amount, Unit: unit, Stated: stated}, true, nil
+	}
+
+	return Countdown{}, false, nil
+}
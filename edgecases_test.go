@@ -1,6 +1,9 @@
 package godateparser
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 )
@@ -19,9 +22,10 @@ func TestEdgeCase_MonthBoundaries(t *testing.T) {
 		wantMonth time.Month
 		wantDay   int
 	}{
+		// "next month" names the month itself, so it starts on Feb 1
+		{"Next month from Jan 31", "next month", time.February, 1},
 		// Go's time.AddDate normalizes overflow dates
 		// Jan 31 + 1 month = Feb 31 (invalid) -> March 2
-		{"Next month from Jan 31", "next month", time.March, 2},
 		{"1 month from Jan 31", "in 1 month", time.March, 2},
 	}
 
@@ -241,6 +245,53 @@ func TestEdgeCase_Time_InvalidHour(t *testing.T) {
 	}
 }
 
+func TestEdgeCase_InvalidDatePosition(t *testing.T) {
+	tests := []struct {
+		input        string
+		settings     *Settings
+		wantFragment string
+		wantPosition int
+	}{
+		{"2024-02-30", nil, "30", 8},
+		{"2030-02-30", nil, "30", 8},
+		{"  2024-13-01", nil, "13", 7},
+		{"2024-12-31T25:00:00", nil, "25", 11},
+		{"02/30/2024", &Settings{DateOrder: "MDY"}, "30", 3},
+		{"February 30, 2024", nil, "30", 9},
+		{"2024-W54", nil, "54", 6},
+		{"25:30", nil, "25", 0},
+		{"Tuesday, December 30, 2024", &Settings{Strict: true}, "Tuesday", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := ParseDate(tt.input, tt.settings)
+			var invalid *ErrInvalidDate
+			if !errors.As(err, &invalid) {
+				t.Fatalf("ParseDate(%q) error = %v, want ErrInvalidDate", tt.input, err)
+			}
+			if invalid.Fragment != tt.wantFragment || invalid.Position != tt.wantPosition {
+				t.Errorf("ParseDate(%q) fragment = %q at %d, want %q at %d",
+					tt.input, invalid.Fragment, invalid.Position, tt.wantFragment, tt.wantPosition)
+			}
+			if !strings.Contains(err.Error(), fmt.Sprintf("position %d", tt.wantPosition)) {
+				t.Errorf("Error() = %q, want position mentioned", err.Error())
+			}
+		})
+	}
+}
+
+func TestEdgeCase_AmbiguousDatePosition(t *testing.T) {
+	_, err := ParseDate(" 01/02/2024", &Settings{Strict: true})
+	var ambiguous *ErrAmbiguousDate
+	if !errors.As(err, &ambiguous) {
+		t.Fatalf("ParseDate() error = %v, want ErrAmbiguousDate", err)
+	}
+	if ambiguous.Fragment != "01/02/2024" || ambiguous.Position != 1 {
+		t.Errorf("fragment = %q at %d, want \"01/02/2024\" at 1", ambiguous.Fragment, ambiguous.Position)
+	}
+}
+
 func TestEdgeCase_Time_MidnightAmbiguity(t *testing.T) {
 	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
 	settings := &Settings{RelativeBase: base}
@@ -412,6 +463,66 @@ func TestEdgeCase_UnicodeWhitespace(t *testing.T) {
 	}
 }
 
+// Input Length Tests
+
+func TestEdgeCase_InputTooLong(t *testing.T) {
+	// Adversarial input: a long run of partial numeric dates that every pattern would scan
+	input := strings.Repeat("12/31/", 50000) + "2024"
+
+	// The input is rejected before anything, even a preprocessor, reads it
+	calls := 0
+	settings := DefaultSettings()
+	settings.Preprocessors = []Preprocessor{PreprocessorFunc(func(s string) string {
+		calls++
+		return s
+	})}
+	_, err := ParseDate(input, settings)
+
+	var tooLong *ErrInputTooLong
+	if !errors.As(err, &tooLong) {
+		t.Fatalf("ParseDate() error = %v, want ErrInputTooLong", err)
+	}
+	if tooLong.Length != len(input) || tooLong.MaxLength != DefaultMaxInputLength {
+		t.Errorf("ErrInputTooLong = %+v, want Length=%d MaxLength=%d", tooLong, len(input), DefaultMaxInputLength)
+	}
+	if calls != 0 {
+		t.Errorf("preprocessor ran %d times on over-long input, want 0", calls)
+	}
+
+	// The limit itself is allowed
+	atLimit := strings.Repeat(" ", DefaultMaxInputLength-len("2024-12-31")) + "2024-12-31"
+	if _, err := ParseDate(atLimit, nil); err != nil {
+		t.Errorf("ParseDate() of %d bytes error = %v, want the date", len(atLimit), err)
+	}
+	if _, err := ParseDate(" "+atLimit, nil); !errors.As(err, &tooLong) || tooLong.Length != DefaultMaxInputLength+1 {
+		t.Errorf("ParseDate() of %d bytes error = %v, want ErrInputTooLong", len(atLimit)+1, err)
+	}
+
+	if _, err := ExtractDates(input, nil); !errors.As(err, &tooLong) {
+		t.Errorf("ExtractDates() error = %v, want ErrInputTooLong", err)
+	}
+	if _, err := ParseDateRange(input, nil); !errors.As(err, &tooLong) {
+		t.Errorf("ParseDateRange() error = %v, want ErrInputTooLong", err)
+	}
+}
+
+func TestEdgeCase_InputLengthSettings(t *testing.T) {
+	// Custom limit
+	if _, err := ParseDate("2024-12-31", &Settings{MaxInputLength: 5}); err == nil {
+		t.Error("ParseDate() with MaxInputLength=5 should reject a 10-byte input")
+	}
+
+	// Negative limit disables the check
+	text := strings.Repeat(" ", DefaultMaxInputLength) + "2024-12-31"
+	results, err := ExtractDates(text, &Settings{MaxInputLength: -1})
+	if err != nil {
+		t.Fatalf("ExtractDates() with MaxInputLength=-1 error = %v", err)
+	}
+	if len(results) != 1 {
+		t.Errorf("ExtractDates() found %d dates, want 1", len(results))
+	}
+}
+
 // Benchmarks
 
 func BenchmarkEdgeCase_MonthBoundary(b *testing.B) {
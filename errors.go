@@ -1,7 +1,11 @@
 package godateparser
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -25,13 +29,18 @@ type ErrAmbiguousDate struct {
 	Input      string
 	Candidates []time.Time
 	Reason     string
+
+	// Fragment is the ambiguous part of Input and Position its byte offset,
+	// so callers can highlight it. Fragment is empty when it is not known.
+	Fragment string
+	Position int
 }
 
 func (e *ErrAmbiguousDate) Error() string {
 	if e.Reason != "" {
-		return fmt.Sprintf("ambiguous date: %q (%s)", e.Input, e.Reason)
+		return fmt.Sprintf("ambiguous date: %q (%s)%s", e.Input, e.Reason, fragmentSuffix(e.Fragment, e.Position))
 	}
-	return fmt.Sprintf("ambiguous date: %q (use strict mode settings to resolve)", e.Input)
+	return fmt.Sprintf("ambiguous date: %q (use strict mode settings to resolve)%s", e.Input, fragmentSuffix(e.Fragment, e.Position))
 }
 
 // ErrInvalidDate indicates the date components are invalid (e.g., Feb 31).
@@ -41,22 +50,67 @@ type ErrInvalidDate struct {
 	Month  int
 	Day    int
 	Reason string
+
+	// Fragment is the offending part of Input (e.g. "30" in "2024-02-30") and
+	// Position its byte offset, so callers can highlight it. Fragment is empty
+	// when it is not known.
+	Fragment string
+	Position int
+
+	// field and value name the component that failed validation
+	// ("day", 30); parsers use them to locate Fragment
+	field string
+	value int
 }
 
 func (e *ErrInvalidDate) Error() string {
 	if e.Reason != "" {
-		return fmt.Sprintf("invalid date: %q - %s", e.Input, e.Reason)
+		return fmt.Sprintf("invalid date: %q - %s%s", e.Input, e.Reason, fragmentSuffix(e.Fragment, e.Position))
+	}
+	return fmt.Sprintf("invalid date: %q (year=%d, month=%d, day=%d)%s", e.Input, e.Year, e.Month, e.Day, fragmentSuffix(e.Fragment, e.Position))
+}
+
+// fragmentSuffix formats the location of an offending fragment for error messages.
+func fragmentSuffix(fragment string, position int) string {
+	if fragment == "" {
+		return ""
 	}
-	return fmt.Sprintf("invalid date: %q (year=%d, month=%d, day=%d)", e.Input, e.Year, e.Month, e.Day)
+	return fmt.Sprintf(" at %q (position %d)", fragment, position)
 }
 
-// ErrEmptyInput indicates an empty input string was provided.
+// ErrEmptyInput indicates an empty input string was provided, or one holding
+// only whitespace and control characters.
 type ErrEmptyInput struct{}
 
 func (e *ErrEmptyInput) Error() string {
 	return "input string is empty"
 }
 
+// ErrInputTooLong indicates the input exceeds Settings.MaxInputLength.
+type ErrInputTooLong struct {
+	Length    int
+	MaxLength int
+}
+
+func (e *ErrInputTooLong) Error() string {
+	return fmt.Sprintf("input too long: %d bytes (maximum %d)", e.Length, e.MaxLength)
+}
+
+// ErrDateOutOfRange indicates the parsed date violates Settings.RejectFuture,
+// Settings.RejectPast, Settings.MinDate or Settings.MaxDate. Base is the
+// RelativeBase or bound it was checked against, and Reason is "in the
+// future", "in the past", "before MinDate" or "after MaxDate".
+type ErrDateOutOfRange struct {
+	Input  string
+	Date   time.Time
+	Base   time.Time
+	Reason string
+}
+
+func (e *ErrDateOutOfRange) Error() string {
+	return fmt.Sprintf("date out of range: %q is %s (%s, base %s)", e.Input, e.Reason, e.Date.Format(time.RFC3339), e.Base.Format(time.RFC3339))
+}
+
 // ErrParseFailure is a generic parse error with context.
 type ErrParseFailure struct {
 	Input  string
@@ -75,6 +129,59 @@ func (e *ErrParseFailure) Unwrap() error {
 	return e.Reason
 }
 
+// locateError fills in Fragment and Position on an ErrInvalidDate or
+// ErrAmbiguousDate raised while parsing s, the (possibly trimmed or rewritten)
+// form of input matched by re. Invalid components are found by a capture
+// group named after the field ("(?P<month>...)") or else by their value
+// among the captured groups; ambiguity covers the whole match.
+func locateError(err error, input, s string, re *regexp.Regexp) error {
+	loc := re.FindStringSubmatchIndex(s)
+	if loc == nil {
+		return err
+	}
+
+	var invalidErr *ErrInvalidDate
+	if errors.As(err, &invalidErr) && invalidErr.Fragment == "" && invalidErr.field != "" {
+		if i := 2 * re.SubexpIndex(invalidErr.field); i > 0 && loc[i] >= 0 {
+			invalidErr.Fragment = s[loc[i]:loc[i+1]]
+			invalidErr.Position = fragmentPosition(input, s, loc[i], invalidErr.Fragment)
+		}
+		for i := 2; invalidErr.Fragment == "" && i+1 < len(loc); i += 2 {
+			if loc[i] < 0 {
+				continue
+			}
+			group := s[loc[i]:loc[i+1]]
+			if n, convErr := strconv.Atoi(group); convErr == nil && n == invalidErr.value {
+				invalidErr.Fragment = group
+				invalidErr.Position = fragmentPosition(input, s, loc[i], group)
+				break
+			}
+		}
+		if invalidErr.Input == "" {
+			invalidErr.Input = input
+		}
+		return err
+	}
+
+	var ambiguousErr *ErrAmbiguousDate
+	if errors.As(err, &ambiguousErr) && ambiguousErr.Fragment == "" {
+		ambiguousErr.Fragment = s[loc[0]:loc[1]]
+		ambiguousErr.Position = fragmentPosition(input, s, loc[0], ambiguousErr.Fragment)
+	}
+	return err
+}
+
+// fragmentPosition maps an offset in s back to a byte offset in input.
+// s is normally input with leading whitespace trimmed; when it was rewritten
+// (e.g. ordinal suffixes dropped) the first occurrence of fragment is used.
+func fragmentPosition(input, s string, offset int, fragment string) int {
+	lead := len(input) - len(strings.TrimLeft(input, " \t\r\n"))
+	if pos := lead + offset; pos+len(fragment) <= len(input) && input[pos:pos+len(fragment)] == fragment {
+		return pos
+	}
+	return strings.Index(input, fragment)
+}
+
 // Helper functions to create errors with suggestions
 
 func newInvalidFormatError(input string) error {
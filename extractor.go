@@ -1,101 +1,894 @@
 package godateparser
 
 import (
+	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/coredds/godateparser/translations"
 )
 
+// weekdayPrefixPattern optionally matches an English weekday leading a date ("Monday, ", "Tue. ").
+const weekdayPrefixPattern = `(?:(?:Mon|Tues?|Wed(?:nes)?|Thu(?:rs?)?|Fri|Sat(?:ur)?|Sun)(?:day)?\.?,?\s+)?`
+
+// vagueAmountPattern matches the English vague amounts extracted in place of
+// a number ("a couple of days ago", "in a few weeks").
+const vagueAmountPattern = `a\s+couple(?:\s+of)?|couple\s+of|a\s+few|several`
+
+// weekdayMismatchPenalty is subtracted from the confidence of a date whose
+// leading weekday contradicts the date itself (only possible outside strict mode).
+const weekdayMismatchPenalty = 0.30
+
+// siblingOrderPenalty is subtracted from the confidence of a numeric date
+// read in the order an unambiguous date in the same text proves
+// (Settings.InferOrderFromSiblings).
+const siblingOrderPenalty = 0.10
+
+// approximatePenalty is subtracted from the confidence of an approximate
+// time ("around 3pm", "noonish").
+const approximatePenalty = 0.20
+
 // Date extraction patterns for scanning text
 var extractionPatterns = []*regexp.Regexp{
-	// ISO dates
-	regexp.MustCompile(`\b\d{4}-\d{1,2}-\d{1,2}(?:[T\s]\d{1,2}:\d{1,2}(?::\d{1,2})?)?\b`),
-	// Numeric dates: 12/31/2024, 31-12-2024
-	regexp.MustCompile(`\b\d{1,2}[/-]\d{1,2}[/-]\d{4}\b`),
-	// Month name dates: "December 31, 2024", "31 Dec 2024"
-	regexp.MustCompile(`(?i)\b\d{1,2}\s+(?:Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|Jun(?:e)?|Jul(?:y)?|Aug(?:ust)?|Sep(?:tember)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)[,\s]+\d{4}\b`),
-	regexp.MustCompile(`(?i)\b(?:Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|Jun(?:e)?|Jul(?:y)?|Aug(?:ust)?|Sep(?:tember)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)\s+\d{1,2}[,\s]+\d{4}\b`),
+	// ISO dates, optionally with time, fractional seconds and UTC offset
+	regexp.MustCompile(`\b\d{4}-\d{1,2}-\d{1,2}(?:[T\s]\d{1,2}:\d{1,2}(?::\d{1,2}(?:[.,]\d{1,9})?)?(?:Z|[+-]\d{2}:?\d{2})?)?\b`),
+	// Year-first numeric dates: 2024/12/31, 2024.12.31
+	regexp.MustCompile(`\b\d{4}[/.]\d{1,2}[/.]\d{1,2}\b`),
+	// Numeric dates: 12/31/2024, 31-12-2024, 31.12.2024 (both separators alike)
+	regexp.MustCompile(`\b\d{1,2}(?:/\d{1,2}/|-\d{1,2}-|\.\d{1,2}\.)\d{4}\b`),
+	// Roman-numeral months: 15.XII.2024, 15 XII 2024 (parsed only with Settings.AllowRomanMonths)
+	// Single-letter numerals need punctuation, so "code 1 V 2024" is not a date
+	regexp.MustCompile(`\b\d{1,2}(?:[./-]\s*[IVX]{1,4}[./-]\s*|\s+[IVX]{2,4}\s+)\d{4}\b`),
+	// Month name dates: "December 31, 2024", "31 Dec. 2024", optionally led by a weekday ("Monday, December 30, 2024")
+	regexp.MustCompile(`(?i)\b` + weekdayPrefixPattern + `\d{1,2}\s+(?:Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|Jun(?:e)?|Jul(?:y)?|Aug(?:ust)?|Sep(?:tember)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)\.?[,\s]+\d{4}\b`),
+	regexp.MustCompile(`(?i)\b` + weekdayPrefixPattern + `(?:Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|Jun(?:e)?|Jul(?:y)?|Aug(?:ust)?|Sep(?:tember)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)\.?\s+\d{1,2}[,\s]+\d{4}\b`),
+	// Month and year: "May 2024", "Sept. 2024"
+	regexp.MustCompile(`(?i)\b(?:Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|June?|July?|Aug(?:ust)?|Sep(?:t|tember)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)\.?\s+\d{4}\b`),
 	// Relative dates
-	regexp.MustCompile(`(?i)\b\d+\s+(?:second|minute|hour|day|week|month|year)s?\s+ago\b`),
-	regexp.MustCompile(`(?i)\bin\s+\d+\s+(?:second|minute|hour|day|week|month|year)s?\b`),
+	regexp.MustCompile(`(?i)\b(?:\d+|` + vagueAmountPattern + `)\s+(?:second|minute|hour|day|week|month|year)s?\s+ago\b`),
+	regexp.MustCompile(`(?i)\bin\s+(?:\d+|` + vagueAmountPattern + `)\s+(?:second|minute|hour|day|week|month|year)s?\b`),
 	regexp.MustCompile(`(?i)\b(?:yesterday|today|tomorrow)\b`),
 	regexp.MustCompile(`(?i)\b(?:last|next)\s+(?:week|month|year)\b`),
 	regexp.MustCompile(`(?i)\b(?:next|last)\s+(?:monday|tuesday|wednesday|thursday|friday|saturday|sunday)\b`),
-	// Timestamps
-	regexp.MustCompile(`\b\d{10,13}\b`),
+	// "next March": the month name must be capitalized, so "this may work" is not a date
+	regexp.MustCompile(`\b(?i:next|last|this)\s+(?:January|February|March|April|May|June|July|August|September|October|November|December)\b`),
+	// Quarters and halves of a year: "Q3 2024", "H1 2024"
+	regexp.MustCompile(`(?i)\b(?:Q[1-4]|H[12])\s+\d{4}\b`),
+	// Marked two-digit years: "'24", "FY24" (parsed only with Settings.AbbreviatedYears)
+	regexp.MustCompile(`(?i)(?:\B['’]|\bFY\s?)\d{2}\b`),
+	// Approximate times: "around 3pm", "~10:30", "noonish", "3-ish"
+	regexp.MustCompile(`(?i)(?:~\s*|\b(?:around|about|approximately|roughly)\s+)(?:\d{1,2}(?::\d{2})?\s*[ap]m|\d{1,2}:\d{2}|noon|midnight)\b|\b(?:\d{1,2}(?::\d{2})?(?:\s*[ap]m)?|noon|midnight)-?ish\b`),
+	// Exact times: "3pm sharp", "noon on the dot", "exactly 15:00"
+	regexp.MustCompile(`(?i)\b(?:exactly|precisely|promptly)\s+(?:at\s+)?(?:\d{1,2}(?::\d{2})?\s*[ap]m|\d{1,2}:\d{2}|noon|midnight)\b|\b(?:\d{1,2}(?::\d{2})?\s*[ap]m|\d{1,2}:\d{2}|noon|midnight)\s+(?:sharp|on\s+the\s+dot)\b`),
+	// Timestamps, optionally with a fractional part ("1700000000.123456")
+	regexp.MustCompile(`\b\d{10,13}(?:\.\d{1,9})?\b`),
 }
 
-// extractAllDates scans text and extracts all date occurrences.
+// extractAllDates scans text and extracts all date occurrences, in position
+// order. Where matches of several extractionPatterns overlap, the earliest
+// pattern that parses wins. With Settings.MaxResults, the scan stops once
+// that many dates have been found.
 func extractAllDates(ctx *parserContext) ([]ParsedDate, error) {
 	var results []ParsedDate
-	text := ctx.input
+	err := scanDates(ctx, func(date ParsedDate) bool {
+		results = append(results, date)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if ctx.settings.ResolveReferences {
+		results = resolveReferences(ctx, results)
+	}
+	sortByPosition(results)
+	if limit := ctx.settings.MaxResults; limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
 
+// scanDates passes the dates in text to emit in position order, stopping
+// when emit returns false or Settings.MaxResults dates have been emitted.
+// References (Settings.ResolveReferences) are not resolved.
+func scanDates(ctx *parserContext, emit func(ParsedDate) bool) error {
 	// Track processed positions to avoid duplicates
 	processed := make(map[int]bool)
 
+	// Elided lists first, so "3 December 2024" in "1, 2 and 3 December 2024"
+	// is reported once, as part of its list
+	lists := extractDateLists(ctx, processed)
+	sortByPosition(lists)
+
+	scan := &extractionScan{ctx: ctx, processed: processed, lists: lists}
+	scan.collect()
+
+	limit := ctx.settings.MaxResults
+	emitted := 0
+	send := func(date ParsedDate) bool {
+		if tooShort(ctx, date.MatchedText) {
+			return true
+		}
+		date.Context = matchContext(ctx, date.Position, date.Position+date.Length)
+		emitted++
+		return emit(date) && (limit <= 0 || emitted < limit)
+	}
+
+	listsSent := 0
+	for i := range scan.candidates {
+		// Stop early if the caller canceled the scan
+		if ctx.cancel != nil {
+			if err := ctx.cancel.Err(); err != nil {
+				return err
+			}
+		}
+
+		// Lists before this candidate come first
+		start := scan.candidates[i].start
+		for ; listsSent < len(lists) && lists[listsSent].Position < start; listsSent++ {
+			if !send(lists[listsSent]) {
+				return nil
+			}
+		}
+
+		if scan.accept(i) {
+			result := scan.candidates[i].result
+			if end := result.Position + result.Length; end > scan.reach {
+				scan.reach = end
+			}
+			if !send(result) {
+				return nil
+			}
+		}
+	}
+
+	for ; listsSent < len(lists); listsSent++ {
+		if !send(lists[listsSent]) {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// tooShort reports whether matched is shorter than Settings.MinMatchLength
+// runes, so ExtractDates drops it.
+func tooShort(ctx *parserContext, matched string) bool {
+	return utf8.RuneCountInString(matched) < ctx.settings.MinMatchLength
+}
+
+// matchContext returns text[start:end] widened by Settings.ContextWindow
+// runes on either side, or "" when the window is zero.
+func matchContext(ctx *parserContext, start, end int) string {
+	window := ctx.settings.ContextWindow
+	if window <= 0 {
+		return ""
+	}
+
+	text := ctx.input
+	for n := 0; n < window && start > 0; n++ {
+		_, size := utf8.DecodeLastRuneInString(text[:start])
+		start -= size
+	}
+	for n := 0; n < window && end < len(text); n++ {
+		_, size := utf8.DecodeRuneInString(text[end:])
+		end += size
+	}
+	return text[start:end]
+}
+
+// Decisions on an extraction candidate
+const (
+	candidateUndecided = iota
+	candidateAccepted
+	candidateRejected
+)
+
+// extractionCandidate is a match of one of the extractionPatterns, or a bare
+// month name, waiting to be parsed.
+type extractionCandidate struct {
+	start, end int
+	priority   int  // index of the pattern; the lowest wins an overlap
+	bareMonth  bool // a month name without day or year
+	yearless   bool // a numeric date without a year, read in the sibling order
+	state      int
+	result     ParsedDate
+}
+
+// extractionScan holds the candidates of one extractAllDates call, sorted by
+// position, and decides lazily which of them become dates.
+type extractionScan struct {
+	ctx        *parserContext
+	processed  map[int]bool
+	lists      []ParsedDate
+	candidates []extractionCandidate
+	maxLength  int // longest candidate, bounding the overlap search
+	reach      int // end of the furthest date accepted so far in position order
+}
+
+// collect finds every candidate in the text without parsing any of them.
+func (s *extractionScan) collect() {
+	text := s.ctx.input
+
+	for priority, pattern := range extractionPatterns {
+		for _, match := range pattern.FindAllStringIndex(text, -1) {
+			s.add(extractionCandidate{start: match[0], end: match[1], priority: priority})
+		}
+	}
+
+	for _, match := range bareMonthMatches(s.ctx) {
+		s.add(extractionCandidate{start: match[0], end: match[1], priority: len(extractionPatterns), bareMonth: true})
+	}
+
+	// "03/04" next to "15/06": yearless numeric dates are only read when
+	// their siblings prove the order
+	if s.ctx.siblingOrder {
+		for _, match := range yearlessNumericMatches(text) {
+			s.add(extractionCandidate{start: match[0], end: match[1], priority: len(extractionPatterns), yearless: true})
+		}
+	}
+
+	sort.SliceStable(s.candidates, func(i, j int) bool {
+		a, b := s.candidates[i], s.candidates[j]
+		if a.start != b.start {
+			return a.start < b.start
+		}
+		return a.priority < b.priority
+	})
+}
+
+// bareMonthMatches returns the month names of the input written without a
+// day or year that may stand alone as dates, or nil unless
+// Settings.AllowBareMonths is set.
+func bareMonthMatches(ctx *parserContext) [][]int {
+	if !ctx.settings.AllowBareMonths {
+		return nil
+	}
+	var found [][]int
+	for _, match := range bareMonthPattern.FindAllStringIndex(ctx.input, -1) {
+		if bareMonthInContext(ctx, match[0], match[1]) {
+			found = append(found, match)
+		}
+	}
+	return found
+}
+
+// bareMonthInContext reports whether the month name at text[start:end] may
+// stand alone as a date: ambiguous names ("May") and, with
+// RequireMonthContext, all names need a preposition or modifier before them.
+func bareMonthInContext(ctx *parserContext, start, end int) bool {
+	text := ctx.input
+	needsContext := ctx.settings.RequireMonthContext || ambiguousMonths[strings.ToLower(text[start:end])]
+	return !needsContext || monthContextPattern.MatchString(text[max(0, start-monthContextWindow):start])
+}
+
+// containsDate reports whether extractAllDates would find at least one date
+// in the text. Any candidate that parses is either a date or overlaps one,
+// so the first to parse settles it; nothing is ordered or built. Candidates
+// shorter than Settings.MinMatchLength are skipped, as scanDates drops them.
+func containsDate(ctx *parserContext) bool {
+	text := ctx.input
+	parses := func(match []int) bool {
+		if tooShort(ctx, text[match[0]:match[1]]) {
+			return false
+		}
+		_, err := parseDate(text[match[0]:match[1]], ctx.settings, ctx.cache)
+		return err == nil
+	}
+
 	for _, pattern := range extractionPatterns {
-		matches := pattern.FindAllStringIndex(text, -1)
-		for _, match := range matches {
-			start := match[0]
-			end := match[1]
+		for _, match := range pattern.FindAllStringIndex(text, -1) {
+			if parses(match) {
+				return true
+			}
+		}
+	}
+
+	for _, match := range bareMonthMatches(ctx) {
+		if parses(match) {
+			return true
+		}
+	}
+
+	if ctx.siblingOrder {
+		for _, match := range yearlessNumericMatches(text) {
+			if parses(match) {
+				return true
+			}
+		}
+	}
+
+	for _, date := range extractDateLists(ctx, make(map[int]bool)) {
+		if !tooShort(ctx, date.MatchedText) {
+			return true
+		}
+	}
+	return false
+}
+
+// add records a candidate.
+func (s *extractionScan) add(c extractionCandidate) {
+	s.candidates = append(s.candidates, c)
+	if length := c.end - c.start; length > s.maxLength {
+		s.maxLength = length
+	}
+}
+
+// accept reports whether candidate i is a date: it must parse, must not lie
+// in a date list or a date already accepted, and must not overlap a
+// higher-priority candidate that is itself a date ("Dec 2024" inside
+// "31 Dec 2024").
+func (s *extractionScan) accept(i int) bool {
+	c := &s.candidates[i]
+	if c.state != candidateUndecided {
+		return c.state == candidateAccepted
+	}
+	c.state = candidateRejected
+
+	if s.processed[c.start] || c.start < s.reach || overlapsResult(s.lists, c.start, c.end) {
+		return false
+	}
+
+	// Candidates overlapping this one start within maxLength before its end
+	for j := i - 1; j >= 0 && s.candidates[j].start > c.start-s.maxLength; j-- {
+		if s.outranks(j, c) && s.accept(j) {
+			return false
+		}
+	}
+	for j := i + 1; j < len(s.candidates) && s.candidates[j].start < c.end; j++ {
+		if s.outranks(j, c) && s.accept(j) {
+			return false
+		}
+	}
+
+	result, ok := s.parse(c)
+	if !ok {
+		return false
+	}
+	c.result = result
+	c.state = candidateAccepted
+	return true
+}
+
+// outranks reports whether candidate j overlaps c and has a higher priority.
+func (s *extractionScan) outranks(j int, c *extractionCandidate) bool {
+	other := s.candidates[j]
+	return other.priority < c.priority && other.start < c.end && c.start < other.end
+}
+
+// parse parses a candidate into a ParsedDate.
+func (s *extractionScan) parse(c *extractionCandidate) (ParsedDate, bool) {
+	ctx := s.ctx
+	text := ctx.input
+	start, end := c.start, c.end
+	matchedText := text[start:end]
+
+	parsedDate, err := parseDate(matchedText, ctx.settings, ctx.cache)
+	if err != nil {
+		return ParsedDate{}, false
+	}
+
+	if c.bareMonth {
+		return ParsedDate{
+			Date:        parsedDate,
+			Position:    start,
+			Length:      end - start,
+			MatchedText: matchedText,
+			Confidence:  calculateConfidence(matchedText),
+			Granularity: "month",
+		}, true
+	}
+
+	confidence := calculateConfidence(matchedText)
+	ambiguous := isAmbiguousNumericText(matchedText)
+	if (ambiguous || c.yearless) && ctx.siblingOrder {
+		// The order no longer depends on DateOrder
+		ambiguous = false
+		confidence -= siblingOrderPenalty
+	}
+	if ambiguous {
+		confidence = ambiguousConfidence
+	}
+	if weekday, _, ok := splitLeadingWeekday(matchedText, ctx.languages); ok && weekday != parsedDate.Weekday() {
+		confidence -= weekdayMismatchPenalty
+	}
+
+	// Fold a following time of day into the date: "December 31, 2024 at 3:30 PM"
+	if ctx.settings.MergeDateTime {
+		if merged, timeEnd, ok := mergeFollowingTime(ctx, parsedDate, matchedText, end); ok {
+			parsedDate = merged
+			end = timeEnd
+			matchedText = text[start:end]
+		}
+	}
+
+	granularity := matchGranularity(matchedText)
+	if granularity == "time" {
+		if zoned, zoneEnd, ok := absorbFollowingTimezone(text, parsedDate, end); ok {
+			parsedDate = zoned
+			end = zoneEnd
+			matchedText = text[start:end]
+		}
+	}
+	timezoneName, timezoneOffset := matchTimezone(matchedText, parsedDate)
+
+	periodStart, periodEnd := periodBounds(parsedDate, granularity)
+	_, approximate := stripApproximation(matchedText, ctx.languages)
+	modifier := ""
+	if approximate {
+		confidence -= approximatePenalty
+		granularity = "time"
+		modifier = "approximate"
+	}
+	// A precision marker ("3pm sharp") leaves no doubt about the time
+	if _, exact := stripPrecision(matchedText, ctx.languages); exact {
+		confidence = 1.0
+		granularity = "time"
+		approximate = false
+		modifier = ""
+	}
+
+	// Take in a leading "by", "no later than" or "on or about"
+	if qualifierStart, qualifier, ok := precedingDateQualifier(text, start, ctx.languages); ok {
+		start = qualifierStart
+		matchedText = text[start:end]
+		modifier = qualifier
+	}
+	return ParsedDate{
+		Date:        parsedDate,
+		Position:    start,
+		Length:      end - start,
+		MatchedText: matchedText,
+		Confidence:  confidence,
+		Ambiguous:   ambiguous,
+		Granularity: granularity,
+		PeriodStart: periodStart,
+		PeriodEnd:   periodEnd,
+		Approximate: approximate,
+		Modifier:    modifier,
+
+		TimezoneName:   timezoneName,
+		TimezoneOffset: timezoneOffset,
+		LeapSecond:     ctx.settings.AllowLeapSecond && leapSecondPattern.MatchString(matchedText),
+	}, true
+}
+
+// precedingDateQualifier finds a deadline or approximate-date phrase of any
+// of langs written right before text[start:], as in "pay by December 31",
+// and returns where the phrase begins and the modifier it stands for.
+func precedingDateQualifier(text string, start int, langs []*translations.Language) (int, string, bool) {
+	if start == 0 || text[start-1] != ' ' {
+		return 0, "", false
+	}
+	before := text[:start-1]
+	best, modifier := -1, ""
+	for _, lang := range langs {
+		if lang.RelativeTerms == nil {
+			continue
+		}
+		for _, group := range []struct {
+			terms    []string
+			modifier string
+		}{{lang.RelativeTerms.Deadline, "deadline"}, {lang.RelativeTerms.OnOrAbout, "approximate"}} {
+			for _, term := range group.terms {
+				at := len(before) - len(term)
+				if at < 0 || (best >= 0 && at >= best) || !strings.EqualFold(before[at:], term) {
+					continue
+				}
+				// "by" must not match the end of "standby"
+				if prev, _ := utf8.DecodeLastRuneInString(before[:at]); at > 0 && unicode.IsLetter(prev) {
+					continue
+				}
+				best, modifier = at, group.modifier
+			}
+		}
+	}
+	return best, modifier, best >= 0
+}
+
+// leapSecondPattern matches a time written with a leap second: "23:59:60".
+var leapSecondPattern = regexp.MustCompile(`\d:[0-5]\d:60(?:\D|$)`)
+
+// followingTimezonePattern matches a zone written after a time, separated by
+// whitespace: an abbreviation ("PST"), an IANA name ("America/New_York") or
+// a named offset ("UTC+5").
+var followingTimezonePattern = regexp.MustCompile(`^[ \t]+([A-Z]{2,5}|[A-Za-z]+(?:/[A-Za-z_+-]+)+|(?:UTC|GMT)[+-]\d{1,2}(?::\d{2})?)\b`)
+
+// absorbFollowingTimezone extends a time matched at text[:end] over a
+// following zone, as in "3:30 PM PST", and reinterprets the wall-clock time
+// of date in that zone. Matches that already carry a zone are left alone.
+func absorbFollowingTimezone(text string, date time.Time, end int) (time.Time, int, bool) {
+	if _, tzInfo, _ := ExtractTimezone(text[:end]); tzInfo != nil {
+		return time.Time{}, 0, false
+	}
+	m := followingTimezonePattern.FindStringSubmatchIndex(text[end:])
+	if m == nil {
+		return time.Time{}, 0, false
+	}
+	tzInfo, err := ParseTimezone(text[end+m[2] : end+m[3]])
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+	zoned := time.Date(date.Year(), date.Month(), date.Day(),
+		date.Hour(), date.Minute(), date.Second(), date.Nanosecond(), tzInfo.Location)
+	return zoned, end + m[1], true
+}
+
+// matchTimezone returns the ParsedDate TimezoneName and TimezoneOffset for a
+// matched text: the zone's name unless it was written as an offset, and its
+// offset at date. Both are zero when the text carries no zone.
+func matchTimezone(matchedText string, date time.Time) (string, int) {
+	_, tzInfo, _ := ExtractTimezone(matchedText)
+	if tzInfo == nil {
+		return "", 0
+	}
+	_, offset := date.Zone()
+	if tzInfo.isOffset() {
+		return "", offset
+	}
+	return tzInfo.Name, offset
+}
+
+// sortByPosition sorts dates in the order ExtractDates guarantees: by
+// Position ascending and, for dates starting at the same position, longest
+// match first.
+func sortByPosition(dates []ParsedDate) {
+	sort.SliceStable(dates, func(i, j int) bool {
+		if dates[i].Position != dates[j].Position {
+			return dates[i].Position < dates[j].Position
+		}
+		return dates[i].Length > dates[j].Length
+	})
+}
 
-			// Skip if already processed
-			if processed[start] {
+// overlapsResult reports whether text[start:end] overlaps a date already in results.
+func overlapsResult(results []ParsedDate, start, end int) bool {
+	for _, r := range results {
+		if start < r.Position+r.Length && r.Position < end {
+			return true
+		}
+	}
+	return false
+}
+
+// bareMonthPattern matches a full English month name standing alone.
+var bareMonthPattern = regexp.MustCompile(`(?i)\b(?:January|February|March|April|May|June|July|August|September|October|November|December)\b`)
+
+// monthContextPattern matches a preposition or modifier right before a month
+// name that marks it as a date: "in May", "since March", "early August".
+var monthContextPattern = regexp.MustCompile(`(?i)(?:^|[^\p{L}])(?:in|on|by|since|until|till|during|from|through|to|before|after|of|early|late|mid|next|last|this)\s+$`)
+
+// monthContextWindow is how many bytes before a month name are searched for
+// monthContextPattern.
+const monthContextWindow = 24
+
+// ambiguousMonths are month names that are also common English words
+// ("you may go", "they march", "an august body"). Written without a day or
+// year, they need context, a preposition or modifier right before them, to
+// be extracted; Settings.RequireMonthContext asks the same of every month.
+var ambiguousMonths = map[string]bool{"may": true, "march": true, "august": true}
+
+// followingTimePattern matches a time of day at the start of the text after
+// a date match: "3:30 PM", "3pm", "15:30", "15:30:45.250", "noon".
+var followingTimePattern = regexp.MustCompile(`(?i)^(?:\d{1,2}(?::\d{2}(?::\d{2})?)?\s*(?:am|pm)|\d{1,2}:\d{2}(?::\d{2}(?:[.,]\d{1,9})?)?|noon|midnight)\b`)
+
+// mergeFollowingTime looks for a time of day right after the date matched at
+// text[:end], separated only by whitespace, an optional comma and an optional
+// localized connector ("at", "@", "a las"). If one is found, the date is
+// combined with that time and the end of the time is returned. Dates whose
+// matched text already holds a time ("2024-12-31T10:00") are left alone.
+func mergeFollowingTime(ctx *parserContext, date time.Time, matchedText string, end int) (time.Time, int, bool) {
+	if strings.Contains(matchedText, ":") {
+		return time.Time{}, 0, false
+	}
+
+	rest := ctx.input[end:]
+	gap := len(rest) - len(strings.TrimLeft(rest, " \t"))
+	if strings.HasPrefix(rest[gap:], ",") {
+		gap++
+		gap += len(rest[gap:]) - len(strings.TrimLeft(rest[gap:], " \t"))
+	}
+	if gap == 0 {
+		return time.Time{}, 0, false
+	}
+	gap += timeConnectorLength(rest[gap:], ctx.languages)
+
+	loc := followingTimePattern.FindStringIndex(rest[gap:])
+	if loc == nil {
+		return time.Time{}, 0, false
+	}
+	timeText := rest[gap : gap+loc[1]]
+
+	// Parse the time against the extracted date
+	settings := *ctx.settings
+	settings.RelativeBase = date
+	sub := *ctx
+	sub.input = timeText
+	sub.settings = &settings
+	result, err := tryParseTime(&sub)
+	if err != nil {
+		return time.Time{}, 0, false
+	}
+
+	return result, end + gap + loc[1], true
+}
+
+// timeConnectorLength returns the length of a leading time connector ("at ",
+// "@", "a las ") in s, including the whitespace after it, or 0 if s starts
+// with none. Word connectors must be followed by whitespace.
+func timeConnectorLength(s string, langs []*translations.Language) int {
+	for _, lang := range langs {
+		if lang.TimeTerms == nil {
+			continue
+		}
+		for _, connector := range lang.TimeTerms.At {
+			if len(s) < len(connector) || !strings.EqualFold(s[:len(connector)], connector) {
 				continue
 			}
 
-			matchedText := text[start:end]
-
-			// Try to parse the matched text
-			parsedDate, err := ParseDate(matchedText, ctx.settings)
-			if err == nil {
-				results = append(results, ParsedDate{
-					Date:        parsedDate,
-					Position:    start,
-					Length:      end - start,
-					MatchedText: matchedText,
-					Confidence:  calculateConfidence(matchedText),
-				})
-				processed[start] = true
+			after := s[len(connector):]
+			space := len(after) - len(strings.TrimLeft(after, " \t"))
+			if connector == "@" || space > 0 {
+				return len(connector) + space
 			}
 		}
 	}
+	return 0
+}
 
-	return results, nil
+// Patterns classifying an extracted match by the precision it was written with
+var (
+	quarterTextPattern = regexp.MustCompile(`(?i)^Q[1-4]\b`)
+	halfTextPattern    = regexp.MustCompile(`(?i)^H[12]\b`)
+	yearTextPattern    = regexp.MustCompile(`(?i)^(?:\d{4}|['’]\d{2}|FY\s?\d{2})$`)
+	monthTextPattern   = regexp.MustCompile(`(?i)^[a-z]+\s+\d{4}$|^(?:next|last|this)\s+(?:January|February|March|April|May|June|July|August|September|October|November|December)$`)
+	timeTextPattern    = regexp.MustCompile(`(?i):|\d\s*[ap]m\b|\b(?:noon|midnight|seconds?|minutes?|hours?)\b|^\d{10,13}(?:\.\d+)?$`)
+)
+
+// matchGranularity returns the Granularity of an extracted match: "quarter"
+// or "half" for "Q3 2024" and "H1 2024", "year" for a bare or marked year
+// ("2024", "'24"), "month" for a month and year ("May 2024"), "time" when
+// the text holds a time of day or a sub-day offset ("3:30 PM", "2 hours ago",
+// a Unix timestamp), and "day" otherwise.
+func matchGranularity(text string) string {
+	switch {
+	case quarterTextPattern.MatchString(text):
+		return "quarter"
+	case halfTextPattern.MatchString(text):
+		return "half"
+	case yearTextPattern.MatchString(text):
+		return "year"
+	case monthTextPattern.MatchString(text):
+		return "month"
+	case timeTextPattern.MatchString(text):
+		return "time"
+	}
+	return "day"
 }
 
-// calculateConfidence estimates the confidence of a date match.
-func calculateConfidence(text string) float64 {
-	text = strings.TrimSpace(text)
+// periodBounds returns the first and last instant of the period containing
+// date for the "year", "half" and "quarter" granularities, and zero times
+// for the others.
+func periodBounds(date time.Time, granularity string) (time.Time, time.Time) {
+	switch granularity {
+	case "year", "half", "quarter":
+		return getStartOfPeriod(date, granularity, time.Monday), getEndOfPeriod(date, granularity, time.Monday)
+	}
+	return time.Time{}, time.Time{}
+}
+
+// listDayPattern finds the day numbers inside a matched date list.
+var listDayPattern = regexp.MustCompile(`\d{1,2}`)
+
+// extractDateLists expands lists of days sharing a month, such as
+// "Dec 1, 2, and 3" or "1, 2 y 3 de diciembre de 2024", into one ParsedDate
+// per day. The first date spans the month and its day ("Dec 1"), the others
+// only their day number. Lists need one of the language's ListConjunctions
+// before the last day. The start of every date is recorded in processed.
+func extractDateLists(ctx *parserContext, processed map[int]bool) []ParsedDate {
+	var results []ParsedDate
+	text := ctx.input
+
+	for _, lang := range ctx.languages {
+		if len(lang.ListConjunctions) == 0 {
+			continue
+		}
 
-	// ISO format gets highest confidence
-	if regexp.MustCompile(`^\d{4}-\d{2}-\d{2}`).MatchString(text) {
-		return 0.95
+		months := buildMonthPattern([]*translations.Language{lang})
+		conjunctions := make(map[string]bool)
+		for _, conjunction := range lang.ListConjunctions {
+			conjunctions[regexp.QuoteMeta(conjunction)] = true
+		}
+		suffixes := make(map[string]bool)
+		for _, suffix := range lang.OrdinalSuffixes {
+			suffixes[regexp.QuoteMeta(suffix)] = true
+		}
+
+		day := `\d{1,2}\b`
+		if len(suffixes) > 0 {
+			day = `\d{1,2}(?:\b|(?:` + joinAlternatives(suffixes) + `)\b|\.)`
+		}
+		list := fmt.Sprintf(`%s(?:\s*,\s*%s)*,?\s+(?:%s)\s+%s`, day, day, joinAlternatives(conjunctions), day)
+
+		// Month first: "Dec 1, 2, and 3", "December 1, 2 and 3, 2024"
+		monthFirst := ctx.compile(fmt.Sprintf(`(?i)\b(%s)\.?\s+(%s)(?:,?\s+(\d{4})\b)?`, months, list))
+		// Day first: "1, 2 and 3 December 2024", "1, 2 y 3 de diciembre"
+		dayFirst := ctx.compile(fmt.Sprintf(`(?i)\b(%s)\s+(?:(?:de|of)\s+)?(%s)\b(?:,?\s+(?:de\s+)?(\d{4})\b)?`, list, months))
+
+		for _, m := range monthFirst.FindAllStringSubmatchIndex(text, -1) {
+			results = append(results, expandDateList(ctx, processed, text[m[2]:m[3]], m[4], m[5], yearGroup(text, m, 6), m[2])...)
+		}
+		for _, m := range dayFirst.FindAllStringSubmatchIndex(text, -1) {
+			dates := expandDateList(ctx, processed, text[m[4]:m[5]], m[2], m[3], yearGroup(text, m, 6), -1)
+			if len(dates) > 0 {
+				// The trailing month belongs to the list, not to a date of its own
+				processed[m[4]] = true
+			}
+			results = append(results, dates...)
+		}
 	}
 
-	// Month names get high confidence
-	if regexp.MustCompile(`(?i)(january|february|march|april|may|june|july|august|september|october|november|december)`).MatchString(text) {
-		return 0.90
+	return results
+}
+
+// yearGroup returns the text of submatch group at index i of m, or "".
+func yearGroup(text string, m []int, i int) string {
+	if m[i] < 0 {
+		return ""
 	}
+	return text[m[i]:m[i+1]]
+}
 
-	// Relative dates with numbers
-	if regexp.MustCompile(`\d+\s+(day|week|month|year)s?\s+ago`).MatchString(text) {
-		return 0.85
+// expandDateList parses every day number in text[listStart:listEnd] as a
+// date in month and year. If monthStart is not negative, the first date also
+// covers the month name written before it.
+func expandDateList(ctx *parserContext, processed map[int]bool, month string, listStart, listEnd int, year string, monthStart int) []ParsedDate {
+	text := ctx.input
+	var dates []ParsedDate
+
+	for i, loc := range listDayPattern.FindAllStringIndex(text[listStart:listEnd], -1) {
+		start, end := listStart+loc[0], listStart+loc[1]
+		if i == 0 && monthStart >= 0 {
+			start = monthStart
+		}
+		if processed[start] {
+			continue
+		}
+
+		// Rebuild a complete "December 2, 2024" for the regular parsers
+		canonical := month + " " + text[listStart+loc[0]:end]
+		if year != "" {
+			canonical += ", " + year
+		}
+		date, err := parseDate(canonical, ctx.settings, ctx.cache)
+		if err != nil {
+			continue
+		}
+
+		dates = append(dates, ParsedDate{
+			Date:        date,
+			Position:    start,
+			Length:      end - start,
+			MatchedText: text[start:end],
+			Confidence:  calculateConfidence(canonical),
+			Granularity: "day",
+		})
+		processed[start] = true
 	}
 
-	// Common relative terms
-	if regexp.MustCompile(`(?i)(yesterday|today|tomorrow|next|last)`).MatchString(text) {
-		return 0.80
+	return dates
+}
+
+// ambiguousConfidence is the confidence given to numeric dates whose
+// meaning depends on DateOrder (lower than any unambiguous match).
+const ambiguousConfidence = 0.50
+
+// numericDateRegex matches all-numeric day/month/year dates such as "03/04/2024".
+var numericDateRegex = regexp.MustCompile(`^(\d{1,2})[/.-](\d{1,2})[/.-](\d{2,4})$`)
+
+// isAmbiguousNumericText reports whether text is a numeric date that reads
+// differently as MDY and DMY ("03/04/2024" but not "25/04/2024" or "04/04/2024").
+func isAmbiguousNumericText(text string) bool {
+	matches := numericDateRegex.FindStringSubmatch(strings.TrimSpace(text))
+	if matches == nil {
+		return false
 	}
+	num1, _ := strconv.Atoi(matches[1])
+	num2, _ := strconv.Atoi(matches[2])
+	year, _ := strconv.Atoi(matches[3])
+	return isAmbiguousDate(num1, num2, year)
+}
 
-	// Numeric dates (more ambiguous)
-	if regexp.MustCompile(`^\d{1,2}[/-]\d{1,2}[/-]\d{4}$`).MatchString(text) {
-		return 0.75
+// numericSiblingRegex finds numeric day/month/year dates anywhere in text.
+var numericSiblingRegex = regexp.MustCompile(`\b(\d{1,2})[/.-](\d{1,2})[/.-](\d{4}|\d{2})\b`)
+
+// yearlessNumericRegex finds day/month dates without a year, such as
+// "15/06". Only slashes are read, so decimals such as "3.14" are not dates.
+var yearlessNumericRegex = regexp.MustCompile(`\b(\d{1,2})/(\d{1,2})\b`)
+
+// yearlessNumericMatches returns the submatch indexes of the yearless
+// numeric dates of text, skipping those that are part of a longer numeric
+// date ("2024/12/31", "12/31/2024").
+func yearlessNumericMatches(text string) [][]int {
+	var found [][]int
+	for _, m := range yearlessNumericRegex.FindAllStringSubmatchIndex(text, -1) {
+		if m[0] > 0 && strings.IndexByte("/.-", text[m[0]-1]) >= 0 {
+			continue
+		}
+		if m[1]+1 < len(text) && strings.IndexByte("/.-", text[m[1]]) >= 0 && '0' <= text[m[1]+1] && text[m[1]+1] <= '9' {
+			continue
+		}
+		found = append(found, m)
 	}
+	return found
+}
 
-	// Timestamps
-	if regexp.MustCompile(`^\d{10,13}$`).MatchString(text) {
-		return 0.70
+// siblingDateOrder returns the order, "DMY" or "MDY", proven by the numeric
+// dates of text that read only one way ("15/06/2024" and "15/06" are DMY),
+// or "" if there are none or they disagree.
+func siblingDateOrder(text string) string {
+	var pairs [][2]string
+	for _, m := range numericSiblingRegex.FindAllStringSubmatch(text, -1) {
+		pairs = append(pairs, [2]string{m[1], m[2]})
+	}
+	for _, m := range yearlessNumericMatches(text) {
+		pairs = append(pairs, [2]string{text[m[2]:m[3]], text[m[4]:m[5]]})
+	}
+
+	order := ""
+	for _, pair := range pairs {
+		num1, _ := strconv.Atoi(pair[0])
+		num2, _ := strconv.Atoi(pair[1])
+		var proven string
+		switch {
+		case num1 > 12 && num1 <= 31 && num2 >= 1 && num2 <= 12:
+			proven = "DMY"
+		case num2 > 12 && num2 <= 31 && num1 >= 1 && num1 <= 12:
+			proven = "MDY"
+		default:
+			continue
+		}
+		if order != "" && order != proven {
+			return ""
+		}
+		order = proven
 	}
+	return order
+}
 
+// confidenceLevels lists the forms calculateConfidence recognizes, in the
+// order it tries them, with their confidence.
+var confidenceLevels = []struct {
+	pattern    *regexp.Regexp
+	confidence float64
+}{
+	// ISO and other year-first formats get highest confidence
+	{regexp.MustCompile(`^\d{4}(?:-\d{2}-\d{2}|[/.]\d{1,2}[/.]\d{1,2})`), 0.95},
+	// Month names get high confidence
+	{regexp.MustCompile(`(?i)(january|february|march|april|may|june|july|august|september|october|november|december)`), 0.90},
+	// Relative dates with numbers
+	{regexp.MustCompile(`\d+\s+(day|week|month|year)s?\s+ago`), 0.85},
+	// Common relative terms
+	{regexp.MustCompile(`(?i)(yesterday|today|tomorrow|next|last)`), 0.80},
+	// Numeric dates (more ambiguous)
+	{regexp.MustCompile(`^\d{1,2}[/.-]\d{1,2}[/.-]\d{4}$`), 0.75},
+	// Timestamps
+	{regexp.MustCompile(`^\d{10,13}(?:\.\d{1,9})?$`), 0.70},
+}
+
+// calculateConfidence estimates the confidence of a date match.
+func calculateConfidence(text string) float64 {
+	text = strings.TrimSpace(text)
+	for _, level := range confidenceLevels {
+		if level.pattern.MatchString(text) {
+			return level.confidence
+		}
+	}
 	return 0.60
 }
@@ -1,6 +1,8 @@
 package godateparser
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 )
@@ -128,6 +130,53 @@ func TestIncompleteDate_MonthAndDay(t *testing.T) {
 	}
 }
 
+func TestIncompleteDate_NumericMonthAndDay(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		dateOrder string
+		past      bool
+		want      time.Time
+	}{
+		{"DMY forced by 31", "31/12", "MDY", false, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"MDY forced by 31", "12/31", "DMY", false, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"ambiguous MDY", "03/04", "MDY", false, time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"ambiguous DMY", "03/04", "DMY", false, time.Date(2025, 4, 3, 0, 0, 0, 0, time.UTC)},
+		{"ambiguous default order", "3/4", "", false, time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"past", "12/31", "MDY", true, time.Date(2023, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"today", "10/15", "MDY", false, time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"dotted with closing period", "31.12.", "DMY", false, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"leap day", "2/29", "MDY", false, time.Date(2028, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"leap day in the past", "29/2", "DMY", true, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, DateOrder: tt.dateOrder}
+			if tt.past {
+				settings.PreferDatesFrom = "past"
+			}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	for _, input := range []string{"13/13", "2/30", "31.12", "0/5"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			if result, err := ParseDate(input, &Settings{RelativeBase: base}); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		})
+	}
+}
+
 // Ordinal Date Tests
 
 func TestOrdinalDate_Basic(t *testing.T) {
@@ -157,6 +206,64 @@ func TestOrdinalDate_Basic(t *testing.T) {
 	}
 }
 
+func TestOrdinalDate_DayOfMonth(t *testing.T) {
+	before := time.Date(2024, 10, 10, 12, 0, 0, 0, time.UTC)
+	after := time.Date(2024, 10, 20, 12, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		base      time.Time
+		prefer    string
+		languages []string
+		want      time.Time
+	}{
+		{"future before the day", "the 15th", before, "", nil, time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"future after the day", "the 15th", after, "", nil, time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC)},
+		{"past before the day", "the 15th", before, "past", nil, time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC)},
+		{"past after the day", "the 15th", after, "past", nil, time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"on the", "on the 3rd", after, "", nil, time.Date(2024, 11, 3, 0, 0, 0, 0, time.UTC)},
+		{"spanish", "el 15", before, "", []string{"es"}, time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"french", "le 1er", after, "", []string{"fr"}, time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"german", "am 15.", before, "", []string{"de"}, time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"italian", "il 15", after, "", []string{"it"}, time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC)},
+		{"portuguese", "dia 15", before, "", []string{"pt"}, time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"dutch", "de 15e", before, "", []string{"nl"}, time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)},
+		{"japanese", "15日", after, "", []string{"ja"}, time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC)},
+		{"chinese", "15号", before, "", []string{"zh"}, time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)},
+		// Months without the day are skipped
+		{"30th after january 31", "the 30th", time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC), "", nil, time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC)},
+		{"29th in leap february", "the 29th", feb, "", nil, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"29th in common february", "the 29th", time.Date(2023, 2, 10, 12, 0, 0, 0, time.UTC), "", nil, time.Date(2023, 3, 29, 0, 0, 0, 0, time.UTC)},
+		{"30th in february", "the 30th", feb, "", nil, time.Date(2024, 3, 30, 0, 0, 0, 0, time.UTC)},
+		{"31st in february", "the 31st", feb, "", nil, time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+		{"31st in february past", "the 31st", feb, "past", nil, time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		{"30th in march past", "the 30th", time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC), "past", nil, time.Date(2024, 1, 30, 0, 0, 0, 0, time.UTC)},
+		{"31st after march 31", "31st", time.Date(2024, 4, 5, 12, 0, 0, 0, time.UTC), "", nil, time.Date(2024, 5, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &Settings{RelativeBase: tt.base, PreferDatesFrom: tt.prefer, Languages: tt.languages}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	// A bare number is not a day of the month
+	for _, input := range []string{"15", "the 40th"} {
+		if _, err := ParseDate(input, &Settings{RelativeBase: before}); err == nil {
+			t.Errorf("ParseDate(%q) should fail", input)
+		}
+	}
+}
+
 func TestOrdinalDate_WithMonth(t *testing.T) {
 	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
 	settings := &Settings{RelativeBase: base}
@@ -265,6 +372,44 @@ func TestWeekNumber_WithWeekday(t *testing.T) {
 	}
 }
 
+func TestWeekNumber_ISOWeekDate(t *testing.T) {
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"2024-W01-1", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},   // start of ISO year 2024
+		{"2024-W52-7", time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC)}, // last day of ISO year 2024
+		{"2025-W01-1", time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)}, // ISO year 2025 starts in December 2024
+		{"2020-W53-5", time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)},   // ISO year 2020 ends in January 2021
+		{"2024W153", time.Date(2024, 4, 10, 0, 0, 0, 0, time.UTC)},    // basic format
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, nil)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	for _, input := range []string{"2024-W15-8", "2024-W15-0"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseDate(input, nil)
+			var invalid *ErrInvalidDate
+			if !errors.As(err, &invalid) {
+				t.Fatalf("ParseDate(%q) error = %v, want ErrInvalidDate", input, err)
+			}
+			if want := input[len(input)-1:]; invalid.Fragment != want || invalid.Position != len(input)-1 {
+				t.Errorf("ParseDate(%q) fragment = %q at %d, want %q at %d", input, invalid.Fragment, invalid.Position, want, len(input)-1)
+			}
+		})
+	}
+}
+
 func TestWeekNumber_NaturalLanguage(t *testing.T) {
 	tests := []struct {
 		input     string
@@ -289,6 +434,95 @@ func TestWeekNumber_NaturalLanguage(t *testing.T) {
 	}
 }
 
+func TestWeekNumber_RelativeWeeks(t *testing.T) {
+	saturday := time.Date(2024, 12, 28, 15, 0, 0, 0, time.UTC)
+	monday := time.Date(2024, 12, 30, 15, 0, 0, 0, time.UTC)
+	weekOnly := []string{"week"}
+
+	tests := []struct {
+		name     string
+		input    string
+		base     time.Time
+		settings Settings
+		want     time.Time
+	}{
+		{"W+1 across year boundary", "W+1", saturday, Settings{}, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{"W+1 from Monday", "W+1", monday, Settings{}, time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{"W-2", "W-2", monday, Settings{}, time.Date(2024, 12, 16, 0, 0, 0, 0, time.UTC)},
+		{"week +3", "week +3", monday, Settings{}, time.Date(2025, 1, 20, 0, 0, 0, 0, time.UTC)},
+		{"W+1 sunday start", "W+1", saturday, Settings{WeekStartsOn: "sunday"}, time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{"next week across year boundary", "next week", saturday, Settings{}, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{"next week from Monday", "next week", monday, Settings{}, time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{"last week", "last week", monday, Settings{}, time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC)},
+		{"this week", "this week", saturday, Settings{}, time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC)},
+		{"in 3 weeks", "in 3 weeks", saturday, Settings{}, time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)},
+		// Other week offsets stay plain durations
+		{"2 weeks ago", "2 weeks ago", monday, Settings{}, time.Date(2024, 12, 16, 15, 0, 0, 0, time.UTC)},
+		{"in a few weeks", "in a few weeks", monday, Settings{}, time.Date(2025, 1, 20, 15, 0, 0, 0, time.UTC)},
+		{"next week sunday start", "next week", saturday, Settings{WeekStartsOn: "sunday"}, time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{"next week week parser", "next week", saturday, Settings{EnableParsers: weekOnly}, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{"french postposed", "semaine prochaine", saturday, Settings{Languages: []string{"fr"}}, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		{"spanish next week", "próxima semana", monday, Settings{Languages: []string{"es"}}, time.Date(2025, 1, 6, 0, 0, 0, 0, time.UTC)},
+		{"spanish signed", "semana -1", monday, Settings{Languages: []string{"es"}}, time.Date(2024, 12, 23, 0, 0, 0, 0, time.UTC)},
+		{"japanese next week", "来週", saturday, Settings{Languages: []string{"ja"}}, time.Date(2024, 12, 30, 0, 0, 0, 0, time.UTC)},
+		// Without the week parser, weeks are plain durations
+		{"relative parser only", "next week", saturday, Settings{EnableParsers: []string{"relative"}}, time.Date(2025, 1, 4, 15, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := tt.settings
+			settings.RelativeBase = tt.base
+			result, err := ParseDate(tt.input, &settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestWeekNumber_WeekOf(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		weekStart string
+		want      time.Time
+	}{
+		{"mid-week to Monday", "week of 2024-03-06", "", time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"mid-week to Sunday", "week of 2024-03-06", "sunday", time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"Sunday date, Monday weeks", "the week of 2024-03-03", "", time.Date(2024, 2, 26, 0, 0, 0, 0, time.UTC)},
+		{"already on Sunday boundary", "the week of 2024-03-03", "sunday", time.Date(2024, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"already on Monday boundary", "Week of March 4, 2024", "", time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},
+		{"month and day", "week of March 5", "", time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"relative date", "week of tomorrow", "", time.Date(2024, 10, 14, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, WeekStartsOn: tt.weekStart})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("invalid inner date", func(t *testing.T) {
+		_, err := ParseDate("week of 2024-02-30", &Settings{RelativeBase: base})
+		var invalidErr *ErrInvalidDate
+		if !errors.As(err, &invalidErr) {
+			t.Errorf("ParseDate(\"week of 2024-02-30\") error = %v, want ErrInvalidDate", err)
+		}
+	})
+}
+
 // Natural Time Expression Tests
 
 func TestNaturalTime_QuarterPast(t *testing.T) {
@@ -320,6 +554,92 @@ func TestNaturalTime_QuarterPast(t *testing.T) {
 	}
 }
 
+func TestNaturalTime_DayParts(t *testing.T) {
+	base := time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		input        string
+		languages    []string
+		dayPartTimes map[string]string
+		wantDay      int
+		wantHour     int
+		wantMinute   int
+	}{
+		{"midday", "midday", nil, nil, 15, 12, 0},
+		{"teatime", "teatime", nil, nil, 15, 16, 0},
+		{"two words", "tea time", nil, nil, 15, 16, 0},
+		{"dinnertime", "Dinnertime", nil, nil, 15, 19, 0},
+		{"dawn", "dawn", nil, nil, 15, 6, 0},
+		{"dusk", "dusk", nil, nil, 15, 18, 0},
+		{"with a day", "tomorrow at teatime", nil, nil, 16, 16, 0},
+		{"French", "l'heure du thé", []string{"fr"}, nil, 15, 16, 0},
+		{"German", "Kaffeezeit", []string{"de"}, nil, 15, 15, 0},
+		{"override", "teatime", nil, map[string]string{"teatime": "17:15"}, 15, 17, 15},
+		{"override is case-insensitive", "Teatime", nil, map[string]string{"TEATIME": "17:15"}, 15, 17, 15},
+		{"override with a day", "tomorrow at teatime", nil, map[string]string{"teatime": "17:15"}, 16, 17, 15},
+		{"new name", "elevenses", nil, map[string]string{"elevenses": "11:00"}, 15, 11, 0},
+		{"dusk configured", "dusk", nil, map[string]string{"dusk": "20:30"}, 15, 20, 30},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, Languages: tt.languages, DayPartTimes: tt.dayPartTimes}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if result.Day() != tt.wantDay || result.Hour() != tt.wantHour || result.Minute() != tt.wantMinute {
+				t.Errorf("ParseDate(%q) = %v, want day %d %02d:%02d",
+					tt.input, result, tt.wantDay, tt.wantHour, tt.wantMinute)
+			}
+		})
+	}
+}
+
+func TestNaturalTime_SpokenTimes(t *testing.T) {
+	base := time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)
+	settings := &Settings{RelativeBase: base}
+
+	tests := []struct {
+		input      string
+		wantHour   int
+		wantMinute int
+	}{
+		{"half ten", 10, 30},
+		{"Half Ten", 10, 30},
+		{"half 4", 4, 30},
+		{"ten to six", 5, 50},
+		{"ten to one", 12, 50},
+		{"twenty past eight", 8, 20},
+		{"twenty-five past eight", 8, 25},
+		{"twenty five to nine", 8, 35},
+		{"quarter past nine", 9, 15},
+		{"quarter to twelve", 11, 45},
+		{"half past ten", 10, 30},
+		{"five past 3", 3, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate() error = %v", err)
+			}
+			if result.Hour() != tt.wantHour || result.Minute() != tt.wantMinute {
+				t.Errorf("ParseDate(%q) = %v:%v, want %v:%v",
+					tt.input, result.Hour(), result.Minute(), tt.wantHour, tt.wantMinute)
+			}
+		})
+	}
+
+	t.Run("digit minutes are not spoken times", func(t *testing.T) {
+		if result, err := ParseDate("10 to 6", settings); err == nil {
+			t.Errorf("ParseDate(\"10 to 6\") = %v, want error", result)
+		}
+	})
+}
+
 func TestNaturalTime_HalfPast(t *testing.T) {
 	base := time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)
 	settings := &Settings{RelativeBase: base}
@@ -402,3 +722,1245 @@ func BenchmarkFeatures_NaturalTime(b *testing.B) {
 		_, _ = ParseDate("quarter past 3", settings)
 	}
 }
+
+// Decimal Separator Tests
+
+func TestDecimalSeparator_FractionalSeconds(t *testing.T) {
+	base := time.Date(2024, 10, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		settings  *Settings
+		wantNanos int
+		wantErr   bool
+	}{
+		{"English period", "10:30:45.500", &Settings{RelativeBase: base, Languages: []string{"en"}}, 500000000, false},
+		{"German comma", "10:30:45,500", &Settings{RelativeBase: base, Languages: []string{"de"}}, 500000000, false},
+		{"French comma", "10:30:45,25", &Settings{RelativeBase: base, Languages: []string{"fr"}}, 250000000, false},
+		{"Override comma", "10:30:45,5", &Settings{RelativeBase: base, DecimalSeparator: ","}, 500000000, false},
+		{"English rejects comma", "10:30:45,500", &Settings{RelativeBase: base, Languages: []string{"en"}}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, tt.settings)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseDate(%q) expected error, got %v", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate() error = %v", err)
+			}
+			if result.Hour() != 10 || result.Minute() != 30 || result.Second() != 45 {
+				t.Errorf("ParseDate(%q) = %v, want 10:30:45", tt.input, result)
+			}
+			if result.Nanosecond() != tt.wantNanos {
+				t.Errorf("ParseDate(%q) nanos = %d, want %d", tt.input, result.Nanosecond(), tt.wantNanos)
+			}
+		})
+	}
+}
+
+func TestDecimalSeparator_RelativeAmounts(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		settings *Settings
+		want     time.Time
+	}{
+		{"English decimal", "in 12.5 hours", &Settings{RelativeBase: base}, base.Add(12*time.Hour + 30*time.Minute)},
+		{"English ago", "1.5 hours ago", &Settings{RelativeBase: base}, base.Add(-90 * time.Minute)},
+		{"English thousands", "1,000 days ago", &Settings{RelativeBase: base}, base.AddDate(0, 0, -1000)},
+		{"Override comma", "in 12,5 hours", &Settings{RelativeBase: base, DecimalSeparator: ","}, base.Add(12*time.Hour + 30*time.Minute)},
+		{"German comma", "in 12,5 Stunden", &Settings{RelativeBase: base, Languages: []string{"de"}}, base.Add(12*time.Hour + 30*time.Minute)},
+		{"Spanish comma", "hace 2,5 horas", &Settings{RelativeBase: base, Languages: []string{"es"}}, base.Add(-150 * time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, tt.settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecimalSeparator_CommaListNotDecimal(t *testing.T) {
+	// With "." as decimal separator, "12,5" is neither a decimal nor a thousands group
+	settings := &Settings{RelativeBase: time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)}
+	if result, err := ParseDate("in 12,5 hours", settings); err == nil {
+		t.Errorf("ParseDate(\"in 12,5 hours\") = %v, want error", result)
+	}
+
+	results, err := ExtractDates("Meetings on 12/01/2024, 12/05/2024", nil)
+	if err != nil {
+		t.Fatalf("ExtractDates() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("ExtractDates() found %d dates, want 2", len(results))
+	}
+}
+
+func TestDecimalSeparator_FractionalTimestamp(t *testing.T) {
+	result, err := ParseDate("1700000000,25", &Settings{Languages: []string{"fr"}})
+	if err != nil {
+		t.Fatalf("ParseDate() error = %v", err)
+	}
+	if result.Unix() != 1700000000 || result.Nanosecond() != 250000000 {
+		t.Errorf("ParseDate() = %v, want 1700000000.25", result)
+	}
+}
+
+func TestGroupedTimestamps(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		languages []string
+		want      time.Time
+	}{
+		{"English seconds", "1,702,635,045", nil, time.Unix(1702635045, 0)},
+		{"English fraction", "1,702,635,045.5", nil, time.Unix(1702635045, 500000000)},
+		{"English milliseconds", "1,702,635,045,123", nil, time.Unix(1702635045, 123000000)},
+		{"German seconds", "1.702.635.045", []string{"de"}, time.Unix(1702635045, 0)},
+		{"German fraction", "1.702.635.045,25", []string{"de"}, time.Unix(1702635045, 250000000)},
+		{"ungrouped still parses", "1702635045", nil, time.Unix(1702635045, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{Languages: tt.languages, GroupedTimestamps: true})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want.UTC())
+			}
+		})
+	}
+
+	rejected := []struct {
+		name      string
+		input     string
+		languages []string
+	}{
+		{"comma list", "17,26,35", nil},
+		{"list with spaces", "1,702, 635,045", nil},
+		{"short group", "1,702,635,04", nil},
+		{"too many digits", "1,702,635,045,123,456", nil},
+		{"grouped year", "2,024", nil},
+		{"wrong locale separator", "1.702.635.045", nil},
+		{"German comma grouping", "1,702,635,045", []string{"de"}},
+	}
+
+	for _, tt := range rejected {
+		t.Run(tt.name, func(t *testing.T) {
+			if result, err := ParseDate(tt.input, &Settings{Languages: tt.languages, GroupedTimestamps: true}); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", tt.input, result)
+			}
+		})
+	}
+
+	t.Run("off by default", func(t *testing.T) {
+		if result, err := ParseDate("1,702,635,045", nil); err == nil {
+			t.Errorf("ParseDate(\"1,702,635,045\") = %v, want error without GroupedTimestamps", result)
+		}
+	})
+}
+
+func TestSubSecondPrecision(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		text      string
+		matched   string
+		wantNanos int
+	}{
+		{"ISO 6 digits", "logged at 2024-12-31T10:30:45.123456Z", "2024-12-31T10:30:45.123456Z", 123456000},
+		{"ISO 9 digits", "logged at 2024-12-31T10:30:45.123456789Z", "2024-12-31T10:30:45.123456789Z", 123456789},
+		{"timestamp 6 digits", "event 1700000000.123456 fired", "1700000000.123456", 123456000},
+		{"timestamp 9 digits", "event 1700000000.123456789 fired", "1700000000.123456789", 123456789},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ExtractDates(tt.text, &Settings{RelativeBase: base})
+			if err != nil {
+				t.Fatalf("ExtractDates(%q) error = %v", tt.text, err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("ExtractDates(%q) returned %d dates, want 1", tt.text, len(results))
+			}
+			if results[0].MatchedText != tt.matched {
+				t.Errorf("MatchedText = %q, want %q", results[0].MatchedText, tt.matched)
+			}
+			if got := results[0].Date.Nanosecond(); got != tt.wantNanos {
+				t.Errorf("Nanosecond() = %d, want %d", got, tt.wantNanos)
+			}
+		})
+	}
+
+	t.Run("time only", func(t *testing.T) {
+		for input, wantNanos := range map[string]int{"10:30:45.123456": 123456000, "10:30:45.123456789": 123456789} {
+			result, err := ParseDate(input, &Settings{RelativeBase: base})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", input, err)
+			}
+			if result.Nanosecond() != wantNanos {
+				t.Errorf("ParseDate(%q) nanos = %d, want %d", input, result.Nanosecond(), wantNanos)
+			}
+		}
+	})
+}
+
+// DefaultTime Tests
+
+func TestDefaultTime_DateOnlyInputs(t *testing.T) {
+	tokyo := time.FixedZone("JST", 9*60*60)
+	base := time.Date(2024, 10, 15, 8, 20, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		input       string
+		defaultTime time.Duration
+		want        time.Time
+	}{
+		{"iso noon", "2024-12-31", 12 * time.Hour, time.Date(2024, 12, 31, 12, 0, 0, 0, tokyo)},
+		{"month name end of day", "December 31, 2024", 24*time.Hour - time.Nanosecond, time.Date(2024, 12, 31, 23, 59, 59, 999999999, tokyo)},
+		{"incomplete date", "December 31", 17*time.Hour + 30*time.Minute, time.Date(2024, 12, 31, 17, 30, 0, 0, tokyo)},
+		{"zero keeps midnight", "2024-12-31", 0, time.Date(2024, 12, 31, 0, 0, 0, 0, tokyo)},
+		{"explicit time wins", "2024-12-31T00:00:00", 12 * time.Hour, time.Date(2024, 12, 31, 0, 0, 0, 0, tokyo)},
+		{"explicit time with weekday", "Tuesday 2024-12-31 08:15", 12 * time.Hour, time.Date(2024, 12, 31, 8, 15, 0, 0, tokyo)},
+		{"relative keeps base clock", "tomorrow", 12 * time.Hour, time.Date(2024, 10, 16, 8, 20, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, PreferredTimezone: tokyo, DefaultTime: tt.defaultTime}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestRelative_FractionalAmounts(t *testing.T) {
+	base := time.Date(2024, 1, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		settings *Settings
+		want     time.Time
+	}{
+		{"weeks ago", "1.5 weeks ago", &Settings{RelativeBase: base}, base.AddDate(0, 0, -10).Add(-12 * time.Hour)},
+		{"hours from now", "2.5 hours from now", &Settings{RelativeBase: base}, base.Add(150 * time.Minute)},
+		{"in days", "in 1.5 days", &Settings{RelativeBase: base}, base.AddDate(0, 0, 1).Add(12 * time.Hour)},
+		{"fortnight", "0.5 fortnight ago", &Settings{RelativeBase: base}, base.AddDate(0, 0, -7)},
+		{"months approximate", "in 1.5 months", &Settings{RelativeBase: base}, base.AddDate(0, 1, 15)},
+		{"years approximate", "0.5 years ago", &Settings{RelativeBase: base}, base.AddDate(0, -6, 0)},
+		{"German weeks", "vor 1,5 Wochen", &Settings{RelativeBase: base, Languages: []string{"de"}}, base.AddDate(0, 0, -10).Add(-12 * time.Hour)},
+		{"Spanish days", "en 2,5 días", &Settings{RelativeBase: base, Languages: []string{"es"}}, base.AddDate(0, 0, 2).Add(12 * time.Hour)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, tt.settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("strict rejects fractional months", func(t *testing.T) {
+		_, err := ParseDate("in 1.5 months", &Settings{RelativeBase: base, Strict: true})
+		var ambiguous *ErrAmbiguousDate
+		if !errors.As(err, &ambiguous) {
+			t.Fatalf("expected ErrAmbiguousDate, got %v", err)
+		}
+	})
+
+	t.Run("strict allows fractional weeks", func(t *testing.T) {
+		if _, err := ParseDate("1.5 weeks ago", &Settings{RelativeBase: base, Strict: true}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+}
+
+func TestFuzzyMatching(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC) // Tuesday
+	fuzzy := &Settings{RelativeBase: base, FuzzyMatching: true}
+
+	tests := []struct {
+		name    string
+		input   string
+		want    time.Time
+		wantErr bool
+	}{
+		{"deleted letter", "Decembr 25, 2024", time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC), false},
+		{"swapped letters", "Setpember 3 2024", time.Date(2024, 9, 3, 0, 0, 0, 0, time.UTC), false},
+		{"swapped weekday letters", "Wendesday", time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC), false},
+		{"substituted letter", "last Fridey", time.Date(2024, 10, 11, 12, 0, 0, 0, time.UTC), false},
+		{"two typos rejected", "Dcembr 25, 2024", time.Time{}, true},
+		{"two typos in weekday rejected", "Wensday", time.Time{}, true},
+		{"short names must be exact", "Jnue 5 2024", time.Time{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, fuzzy)
+			if tt.wantErr {
+				if err == nil {
+					t.Errorf("ParseDate(%q) = %v, want error", tt.input, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("off by default", func(t *testing.T) {
+		if _, err := ParseDate("Decembr 25, 2024", &Settings{RelativeBase: base}); err == nil {
+			t.Error("ParseDate(\"Decembr 25, 2024\") succeeded without FuzzyMatching")
+		}
+	})
+
+	t.Run("extraction", func(t *testing.T) {
+		text := "We met Setpember 3 2024 and Decembr 25 2024."
+		want := []struct {
+			original string
+			date     time.Time
+		}{
+			{"Setpember 3 2024", time.Date(2024, 9, 3, 0, 0, 0, 0, time.UTC)},
+			{"Decembr 25 2024", time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
+		}
+
+		results, err := ExtractDates(text, fuzzy)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != len(want) {
+			t.Fatalf("ExtractDates() = %+v, want %d dates", results, len(want))
+		}
+		for i, result := range results {
+			if got := text[result.Position : result.Position+result.Length]; got != want[i].original || !result.Date.Equal(want[i].date) {
+				t.Errorf("result %d = %q (%v), want %q (%v)", i, got, result.Date, want[i].original, want[i].date)
+			}
+		}
+		if !ContainsDate(text, fuzzy) {
+			t.Error("ContainsDate() = false, want true")
+		}
+
+		results, err = ExtractDates(text, &Settings{RelativeBase: base})
+		if err != nil || len(results) != 0 {
+			t.Errorf("ExtractDates() without FuzzyMatching = %+v, %v, want none", results, err)
+		}
+	})
+}
+
+func TestDisableFeatures(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		feature  string
+		disabled []string // inputs rejected once the feature is disabled
+		kept     []string // inputs of the same parser that still parse
+	}{
+		{"bare_year", []string{"2024", "1999"}, []string{"May", "June 15", "15 June"}},
+		{"two_digit_year", []string{"12/31/24", "Dec 31 24", "31 December 24", "3rd June 24"}, []string{"12/31/2024", "Dec 31 2024", "3rd June 2024"}},
+		{"ordinal_words", []string{"3rd", "3rd June", "3rd of June 2024", "March 1st, 2024"}, []string{"March 1, 2024", "June 3"}},
+		{"fuzzy", []string{"Decembr 25, 2024"}, []string{"December 25, 2024"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.feature, func(t *testing.T) {
+			enabled := &Settings{RelativeBase: base, FuzzyMatching: true}
+			disabled := &Settings{RelativeBase: base, FuzzyMatching: true, DisableFeatures: []string{tt.feature}}
+
+			for _, input := range tt.disabled {
+				if _, err := ParseDate(input, enabled); err != nil {
+					t.Errorf("ParseDate(%q) with %s enabled error = %v", input, tt.feature, err)
+				}
+				if result, err := ParseDate(input, disabled); err == nil {
+					t.Errorf("ParseDate(%q) with %s disabled = %v, want error", input, tt.feature, result)
+				}
+			}
+			for _, input := range tt.kept {
+				if _, err := ParseDate(input, disabled); err != nil {
+					t.Errorf("ParseDate(%q) with %s disabled error = %v", input, tt.feature, err)
+				}
+			}
+		})
+	}
+}
+
+func TestAbbreviatedYears(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := &Settings{RelativeBase: base, AbbreviatedYears: true}
+
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"'24", 2024},
+		{"'99", 1999},
+		{"’05", 2005},
+		{"FY24", 2024},
+		{"fy 25", 2025},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			want := time.Date(tt.want, 1, 1, 0, 0, 0, 0, time.UTC)
+			if !result.Equal(want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, want)
+			}
+		})
+	}
+
+	t.Run("bare two digits are not years", func(t *testing.T) {
+		for _, input := range []string{"99", "24"} {
+			if result, err := ParseDate(input, settings); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+	})
+
+	t.Run("off by default", func(t *testing.T) {
+		if result, err := ParseDate("'99", &Settings{RelativeBase: base}); err == nil {
+			t.Errorf("ParseDate(\"'99\") = %v, want error without AbbreviatedYears", result)
+		}
+	})
+
+	t.Run("two_digit_year disabled", func(t *testing.T) {
+		opts := &Settings{RelativeBase: base, AbbreviatedYears: true, DisableFeatures: []string{"two_digit_year"}}
+		if result, err := ParseDate("'99", opts); err == nil {
+			t.Errorf("ParseDate(\"'99\") = %v, want error with two_digit_year disabled", result)
+		}
+	})
+
+	t.Run("fiscal year prefix follows FiscalCalendar", func(t *testing.T) {
+		opts := &Settings{RelativeBase: base, AbbreviatedYears: true, FiscalCalendar: &FiscalCalendar{StartMonth: time.October}}
+		result, err := ParseDate("FY24", opts)
+		if err != nil {
+			t.Fatalf("ParseDate(\"FY24\") error = %v", err)
+		}
+		if want := time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC); !result.Equal(want) {
+			t.Errorf("ParseDate(\"FY24\") = %v, want %v", result, want)
+		}
+		if result, err := ParseDate("'24", opts); err != nil || result.Month() != time.January {
+			t.Errorf("ParseDate(\"'24\") = %v, %v, want January 1 regardless of FiscalCalendar", result, err)
+		}
+	})
+
+	t.Run("extracted", func(t *testing.T) {
+		results, err := ExtractDates("Class of '99, budget for FY24, 24 people", settings)
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 2 || results[0].Canonical() != "1999" || results[1].Canonical() != "2024" {
+			t.Errorf("ExtractDates() = %+v, want '99 and FY24 as years", results)
+		}
+	})
+}
+
+func TestApproximateTimes(t *testing.T) {
+	base := time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input     string
+		languages []string
+		hour      int
+		minute    int
+	}{
+		{"about noon", nil, 12, 0},
+		{"noonish", nil, 12, 0},
+		{"3-ish", nil, 15, 0},
+		{"3ish", nil, 15, 0},
+		{"around 3pm", nil, 15, 0},
+		{"around 3", nil, 15, 0},
+		{"about 9", nil, 9, 0},
+		{"roughly 10:30", nil, 10, 30},
+		{"~5pm", nil, 17, 0},
+		{"midnight-ish", nil, 0, 0},
+		{"vers midi", []string{"fr"}, 12, 0},
+		{"vers 15h30", []string{"fr"}, 15, 30},
+		{"gegen 15:30", []string{"de"}, 15, 30},
+		{"a eso de las 3pm", []string{"es"}, 15, 0},
+		{"около 15:00", []string{"ru"}, 15, 0},
+		{"中午左右", []string{"zh"}, 12, 0},
+		{"大约15:00", []string{"zh"}, 15, 0},
+		{"15:00頃", []string{"ja"}, 15, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, Languages: tt.languages}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			want := time.Date(2024, 10, 15, tt.hour, tt.minute, 0, 0, time.UTC)
+			if !result.Equal(want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, want)
+			}
+		})
+	}
+
+	t.Run("qualifier must be a whole word", func(t *testing.T) {
+		if result, err := ParseDate("aroundnoon", &Settings{RelativeBase: base}); err == nil {
+			t.Errorf("ParseDate(\"aroundnoon\") = %v, want error", result)
+		}
+	})
+
+	t.Run("time parser disabled", func(t *testing.T) {
+		opts := &Settings{RelativeBase: base, EnableParsers: []string{"absolute"}}
+		if result, err := ParseDate("around 3pm", opts); err == nil {
+			t.Errorf("ParseDate(\"around 3pm\") = %v, want error with the time parser disabled", result)
+		}
+	})
+
+	t.Run("extracted", func(t *testing.T) {
+		results, err := ExtractDates("Lunch about noon, call at 3-ish with 4 people", &Settings{RelativeBase: base})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 2 {
+			t.Fatalf("ExtractDates() = %+v, want 2 results", results)
+		}
+		for i, want := range []int{12, 15} {
+			r := results[i]
+			if r.Date.Hour() != want || !r.Approximate || r.Granularity != "time" {
+				t.Errorf("results[%d] = %+v, want approximate %d:00", i, r, want)
+			}
+			if r.Confidence >= calculateConfidence(r.MatchedText) {
+				t.Errorf("results[%d].Confidence = %v, want it lowered for an approximate time", i, r.Confidence)
+			}
+		}
+	})
+}
+
+func TestExactTimes(t *testing.T) {
+	base := time.Date(2024, 10, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input     string
+		languages []string
+		hour      int
+		minute    int
+	}{
+		{"3pm sharp", nil, 15, 0},
+		{"3PM Sharp", nil, 15, 0},
+		{"noon sharp", nil, 12, 0},
+		{"10:30 on the dot", nil, 10, 30},
+		{"exactly 3pm", nil, 15, 0},
+		{"exactly at noon", nil, 12, 0},
+		{"precisely 15:00", nil, 15, 0},
+		{"15h pile", []string{"fr"}, 15, 0},
+		{"midi pile", []string{"fr"}, 12, 0},
+		{"genau um 15:00", []string{"de"}, 15, 0},
+		{"Punkt 15:00", []string{"de"}, 15, 0},
+		{"ровно в 15:00", []string{"ru"}, 15, 0},
+		{"15:00ちょうど", []string{"ja"}, 15, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, Languages: tt.languages}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			want := time.Date(2024, 10, 15, tt.hour, tt.minute, 0, 0, time.UTC)
+			if !result.Equal(want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, want)
+			}
+		})
+	}
+
+	t.Run("marker alone", func(t *testing.T) {
+		for _, input := range []string{"sharp", "exactly", "on the dot", "sharpen 3pm"} {
+			if result, err := ParseDate(input, &Settings{RelativeBase: base}); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+	})
+
+	t.Run("extracted", func(t *testing.T) {
+		results, err := ExtractDates("Stand-up at 3pm sharp, demo exactly at noon", &Settings{RelativeBase: base})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		want := []struct {
+			matched string
+			hour    int
+		}{
+			{"3pm sharp", 15},
+			{"exactly at noon", 12},
+		}
+		if len(results) != len(want) {
+			t.Fatalf("ExtractDates() = %+v, want %d results", results, len(want))
+		}
+		for i, w := range want {
+			r := results[i]
+			if r.MatchedText != w.matched || r.Date.Hour() != w.hour {
+				t.Errorf("results[%d] = %q at %v, want %q at %d:00", i, r.MatchedText, r.Date, w.matched, w.hour)
+			}
+			if r.Confidence != 1.0 || r.Approximate || r.Modifier != "" {
+				t.Errorf("results[%d] = %+v, want confidence 1.0 and not approximate", i, r)
+			}
+		}
+	})
+}
+
+func TestDateQualifiers(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC) // Tuesday
+
+	tests := []struct {
+		input     string
+		languages []string
+		want      time.Time
+	}{
+		{"by December 31", nil, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"By Dec 31, 2024", nil, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"no later than Friday", nil, time.Date(2024, 10, 18, 12, 0, 0, 0, time.UTC)},
+		{"on or before 2025-01-15", nil, time.Date(2025, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"on or about March 3", nil, time.Date(2025, 3, 3, 0, 0, 0, 0, time.UTC)},
+		{"by 3pm", nil, time.Date(2024, 10, 15, 15, 0, 0, 0, time.UTC)},
+		{"a más tardar el 31 de diciembre", []string{"es"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"au plus tard le 31 décembre", []string{"fr"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"spätestens am 31. Dezember 2024", []string{"de"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"entro il 31 dicembre", []string{"it"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"o mais tardar em 31 de dezembro", []string{"pt"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"uiterlijk 31 december", []string{"nl"}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, Languages: tt.languages})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("phrase alone", func(t *testing.T) {
+		for _, input := range []string{"by", "no later than", "bypass"} {
+			if result, err := ParseDate(input, &Settings{RelativeBase: base}); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+	})
+
+	t.Run("extracted modifier", func(t *testing.T) {
+		text := "Pay no later than December 31, 2024, deliver on or about March 3, 2025, review January 5, 2025 around 3pm, standby March 9, 2025."
+		results, err := ExtractDates(text, &Settings{RelativeBase: base})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+
+		want := []struct {
+			matched  string
+			modifier string
+		}{
+			{"no later than December 31, 2024", "deadline"},
+			{"on or about March 3, 2025", "approximate"},
+			{"January 5, 2025", ""},
+			{"around 3pm", "approximate"},
+			{"March 9, 2025", ""},
+		}
+		if len(results) != len(want) {
+			t.Fatalf("ExtractDates() found %d dates, want %d: %+v", len(results), len(want), results)
+		}
+		for i, w := range want {
+			if results[i].MatchedText != w.matched || results[i].Modifier != w.modifier {
+				t.Errorf("results[%d] = %q (%q), want %q (%q)", i, results[i].MatchedText, results[i].Modifier, w.matched, w.modifier)
+			}
+		}
+	})
+}
+
+func TestUnicodeSpaces(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := &Settings{RelativeBase: base}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"31\u00a0December\u00a02024", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"December\u202f31,\u202f2024", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"3\u00a0days\u00a0ago", time.Date(2024, 10, 12, 12, 0, 0, 0, time.UTC)},
+		{"3:30\u202fPM", time.Date(2024, 10, 15, 15, 30, 0, 0, time.UTC)},
+		{"next\u3000Friday", time.Date(2024, 10, 18, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("ranges", func(t *testing.T) {
+		result, err := ParseDateRange("next\u00a07\u00a0days", settings)
+		if err != nil {
+			t.Fatalf("ParseDateRange() error = %v", err)
+		}
+		if !result.End.Equal(base.AddDate(0, 0, 7)) {
+			t.Errorf("ParseDateRange() end = %v, want %v", result.End, base.AddDate(0, 0, 7))
+		}
+		if _, _, err := ParseTimeRange("9\u202fAM – 5\u202fPM", settings); err != nil {
+			t.Errorf("ParseTimeRange() error = %v", err)
+		}
+	})
+
+	t.Run("unicode_spaces disabled", func(t *testing.T) {
+		opts := &Settings{RelativeBase: base, DisableFeatures: []string{"unicode_spaces"}}
+		if result, err := ParseDate("31\u00a0December\u00a02024", opts); err == nil {
+			t.Errorf("ParseDate() = %v, want error with unicode_spaces disabled", result)
+		}
+	})
+}
+
+func TestRejectFutureAndPast(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		input   string
+		opts    Settings
+		wantErr bool
+	}{
+		{"future rejected", "tomorrow", Settings{RejectFuture: true}, true},
+		{"future: past accepted", "1990-05-17", Settings{RejectFuture: true}, false},
+		{"future: base accepted", "2024-10-15T12:00:00Z", Settings{RejectFuture: true}, false},
+		{"future: one second after base", "2024-10-15T12:00:01Z", Settings{RejectFuture: true}, true},
+		{"past rejected", "yesterday", Settings{RejectPast: true}, true},
+		{"past: future accepted", "2030-01-01", Settings{RejectPast: true}, false},
+		{"past: base accepted", "2024-10-15T12:00:00Z", Settings{RejectPast: true}, false},
+		{"past: one second before base", "2024-10-15T11:59:59Z", Settings{RejectPast: true}, true},
+		{"both: base accepted", "2024-10-15 12:00", Settings{RejectFuture: true, RejectPast: true}, false},
+		{"neither", "yesterday", Settings{}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.RelativeBase = base
+			result, err := ParseDate(tt.input, &opts)
+
+			var outOfRange *ErrDateOutOfRange
+			if tt.wantErr {
+				if !errors.As(err, &outOfRange) {
+					t.Fatalf("ParseDate(%q) = %v, %v, want ErrDateOutOfRange", tt.input, result, err)
+				}
+				if !outOfRange.Base.Equal(base) || outOfRange.Input != tt.input {
+					t.Errorf("ErrDateOutOfRange = %+v, want Input %q and Base %v", outOfRange, tt.input, base)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("ParseDate(%q) error = %v", tt.input, err)
+			}
+		})
+	}
+
+	t.Run("zero base", func(t *testing.T) {
+		if _, err := ParseDate("in 3 days", &Settings{RejectPast: true}); err != nil {
+			t.Errorf("ParseDate() error = %v", err)
+		}
+		var outOfRange *ErrDateOutOfRange
+		if _, err := ParseDate("3 days ago", &Settings{RejectPast: true}); !errors.As(err, &outOfRange) {
+			t.Errorf("ParseDate() error = %v, want ErrDateOutOfRange", err)
+		}
+	})
+
+	t.Run("parser", func(t *testing.T) {
+		p, err := New(&Settings{RelativeBase: base, RejectFuture: true})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var outOfRange *ErrDateOutOfRange
+		if _, err := p.Parse("next week"); !errors.As(err, &outOfRange) || outOfRange.Reason != "in the future" {
+			t.Errorf("Parse() error = %v, want ErrDateOutOfRange in the future", err)
+		}
+	})
+}
+
+func TestMinMaxDate(t *testing.T) {
+	minDate := time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)
+	maxDate := time.Date(2030, 12, 31, 0, 0, 0, 0, time.UTC)
+	settings := Settings{
+		RelativeBase: time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC),
+		MinDate:      minDate,
+		MaxDate:      maxDate,
+	}
+
+	tests := []struct {
+		input      string
+		want       time.Time
+		wantReason string
+	}{
+		{"2024-06-15", time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC), ""},
+		{"2000-01-01", minDate, ""},
+		{"2030-12-31", maxDate, ""},
+		{"1999-12-31", minDate, "before MinDate"},
+		{"2030-12-31T00:00:01Z", maxDate, "after MaxDate"},
+		{"in 10 years", maxDate, "after MaxDate"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			opts := settings
+			result, err := ParseDate(tt.input, &opts)
+			if tt.wantReason == "" {
+				if err != nil {
+					t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+				}
+				if !result.Equal(tt.want) {
+					t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+				}
+				return
+			}
+
+			var outOfRange *ErrDateOutOfRange
+			if !errors.As(err, &outOfRange) || outOfRange.Reason != tt.wantReason {
+				t.Fatalf("ParseDate(%q) = %v, %v, want ErrDateOutOfRange %s", tt.input, result, err, tt.wantReason)
+			}
+			if !outOfRange.Base.Equal(tt.want) {
+				t.Errorf("ErrDateOutOfRange.Base = %v, want %v", outOfRange.Base, tt.want)
+			}
+
+			// With ClampToRange the nearest bound is returned
+			opts.ClampToRange = true
+			result, err = ParseDate(tt.input, &opts)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) with ClampToRange error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) with ClampToRange = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("one bound", func(t *testing.T) {
+		if _, err := ParseDate("1850-01-01", &Settings{MaxDate: maxDate}); err != nil {
+			t.Errorf("ParseDate() with only MaxDate error = %v", err)
+		}
+	})
+}
+
+func TestParseRecurrence(t *testing.T) {
+	// Tuesday
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	weekdays := []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+
+	tests := []struct {
+		input string
+		want  Recurrence
+		next  []time.Time // first occurrences after base
+	}{
+		{
+			"every Monday",
+			Recurrence{Frequency: "weekly", Interval: 1, ByDay: []time.Weekday{time.Monday}},
+			[]time.Time{time.Date(2024, 10, 21, 0, 0, 0, 0, time.UTC), time.Date(2024, 10, 28, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every Monday and Wednesday at 9am",
+			Recurrence{Frequency: "weekly", Interval: 1, ByDay: []time.Weekday{time.Monday, time.Wednesday}, TimeOfDay: 9 * time.Hour, HasTime: true},
+			[]time.Time{time.Date(2024, 10, 16, 9, 0, 0, 0, time.UTC), time.Date(2024, 10, 21, 9, 0, 0, 0, time.UTC), time.Date(2024, 10, 23, 9, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every Fri, Mon and Wed",
+			Recurrence{Frequency: "weekly", Interval: 1, ByDay: []time.Weekday{time.Monday, time.Wednesday, time.Friday}},
+			[]time.Time{time.Date(2024, 10, 16, 0, 0, 0, 0, time.UTC), time.Date(2024, 10, 18, 0, 0, 0, 0, time.UTC), time.Date(2024, 10, 21, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"on Thursdays",
+			Recurrence{Frequency: "weekly", Interval: 1, ByDay: []time.Weekday{time.Thursday}},
+			[]time.Time{time.Date(2024, 10, 17, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every other Tuesday",
+			Recurrence{Frequency: "weekly", Interval: 2, ByDay: []time.Weekday{time.Tuesday}},
+			[]time.Time{time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every third Friday",
+			Recurrence{Frequency: "weekly", Interval: 3, ByDay: []time.Weekday{time.Friday}},
+			[]time.Time{time.Date(2024, 10, 18, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every 2nd Tuesday",
+			Recurrence{Frequency: "weekly", Interval: 2, ByDay: []time.Weekday{time.Tuesday}},
+			[]time.Time{time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"biweekly",
+			Recurrence{Frequency: "weekly", Interval: 2},
+			[]time.Time{time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"bi-weekly on Fridays at 4pm",
+			Recurrence{Frequency: "weekly", Interval: 2, ByDay: []time.Weekday{time.Friday}, TimeOfDay: 16 * time.Hour, HasTime: true},
+			[]time.Time{time.Date(2024, 10, 18, 16, 0, 0, 0, time.UTC), time.Date(2024, 11, 1, 16, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every weekday at 8:30",
+			Recurrence{Frequency: "weekly", Interval: 1, ByDay: weekdays, TimeOfDay: 8*time.Hour + 30*time.Minute, HasTime: true},
+			[]time.Time{time.Date(2024, 10, 16, 8, 30, 0, 0, time.UTC), time.Date(2024, 10, 17, 8, 30, 0, 0, time.UTC), time.Date(2024, 10, 18, 8, 30, 0, 0, time.UTC), time.Date(2024, 10, 21, 8, 30, 0, 0, time.UTC)},
+		},
+		{
+			"every business day",
+			Recurrence{Frequency: "weekly", Interval: 1, ByDay: weekdays},
+			[]time.Time{time.Date(2024, 10, 16, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every 2 weeks",
+			Recurrence{Frequency: "weekly", Interval: 2},
+			[]time.Time{time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every three days",
+			Recurrence{Frequency: "daily", Interval: 3},
+			[]time.Time{time.Date(2024, 10, 16, 0, 0, 0, 0, time.UTC), time.Date(2024, 10, 19, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"daily at 9am",
+			Recurrence{Frequency: "daily", Interval: 1, TimeOfDay: 9 * time.Hour, HasTime: true},
+			[]time.Time{time.Date(2024, 10, 16, 9, 0, 0, 0, time.UTC), time.Date(2024, 10, 17, 9, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every day at 3pm",
+			Recurrence{Frequency: "daily", Interval: 1, TimeOfDay: 15 * time.Hour, HasTime: true},
+			[]time.Time{time.Date(2024, 10, 15, 15, 0, 0, 0, time.UTC), time.Date(2024, 10, 16, 15, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every 6 hours",
+			Recurrence{Frequency: "hourly", Interval: 6},
+			[]time.Time{time.Date(2024, 10, 15, 18, 0, 0, 0, time.UTC), time.Date(2024, 10, 16, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"first of each month",
+			Recurrence{Frequency: "monthly", Interval: 1, ByMonthDay: 1},
+			[]time.Time{time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"the 31st of every month",
+			Recurrence{Frequency: "monthly", Interval: 1, ByMonthDay: 31},
+			[]time.Time{time.Date(2024, 10, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"last day of the month at noon",
+			Recurrence{Frequency: "monthly", Interval: 1, ByMonthDay: -1, TimeOfDay: 12 * time.Hour, HasTime: true},
+			[]time.Time{time.Date(2024, 10, 31, 12, 0, 0, 0, time.UTC), time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)},
+		},
+		{
+			"every month on the 15th",
+			Recurrence{Frequency: "monthly", Interval: 1, ByMonthDay: 15},
+			[]time.Time{time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"quarterly",
+			Recurrence{Frequency: "monthly", Interval: 3},
+			[]time.Time{time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC), time.Date(2025, 2, 15, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			"annually",
+			Recurrence{Frequency: "yearly", Interval: 1},
+			[]time.Time{time.Date(2025, 10, 15, 0, 0, 0, 0, time.UTC)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			rule, err := ParseRecurrence(tt.input, &Settings{RelativeBase: base})
+			if err != nil {
+				t.Fatalf("ParseRecurrence(%q) error = %v", tt.input, err)
+			}
+			if rule.Frequency != tt.want.Frequency || rule.Interval != tt.want.Interval || rule.ByMonthDay != tt.want.ByMonthDay ||
+				rule.TimeOfDay != tt.want.TimeOfDay || rule.HasTime != tt.want.HasTime || fmt.Sprint(rule.ByDay) != fmt.Sprint(tt.want.ByDay) {
+				t.Errorf("ParseRecurrence(%q) = %s, want %s", tt.input, rule, tt.want)
+			}
+
+			got := rule.Occurrences(base, len(tt.next))
+			if fmt.Sprint(got) != fmt.Sprint(tt.next) {
+				t.Errorf("Occurrences() = %v, want %v", got, tt.next)
+			}
+		})
+	}
+
+	t.Run("occurrences far from start", func(t *testing.T) {
+		rule, err := ParseRecurrence("every other Tuesday", &Settings{RelativeBase: base})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := rule.Occurrences(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), 1)
+		want := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+		for !want.After(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)) || want.Sub(time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC))%(14*24*time.Hour) != 0 {
+			want = want.AddDate(0, 0, 1)
+		}
+		if len(got) != 1 || !got[0].Equal(want) {
+			t.Errorf("Occurrences() = %v, want [%v]", got, want)
+		}
+	})
+
+	t.Run("interval counts from the first occurrence", func(t *testing.T) {
+		// Wednesday
+		wednesday := time.Date(2024, 10, 16, 10, 0, 0, 0, time.UTC)
+		tests := []struct {
+			input string
+			next  []time.Time
+		}{
+			{"every other Tuesday", []time.Time{time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC)}},
+			{"every third Friday", []time.Time{time.Date(2024, 10, 18, 0, 0, 0, 0, time.UTC), time.Date(2024, 11, 8, 0, 0, 0, 0, time.UTC)}},
+			{"every other Wednesday at 9am", []time.Time{time.Date(2024, 10, 23, 9, 0, 0, 0, time.UTC), time.Date(2024, 11, 6, 9, 0, 0, 0, time.UTC)}},
+			{"every other Wednesday at 11am", []time.Time{time.Date(2024, 10, 16, 11, 0, 0, 0, time.UTC), time.Date(2024, 10, 30, 11, 0, 0, 0, time.UTC)}},
+			{"every 2 months", []time.Time{time.Date(2024, 11, 16, 0, 0, 0, 0, time.UTC), time.Date(2025, 1, 16, 0, 0, 0, 0, time.UTC)}},
+		}
+		for _, tt := range tests {
+			rule, err := ParseRecurrence(tt.input, &Settings{RelativeBase: wednesday})
+			if err != nil {
+				t.Errorf("ParseRecurrence(%q) error = %v", tt.input, err)
+				continue
+			}
+			if got := rule.Occurrences(wednesday, len(tt.next)); fmt.Sprint(got) != fmt.Sprint(tt.next) {
+				t.Errorf("ParseRecurrence(%q).Occurrences() = %v, want %v", tt.input, got, tt.next)
+			}
+		}
+	})
+
+	t.Run("localized", func(t *testing.T) {
+		tests := []struct {
+			input    string
+			language string
+			interval int
+			byDay    []time.Weekday
+		}{
+			{"cada dos martes", "es", 2, []time.Weekday{time.Tuesday}},
+			{"cada viernes", "es", 1, []time.Weekday{time.Friday}},
+			{"quincenal", "es", 2, nil},
+			{"jeden zweiten Dienstag", "de", 2, []time.Weekday{time.Tuesday}},
+			{"zweiwöchentlich", "de", 2, nil},
+			{"toutes les deux semaines", "fr", 2, nil},
+			{"chaque mardi", "fr", 1, []time.Weekday{time.Tuesday}},
+			{"ogni due martedì", "it", 2, []time.Weekday{time.Tuesday}},
+			{"elke tweede dinsdag", "nl", 2, []time.Weekday{time.Tuesday}},
+			{"каждый второй вторник", "ru", 2, []time.Weekday{time.Tuesday}},
+			{"每隔一个星期二", "zh", 2, []time.Weekday{time.Tuesday}},
+			{"隔週火曜日", "ja", 2, []time.Weekday{time.Tuesday}},
+			// English schedules are recognized whatever the languages
+			{"every other Tuesday", "de", 2, []time.Weekday{time.Tuesday}},
+		}
+		for _, tt := range tests {
+			rule, err := ParseRecurrence(tt.input, &Settings{RelativeBase: base, Languages: []string{tt.language}})
+			if err != nil {
+				t.Errorf("ParseRecurrence(%q) error = %v", tt.input, err)
+				continue
+			}
+			if rule.Frequency != "weekly" || rule.Interval != tt.interval || fmt.Sprint(rule.ByDay) != fmt.Sprint(tt.byDay) {
+				t.Errorf("ParseRecurrence(%q) = %s, want weekly every %d weeks on %v", tt.input, rule, tt.interval, tt.byDay)
+			}
+		}
+	})
+
+	t.Run("never occurs", func(t *testing.T) {
+		rule := Recurrence{Frequency: "monthly", Interval: 12, ByMonthDay: 30, Start: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+		if got := rule.Occurrences(rule.Start, 1); len(got) != 0 {
+			t.Errorf("Occurrences() = %v, want none", got)
+		}
+	})
+
+	for _, input := range []string{"every blue moon", "every Monday at lunchtime please", "hourly at 9am", "every 0 days", "sometimes"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			var formatErr *ErrInvalidFormat
+			if rule, err := ParseRecurrence(input, &Settings{RelativeBase: base}); !errors.As(err, &formatErr) {
+				t.Errorf("ParseRecurrence(%q) = %s, %v, want ErrInvalidFormat", input, rule, err)
+			}
+		})
+	}
+}
+
+func TestParseDateWithInfo_Warnings(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		opts  Settings
+		want  []string
+	}{
+		{"ambiguous numeric MDY", "03/04/2024", Settings{DateOrder: "MDY"}, []string{"assumed DateOrder=MDY"}},
+		{"ambiguous numeric DMY", "03.04.2024", Settings{DateOrder: "DMY"}, []string{"assumed DateOrder=DMY"}},
+		{"language default order", "03/04/2024", Settings{Languages: []string{"en-GB"}}, []string{"assumed DateOrder=DMY"}},
+		{"unambiguous numeric", "31/12/2024", Settings{DateOrder: "DMY"}, nil},
+		{"same day and month", "05/05/2024", Settings{}, nil},
+		{"two-digit year", "12/31/24", Settings{DateOrder: "MDY"}, []string{"two-digit year 24 read as 2024"}},
+		{"ambiguous with two-digit year", "3/4/24", Settings{DateOrder: "MDY"}, []string{"assumed DateOrder=MDY", "two-digit year 24 read as 2024"}},
+		{"year-less month name", "December 25", Settings{}, []string{"year inferred from base"}},
+		{"year-less numeric", "3/4", Settings{DateOrder: "MDY"}, []string{"assumed DateOrder=MDY", "year inferred from base"}},
+		{"year-less ordinal", "June 3rd", Settings{}, []string{"year inferred from base"}},
+		{"ambiguous month word", "May", Settings{}, []string{"year inferred from base", "ambiguous month word \"may\""}},
+		{"month word with context", "May 2025", Settings{}, nil},
+		{"ISO date", "2024-03-04", Settings{}, nil},
+		{"relative", "in 3.5 days", Settings{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := tt.opts
+			opts.RelativeBase = base
+			opts.CollectWarnings = true
+			info, err := ParseDateWithInfo(tt.input, &opts)
+			if err != nil {
+				t.Fatalf("ParseDateWithInfo(%q) error = %v", tt.input, err)
+			}
+			if fmt.Sprint(info.Warnings) != fmt.Sprint(tt.want) {
+				t.Errorf("ParseDateWithInfo(%q) warnings = %q, want %q", tt.input, info.Warnings, tt.want)
+			}
+
+			date, _ := ParseDate(tt.input, &opts)
+			if !info.Date.Equal(date) {
+				t.Errorf("ParseDateWithInfo(%q) date = %v, want %v as from ParseDate", tt.input, info.Date, date)
+			}
+		})
+	}
+
+	t.Run("not collected by default", func(t *testing.T) {
+		info, err := ParseDateWithInfo("03/04/2024", &Settings{RelativeBase: base})
+		if err != nil || info.Warnings != nil {
+			t.Errorf("ParseDateWithInfo() = %+v, %v, want no warnings", info, err)
+		}
+	})
+
+	t.Run("errors are returned", func(t *testing.T) {
+		if _, err := ParseDateWithInfo("not a date", &Settings{CollectWarnings: true}); err == nil {
+			t.Error("ParseDateWithInfo() error = nil, want error")
+		}
+	})
+}
+
+func TestLanguageDetectionThreshold(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	spanish := "15 de diciembre de 2024"
+	want := time.Date(2024, 12, 15, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		settings *Settings
+		wantOK   bool
+	}{
+		// "diciembre" is a strong signal for Spanish
+		{"strong signal is used", &Settings{RelativeBase: base, DetectionThreshold: 15}, true},
+		{"no threshold", &Settings{RelativeBase: base}, true},
+		// Below the threshold only the default language is used
+		{"weak signal falls back to English", &Settings{RelativeBase: base, DetectionThreshold: 30}, false},
+		{"weak signal falls back to DefaultLanguage", &Settings{RelativeBase: base, DetectionThreshold: 30, DefaultLanguage: "es"}, true},
+		// Explicit Languages turn autodetection off
+		{"explicit languages", &Settings{RelativeBase: base, Languages: []string{"en"}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(spanish, tt.settings)
+			if !tt.wantOK {
+				if err == nil {
+					t.Errorf("ParseDate(%q) = %v, want error", spanish, result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", spanish, err)
+			}
+			if !result.Equal(want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", spanish, result, want)
+			}
+		})
+	}
+}
+
+func TestParseAge(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input  string
+		want   int
+		wantOK bool
+	}{
+		// Stated ages
+		{"34 years old", 34, true},
+		{"a 34-year-old engineer", 34, true},
+		{"I am thirty years old", 30, true},
+		{"thirty-four yrs old", 34, true},
+		{"aged 34", 34, true},
+		{"Age: 34", 34, true},
+		{"born 1990, 34 years old", 34, true},
+		// Computed from a birthdate at RelativeBase
+		{"born on 1990-06-15", 34, true},
+		{"Born on June 15, 1990.", 34, true},
+		{"born on 1990-10-15", 34, true},
+		{"born on 1990-10-16", 33, true},
+		{"she was born on 15 October 1990, in Ohio", 34, true},
+		// No age
+		{"born on 2030-01-01", 0, false},
+		{"born on Mars", 0, false},
+		{"see you in 3 years", 0, false},
+		{"", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := ParseAge(tt.input, &Settings{RelativeBase: base})
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ParseAge(%q) = %d, %v, want %d, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	// Birthdays on February 29 are reached on March 1 in common years
+	t.Run("leap day birthday", func(t *testing.T) {
+		for at, want := range map[time.Time]int{
+			time.Date(2023, 2, 28, 0, 0, 0, 0, time.UTC): 22,
+			time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC):  23,
+			time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC): 24,
+		} {
+			if got, ok := ParseAge("born on 2000-02-29", &Settings{RelativeBase: at}); !ok || got != want {
+				t.Errorf("ParseAge() at %s = %d, %v, want %d", at.Format("2006-01-02"), got, ok, want)
+			}
+		}
+	})
+}
@@ -4,7 +4,10 @@
 package godateparser
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/coredds/godateparser/translations"
@@ -16,12 +19,27 @@ const Version = "1.3.4"
 // Settings defines customizable parsing behavior for date parsing operations.
 type Settings struct {
 	// DateOrder specifies the date component order preference: "YMD", "MDY", or "DMY"
+	// If empty and exactly one language is configured, that language's
+	// DefaultDateOrder is used (e.g. DMY for "de"); otherwise MDY
 	DateOrder string
 
 	// Languages specifies preferred languages/locales for parsing (e.g., ["en", "es", "fr"])
-	// If empty, all languages are considered with autodetection
+	// If empty, the language is detected from the input and used alongside
+	// DefaultLanguage (see DetectionThreshold)
+	// BCP 47 tags such as "en-GB", "pt-BR" or "zh-Hant" select their base
+	// language; with a single tag, its region also sets the default DateOrder
+	// ("en-US" is MDY, "en-GB" DMY)
 	Languages []string
 
+	// DetectionThreshold is the lowest DetectLanguageScores score at which
+	// autodetection trusts its best guess; below it, or with no evidence
+	// at all, DefaultLanguage is used. Zero trusts any evidence.
+	DetectionThreshold float64
+
+	// DefaultLanguage is the language autodetection falls back to. If
+	// empty, "en" is used.
+	DefaultLanguage string
+
 	// RelativeBase is the base date/time for relative date calculations
 	// If zero, time.Now() is used
 	RelativeBase time.Time
@@ -42,8 +60,258 @@ type Settings struct {
 	// When set to "past", ambiguous dates like "Monday" prefer last Monday
 	// When empty, defaults to "future" for forward-looking dates
 	PreferDatesFrom string
+
+	// DecimalSeparator overrides the decimal separator used for fractional
+	// numbers ("12,5 hours", "10:30:45,500"). Valid values are "." and ",".
+	// If empty, the separator of the first configured language is used.
+	DecimalSeparator string
+
+	// MaxInputLength is the maximum input length in bytes accepted by ParseDate,
+	// ExtractDates and ParseDateRange. Longer inputs fail with ErrInputTooLong
+	// before any pattern matching runs; the limit is checked both before and
+	// after Preprocessors. If zero, DefaultMaxInputLength is used; a negative
+	// value disables the limit.
+	MaxInputLength int
+
+	// WeekStartsOn is the English name of the first day of the week ("monday",
+	// "sunday", ...) used by week boundaries such as "start of week" and
+	// "end of last week". If empty, weeks start on Monday.
+	WeekStartsOn string
+
+	// DefaultTime is the time of day, as an offset from midnight, given to
+	// date-only inputs such as "2024-12-31" or "December 31". For example,
+	// 12*time.Hour resolves them to noon, and 24*time.Hour-time.Nanosecond to
+	// the end of the day. Values outside [0, 24h) are ignored. If zero,
+	// date-only inputs resolve to midnight.
+	DefaultTime time.Duration
+
+	// CityTimezones maps city names to IANA timezone names ("Lagos":
+	// "Africa/Lagos") for inputs ending in a city, such as "3pm New York time"
+	// or "noon in London". The wall-clock time is resolved in the city's zone,
+	// including daylight saving time. Names are case-insensitive and are
+	// consulted before the built-in map of major cities. Requires the
+	// "timezone" parser.
+	CityTimezones map[string]string
+
+	// DayPartTimes maps named parts of the day to the time of day they stand
+	// for, as "HH:MM" ("teatime": "17:00", "dusk": "20:30"). Entries override
+	// or extend the language defaults, such as "midday" (12:00), "teatime"
+	// (16:00), "dinnertime" (19:00), "dawn" (06:00) and "dusk" (18:00).
+	// Names are case-insensitive.
+	DayPartTimes map[string]string
+
+	// SelectBest makes ParseDate extract every date in the input and return
+	// the one with the highest confidence, preferring the earliest on ties.
+	// Use it for inputs that may hold more than one date, such as
+	// "moved from 3/4 to March 5, 2024". If no date is extracted, the input is
+	// parsed as a whole.
+	SelectBest bool
+
+	// NormalizeToUTC converts every result to UTC after parsing, so inputs
+	// with different zones ("10:00 +05:30", "3pm EST") compare directly.
+	// The instant is unchanged; only its location becomes time.UTC.
+	NormalizeToUTC bool
+
+	// Weekend lists the days skipped by business-day expressions such as
+	// "in 3 business days" and "next weekday", and spanned by "this
+	// weekend". If empty, Saturday and Sunday are used; set it to
+	// []time.Weekday{time.Friday, time.Saturday} for a Friday/Saturday
+	// weekend.
+	Weekend []time.Weekday
+
+	// Holidays lists dates also skipped by business-day expressions. Only the
+	// calendar date of each entry is used.
+	Holidays []time.Time
+
+	// NamedDates maps names to the dates they stand for, such as "the
+	// deadline" or "launch", so they can be counted down to: "3 weeks till
+	// the deadline". Names are case-insensitive and a leading article is
+	// ignored. Entries override the built-in holidays ("Christmas",
+	// "Easter", "Thanksgiving", "Weihnachten", ...), which resolve to their
+	// next occurrence on or after RelativeBase.
+	NamedDates map[string]time.Time
+
+	// EndOfDay is the time of day, as an offset from midnight, that the
+	// workplace shorthands "EOD" and "COB" resolve to. For example,
+	// 23*time.Hour+59*time.Minute makes "EOD" mean 23:59. If zero, 17:00 is used.
+	EndOfDay time.Duration
+
+	// FuzzyMatching accepts month and weekday names with a single typo
+	// ("Decembr", "Wendesday") in ParseDate and ExtractDates, as found in
+	// OCR output or scraped text. Only names of five or more letters are
+	// corrected, and a typo equally close to two names is left alone. An
+	// extracted date's MatchedText holds the corrected name, while Position
+	// and Length locate the original text.
+	FuzzyMatching bool
+
+	// DisableFeatures turns off individual patterns without disabling the
+	// parser that owns them, e.g. to stop "2024" in code or IDs from being
+	// read as a date. Valid values: "ordinal_words" (ordinal day numbers
+	// such as "1st", "3rd of June", "1er mars"), "fuzzy" (FuzzyMatching),
+	// "two_digit_year" ("12/31/24", "Dec 31 24"), "bare_year" ("2024") and
+	// "unicode_spaces" (reading no-break and full-width spaces as spaces).
+	DisableFeatures []string
+
+	// MergeDateTime makes ExtractDates report a date followed by a time of
+	// day as a single match, as in "December 31, 2024 at 3:30 PM". The date
+	// and time may be separated by whitespace, a comma and a connector such
+	// as "at" or "@". DefaultSettings enables it.
+	MergeDateTime bool
+
+	// AllowBareMonths makes ExtractDates report month names written without
+	// a day or year, such as "in June", as month-granularity dates. Names
+	// that are also common words ("May", "March", "August") need a
+	// preposition or modifier such as "in", "since" or "early" right before
+	// them, so "you may go" yields no date while "in May" does.
+	AllowBareMonths bool
+
+	// RequireMonthContext makes AllowBareMonths ask that context of every
+	// month name, not only of those that are also common words.
+	RequireMonthContext bool
+
+	// MaxResults caps the number of dates returned by ExtractDates. The
+	// first MaxResults dates by position are returned, and the scan stops as
+	// soon as they are found, which bounds the work on large documents. Zero
+	// means no limit.
+	MaxResults int
+
+	// InferOrderFromSiblings makes ExtractDates read ambiguous numeric
+	// dates such as "03/04/2024" in the order proven by an unambiguous one
+	// elsewhere in the text: "15/06/2024" can only be DMY, so "03/04/2024"
+	// next to it is April 3. The inferred order overrides DateOrder; when
+	// the unambiguous dates disagree, nothing is inferred. Slashed dates
+	// without a year ("03/04 and 15/06") count as siblings too, and are
+	// extracted only once the order is proven.
+	InferOrderFromSiblings bool
+
+	// MinMatchLength makes ExtractDates drop matches shorter than that many
+	// characters (runes), such as a lone "May" or "today" in noisy text,
+	// before MaxResults is applied. Zero keeps every match.
+	MinMatchLength int
+
+	// ContextWindow makes ExtractDates fill ParsedDate.Context with the
+	// match and up to ContextWindow characters of text on either side of
+	// it, for display or disambiguation. The window counts runes, so it
+	// never splits a multibyte character, and stops at the ends of the
+	// text. Zero leaves Context empty.
+	ContextWindow int
+
+	// AbbreviatedYears parses two-digit years written with a marker, an
+	// apostrophe ("'24") or a fiscal-year prefix ("FY24"), as January 1 of
+	// the full year, expanded like other two-digit years ("'99" is 1999).
+	// "FY24" is the start of fiscal year 2024 under FiscalCalendar.
+	// A bare "24" is never read as a year.
+	AbbreviatedYears bool
+
+	// AllowCompactISO reads an 8-digit number such as "20241231" as a
+	// compact ISO 8601 date (YYYYMMDD) when it forms a valid date. It is off
+	// by default so IDs and other 8-digit numbers are not taken for dates.
+	// Compact date-times such as "20241231T153045" are always recognized.
+	AllowCompactISO bool
+
+	// AllowRomanMonths reads day-month-year dates whose month is a Roman
+	// numeral from I to XII, as written in some European legal and
+	// historical documents: "15.XII.2024" is December 15, 2024. A numeral
+	// past XII ("15.XIII.2024") is an ErrInvalidDate. Single-letter
+	// numerals need a '.', '-' or '/' separator ("1.V.2024"), so text such
+	// as "code 1 V 2024" is not read as a date.
+	AllowRomanMonths bool
+
+	// AllowLeapSecond accepts a leap second written as ":60", as in
+	// "2016-12-31T23:59:60Z". The time package has no leap seconds, so the
+	// result is normalized to the following second (2017-01-01T00:00:00Z)
+	// and ExtractDates sets ParsedDate.LeapSecond. When false, ":60" is an
+	// ErrInvalidDate like any other out-of-range second.
+	AllowLeapSecond bool
+
+	// AllowExtendedHours accepts 24-hour times from 24:00 to 47:59, as used
+	// by transit and broadcast schedules, as times on the following day:
+	// "25:30" is 01:30 the day after the date it is read on, and "24:00"
+	// is midnight at the end of that day. When false, such hours are an
+	// ErrInvalidDate.
+	AllowExtendedHours bool
+
+	// GroupedTimestamps accepts Unix timestamps with their digits grouped
+	// in threes by the thousands separator of the locale, the one that is
+	// not the decimal separator: "1,702,635,045" in English and
+	// "1.702.635.045" in German. Every group after the first must have
+	// exactly three digits, so comma-separated lists are not read as one
+	// timestamp. Years are never grouped ("2,024" is not a year).
+	GroupedTimestamps bool
+
+	// ResolveReferences makes ExtractDates resolve offsets from a named
+	// event, such as "3 days before the meeting", against a date the event
+	// is mentioned with in the same sentence elsewhere in the text ("The
+	// meeting is on March 3, 2025."), before or after the offset. Such
+	// dates carry a lower Confidence than their anchor.
+	ResolveReferences bool
+
+	// RejectFuture makes ParseDate and Parser.Parse return ErrDateOutOfRange
+	// for a date after RelativeBase, as when validating a birthdate. A date
+	// equal to RelativeBase is accepted.
+	RejectFuture bool
+
+	// RejectPast makes ParseDate and Parser.Parse return ErrDateOutOfRange
+	// for a date before RelativeBase, as when validating an expiry date. A
+	// date equal to RelativeBase is accepted.
+	RejectPast bool
+
+	// CollectWarnings makes ParseDateWithInfo report the assumptions behind
+	// its result, such as the date order used for "03/04/2024" or a year
+	// taken from RelativeBase. See ParseInfo.Warnings.
+	CollectWarnings bool
+
+	// MinDate and MaxDate, when non-zero, bound the dates ParseDate and
+	// Parser.Parse return: a date before MinDate or after MaxDate is an
+	// ErrDateOutOfRange, unless ClampToRange is set. The bounds themselves
+	// are in range.
+	MinDate time.Time
+	MaxDate time.Time
+
+	// ClampToRange returns MinDate or MaxDate in place of a date outside
+	// them instead of an error.
+	ClampToRange bool
+
+	// ClampDayOfMonth resolves a day past the end of a relative month, as
+	// in "the 31st of next month" when next month has 30 days, to the
+	// month's last day instead of returning ErrInvalidDate.
+	ClampDayOfMonth bool
+
+	// FewAmount is the number that vague amounts such as "a few" and
+	// "several" stand for in relative dates ("in a few weeks"). Zero means
+	// DefaultFewAmount. "A couple" is always 2.
+	FewAmount int
+
+	// Preprocessors rewrite the input, in order, before ParseDate,
+	// ParseDateRange and ExtractDates parse it. ExtractDates maps Position
+	// and Length back to the text it was given; MatchedText and Context are
+	// taken from the rewritten text.
+	Preprocessors []Preprocessor
+
+	// FiscalCalendar describes the fiscal year used by "FY2024", "Q1 FY2024",
+	// "next fiscal year" and "fiscal year to date". If nil, fiscal years are
+	// calendar years.
+	FiscalCalendar *FiscalCalendar
+}
+
+// FiscalCalendar describes a fiscal year that need not start in January.
+type FiscalCalendar struct {
+	// StartMonth is the first month of the fiscal year. Zero means January.
+	StartMonth time.Month
+
+	// NamedByStartYear names a fiscal year after the calendar year it
+	// starts in. By default a fiscal year is named after the year it ends
+	// in, so with an October start FY2024 runs from October 1, 2023 to
+	// September 30, 2024.
+	NamedByStartYear bool
 }
 
+// DefaultMaxInputLength is the input length limit applied when Settings.MaxInputLength is zero.
+const DefaultMaxInputLength = 10000
+
+// DefaultFewAmount is the amount "a few" stands for when Settings.FewAmount is zero.
+const DefaultFewAmount = 3
+
 // ParsedDate represents a date extracted from text with its position information.
 type ParsedDate struct {
 	// Date is the parsed date/time value
@@ -58,8 +326,92 @@ type ParsedDate struct {
 	// MatchedText is the actual text that was matched and parsed
 	MatchedText string
 
+	// Context is MatchedText with the text around it, up to
+	// Settings.ContextWindow characters on either side. It is empty when
+	// ContextWindow is zero.
+	Context string
+
 	// Confidence is a score (0.0 to 1.0) indicating parsing confidence
 	Confidence float64
+
+	// Ambiguous is true when the result depends on Settings.DateOrder,
+	// e.g. "03/04/2024" could be March 4 (MDY) or April 3 (DMY)
+	Ambiguous bool
+
+	// Granularity is the precision the date was written with: "year",
+	// "half", "quarter", "month", "day" or "time". For example, "Q3 2024" is
+	// "quarter" and "December 31, 2024 at 3:30 PM" is "time".
+	Granularity string
+
+	// PeriodStart and PeriodEnd are the first and last instant of the period
+	// named by a "year", "half" or "quarter" match, such as July 1, 2024 and
+	// September 30, 2024 23:59:59.999999999 for "Q3 2024". They are zero for
+	// finer granularities.
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+
+	// Approximate is true when the time was qualified as approximate, as in
+	// "around 3pm" or "noonish". Such matches carry a lower Confidence.
+	Approximate bool
+
+	// Modifier qualifies the date as written: "deadline" for "by December
+	// 31" or "no later than Friday", "approximate" for "on or about March
+	// 3" or an approximate time, and empty otherwise. ParseDate returns the
+	// underlying date either way.
+	Modifier string
+
+	// TimezoneName is the zone named in the match: an abbreviation such as
+	// "PST" or an IANA name such as "America/New_York". It is empty when the
+	// zone was written as an offset ("+05:30", "Z") or not written at all.
+	TimezoneName string
+
+	// TimezoneOffset is the UTC offset in seconds of a zone written in the
+	// match, taken at Date so that daylight saving time is accounted for.
+	// It is 0 when no zone was written.
+	TimezoneOffset int
+
+	// LeapSecond is true when the match wrote a leap second (":60") that
+	// Settings.AllowLeapSecond normalized to the following second.
+	LeapSecond bool
+}
+
+// Canonical returns Date as an ISO 8601 string no more precise than
+// Granularity: "2024" for a year, "2024-03" for a month, "2024-03-15" for a
+// day and "2024-03-15T10:30:00Z" for a time. Halves and quarters use the
+// common "2024-H1" and "2024-Q3" forms. An empty or unknown Granularity
+// gives the full RFC 3339 timestamp.
+func (p ParsedDate) Canonical() string {
+	switch p.Granularity {
+	case "year":
+		return p.Date.Format("2006")
+	case "half":
+		return fmt.Sprintf("%04d-H%d", p.Date.Year(), getHalf(p.Date))
+	case "quarter":
+		return fmt.Sprintf("%04d-Q%d", p.Date.Year(), getQuarter(p.Date))
+	case "month":
+		return p.Date.Format("2006-01")
+	case "day":
+		return p.Date.Format("2006-01-02")
+	}
+	return p.Date.Format(time.RFC3339Nano)
+}
+
+// Interval returns the half-open span [start, end) implied by Granularity:
+// a day runs from midnight to the next midnight, and a month, quarter, half
+// or year from its first day to the first day of the next one, so
+// "February 2024" is [2024-02-01, 2024-03-01). A date falls within the span
+// when !d.Before(start) && d.Before(end). Times and an empty or unknown
+// Granularity are instants, for which start and end both equal Date.
+func (p ParsedDate) Interval() (start, end time.Time) {
+	switch p.Granularity {
+	case "year", "half", "quarter", "month":
+		start = getStartOfPeriod(p.Date, p.Granularity, time.Monday)
+		return start, addPeriod(start, p.Granularity, 1)
+	case "day":
+		start = time.Date(p.Date.Year(), p.Date.Month(), p.Date.Day(), 0, 0, 0, 0, p.Date.Location())
+		return start, start.AddDate(0, 0, 1)
+	}
+	return p.Date, p.Date
 }
 
 // DefaultSettings returns a Settings struct with sensible defaults.
@@ -72,137 +424,323 @@ func DefaultSettings() *Settings {
 		Strict:            false,
 		PreferredTimezone: time.UTC,
 		PreferDatesFrom:   "future", // Default to forward-looking dates
+		MaxInputLength:    DefaultMaxInputLength,
+		WeekStartsOn:      "monday",
+		MergeDateTime:     true,
 	}
 }
 
 // ParseDate parses a date string and returns the corresponding time.Time value.
 // If opts is nil, DefaultSettings() is used.
 func ParseDate(input string, opts *Settings) (time.Time, error) {
-	if input == "" {
-		return time.Time{}, &ErrEmptyInput{}
-	}
+	return parseDateInRange(input, opts, nil)
+}
 
-	if opts == nil {
-		opts = DefaultSettings()
+// parseDateInRange is parseDate followed by the RejectFuture, RejectPast,
+// MinDate and MaxDate checks.
+func parseDateInRange(input string, opts *Settings, cache *regexCache) (time.Time, error) {
+	input, err := preprocessInput(input, opts)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !hasRangeChecks(opts) {
+		return parseDate(input, opts, cache)
 	}
 
-	// Check if we should auto-detect date order
-	autoDetect := opts.DateOrder == ""
+	opts = pinRelativeBase(opts)
+	result, err := parseDate(input, opts, cache)
+	if err != nil {
+		return result, err
+	}
+	return checkDateRange(input, result, opts)
+}
 
-	// Normalize settings
-	settings := normalizeSettings(opts)
+// preprocessInput runs Settings.Preprocessors on input. Like ExtractDates,
+// it checks the input length before and after them.
+func preprocessInput(input string, opts *Settings) (string, error) {
+	if opts == nil || len(opts.Preprocessors) == 0 {
+		return input, nil
+	}
+	if err := checkInputLength(input, normalizeSettings(opts)); err != nil {
+		return "", err
+	}
+	return preprocess(input, opts.Preprocessors), nil
+}
 
-	// Load language translations
-	langs := translations.GlobalRegistry.GetMultiple(settings.Languages)
+// hasRangeChecks reports whether opts sets RejectFuture, RejectPast,
+// MinDate or MaxDate.
+func hasRangeChecks(opts *Settings) bool {
+	return opts != nil && (opts.RejectFuture || opts.RejectPast || !opts.MinDate.IsZero() || !opts.MaxDate.IsZero())
+}
 
-	// Create parser context
-	ctx := &parserContext{
-		input:               input,
-		settings:            settings,
-		autoDetectDateOrder: autoDetect,
-		languages:           langs,
+// pinRelativeBase returns opts with a zero RelativeBase set to now when
+// RejectFuture or RejectPast is set, so the date is checked against the
+// base it was resolved from.
+func pinRelativeBase(opts *Settings) *Settings {
+	if !opts.RelativeBase.IsZero() || (!opts.RejectFuture && !opts.RejectPast) {
+		return opts
 	}
+	pinned := *opts
+	pinned.RelativeBase = time.Now()
+	return &pinned
+}
 
-	// Try each enabled parser in order
-	var parseErrors []error
+// checkDateRange applies the RejectFuture, RejectPast, MinDate and MaxDate
+// checks to result, clamping it with ClampToRange.
+func checkDateRange(input string, result time.Time, opts *Settings) (time.Time, error) {
+	base := opts.RelativeBase
+	if opts.RejectFuture && result.After(base) {
+		return time.Time{}, &ErrDateOutOfRange{Input: input, Date: result, Base: base, Reason: "in the future"}
+	}
+	if opts.RejectPast && result.Before(base) {
+		return time.Time{}, &ErrDateOutOfRange{Input: input, Date: result, Base: base, Reason: "in the past"}
+	}
 
-	// 1. Try timestamp parser
-	if isParserEnabled(settings, "timestamp") {
-		result, err := parseTimestamp(ctx)
-		if err == nil {
-			return result, nil
+	if !opts.MinDate.IsZero() && result.Before(opts.MinDate) {
+		if opts.ClampToRange {
+			return opts.MinDate, nil
 		}
-		// Check if it's a specific error type that should be returned as-is
-		if isSpecificError(err) {
-			return time.Time{}, err
+		return time.Time{}, &ErrDateOutOfRange{Input: input, Date: result, Base: opts.MinDate, Reason: "before MinDate"}
+	}
+	if !opts.MaxDate.IsZero() && result.After(opts.MaxDate) {
+		if opts.ClampToRange {
+			return opts.MaxDate, nil
 		}
-		parseErrors = append(parseErrors, err)
+		return time.Time{}, &ErrDateOutOfRange{Input: input, Date: result, Base: opts.MaxDate, Reason: "after MaxDate"}
 	}
+	return result, nil
+}
 
-	// 2. Try absolute date parser
-	if isParserEnabled(settings, "absolute") {
-		result, err := parseAbsolute(ctx)
-		if err == nil {
-			return result, nil
-		}
-		// Check if it's a specific error type that should be returned as-is
-		if isSpecificError(err) {
-			return time.Time{}, err
-		}
-		parseErrors = append(parseErrors, err)
+// parseDate implements ParseDate. If cache is non-nil, patterns built at
+// parse time are compiled through it (see Parser).
+func parseDate(input string, opts *Settings, cache *regexCache) (time.Time, error) {
+	result, err := parseDateInZone(input, opts, cache)
+	if err == nil && opts != nil && opts.NormalizeToUTC {
+		result = result.UTC()
 	}
+	return result, err
+}
 
-	// 3. Try relative date parser
-	if isParserEnabled(settings, "relative") {
-		result, err := parseRelative(ctx)
-		if err == nil {
-			return result, nil
-		}
-		// Check if it's a specific error type that should be returned as-is
-		if isSpecificError(err) {
-			return time.Time{}, err
-		}
-		parseErrors = append(parseErrors, err)
+// parseDateInZone runs the parsers; results keep the location they were parsed in.
+func parseDateInZone(input string, opts *Settings, cache *regexCache) (time.Time, error) {
+	if isBlank(input) {
+		return time.Time{}, &ErrEmptyInput{}
 	}
 
-	// 4. Try time parser (v1.0 Phase 3B)
-	if isParserEnabled(settings, "time") {
-		result, err := tryParseTime(ctx)
-		if err == nil {
-			return result, nil
-		}
-		// Check if it's a specific error type that should be returned as-is
-		if isSpecificError(err) {
-			return time.Time{}, err
-		}
-		parseErrors = append(parseErrors, err)
+	if opts == nil {
+		opts = DefaultSettings()
 	}
 
-	// 5. Try incomplete date parser (v1.1 Phase 4)
-	if isParserEnabled(settings, "incomplete") {
-		result, err := tryParseIncompleteDate(ctx)
-		if err == nil {
-			return result, nil
+	input, settings, err := prepareInput(input, opts)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, step := range preParseSteps() {
+		if !step.enabled(settings) {
+			continue
 		}
-		// Check if it's a specific error type that should be returned as-is
-		if isSpecificError(err) {
-			return time.Time{}, err
+		if result, ok, err := step.parse(input, opts, settings, cache); err != nil || ok {
+			return result, err
 		}
-		parseErrors = append(parseErrors, err)
 	}
 
-	// 6. Try ordinal date parser (v1.1 Phase 4)
-	if isParserEnabled(settings, "ordinal") {
-		result, err := tryParseOrdinalDate(ctx)
+	// Try each enabled parser in order
+	ctx := newChainContext(input, opts, settings, cache)
+	for _, step := range parserChain {
+		if !isParserEnabled(settings, step.name) {
+			continue
+		}
+		result, err := step.parse(ctx)
 		if err == nil {
 			return result, nil
 		}
 		// Check if it's a specific error type that should be returned as-is
 		if isSpecificError(err) {
-			return time.Time{}, err
+			return time.Time{}, withErrorInput(err, input)
 		}
-		parseErrors = append(parseErrors, err)
 	}
 
-	// 7. Try week number parser (v1.2 Phase 5)
-	if isParserEnabled(settings, "week") {
-		result, err := tryParseWeekNumber(ctx)
-		if err == nil {
-			return result, nil
-		}
-		// Check if it's a specific error type that should be returned as-is
-		if isSpecificError(err) {
+	// No parser succeeded - return helpful error
+	return time.Time{}, newInvalidFormatError(input)
+}
+
+// prepareInput normalizes opts, rejects over-long input before any pattern
+// runs, detects the languages when opts sets none and normalizes the
+// characters of input.
+func prepareInput(input string, opts *Settings) (string, *Settings, error) {
+	settings := normalizeSettings(opts)
+	if err := checkInputLength(input, settings); err != nil {
+		return "", nil, err
+	}
+
+	if len(opts.Languages) == 0 {
+		settings.Languages = detectLanguages(input, settings)
+	}
+	return normalizeCharacters(input, settings), settings, nil
+}
+
+// newChainContext returns the context the parser chain runs on input.
+func newChainContext(input string, opts, settings *Settings, cache *regexCache) *parserContext {
+	ctx := &parserContext{
+		settings:            settings,
+		autoDetectDateOrder: opts.DateOrder == "",
+		languages:           translations.GlobalRegistry.GetMultiple(settings.Languages),
+		cache:               cache,
+	}
+	ctx.input = normalizeInput(ctx, input)
+	return ctx
+}
+
+// preParseStep is a step ParseDate runs before the parser chain, for forms
+// that wrap something the chain parses. It reports ok == false when input
+// has no such form; otherwise its result or error is what ParseDate returns.
+type preParseStep struct {
+	name    string // TraceEntry.Parser name
+	enabled func(*Settings) bool
+	parse   func(input string, opts, settings *Settings, cache *regexCache) (time.Time, bool, error)
+}
+
+// preParseSteps lists the steps ParseDate runs before the parser chain, in
+// order. It is a function rather than a variable because the steps parse
+// their inner text with parseDateInZone, which reads the list.
+func preParseSteps() []preParseStep {
+	return []preParseStep{
+		// "3pm New York time": parse the rest and resolve it in the city's zone
+		{"city_timezone", parserEnabled("timezone"), parseCityTimezone},
+		// "week of March 3": parse the date and snap it to the start of its week
+		{"week_of", parserEnabled("week"), parseWeekOf},
+		// "45 minutes after 3pm": parse the anchor and offset it by the duration
+		{"offset_from", parserEnabled("relative"), parseOffsetFrom},
+		// "10 days until December 25": parse the date counted down to
+		{"countdown", parserEnabled("relative"), parseCountdownTarget},
+		// "by December 31", "on or about March 3": parse the date without its phrase
+		{"qualified", func(*Settings) bool { return true }, parseQualifiedDate},
+		// "around 3pm", "noonish": parse the time without its qualifier
+		{"approximate_time", parserEnabled("time"), parseApproximateTime},
+		// "3pm sharp", "exactly noon": parse the time without its marker
+		{"exact_time", parserEnabled("time"), parseExactTime},
+		// SelectBest: parse the most confident date extracted from input
+		{"select_best", func(settings *Settings) bool { return settings.SelectBest }, selectBestDate},
+	}
+}
+
+// parserEnabled returns a preParseStep.enabled func for the named parser.
+func parserEnabled(name string) func(*Settings) bool {
+	return func(settings *Settings) bool {
+		return isParserEnabled(settings, name)
+	}
+}
+
+// normalizeCharacters rewrites full-width digits and punctuation as ASCII
+// and, unless the "unicode_spaces" feature is disabled, replaces no-break,
+// narrow and full-width spaces with ASCII spaces.
+func normalizeCharacters(input string, settings *Settings) string {
+	input = translations.NormalizeWidth(input)
+	if isFeatureDisabled(settings, "unicode_spaces") {
+		return input
+	}
+	return translations.NormalizeSpaces(input)
+}
+
+// normalizeInput rewrites input into the form the parsers expect: native
+// digits become ASCII digits, vague amounts ("a couple of days") become
+// numbers (setting ctx.vagueAmount) and, with FuzzyMatching, misspelled
+// month and weekday names are corrected.
+func normalizeInput(ctx *parserContext, input string) string {
+	input = translations.NormalizeDigits(input, ctx.languages...)
+	input = translations.StripNamePeriods(input, ctx.languages...)
+	if isParserEnabled(ctx.settings, "relative") {
+		replaced := replaceVagueAmounts(ctx, input)
+		ctx.vagueAmount = replaced != input
+		input = replaced
+	}
+	if ctx.settings.FuzzyMatching && !isFeatureDisabled(ctx.settings, "fuzzy") {
+		input = translations.CorrectNameTypos(input, ctx.languages...)
+	}
+	return input
+}
+
+// parserStep is one parser of the chain run by ParseDate.
+type parserStep struct {
+	name  string // Settings.EnableParsers name
+	parse func(*parserContext) (time.Time, error)
+}
+
+// parserChain lists the parsers in the order ParseDate tries them.
+// Date parsers give date-only results Settings.DefaultTime.
+var parserChain = []parserStep{
+	{"timestamp", parseTimestamp},
+	{"absolute", withDefaultTime(parseAbsolute)},
+	{"relative", parseRelative},
+	{"time", tryParseTime},                                  // v1.0 Phase 3B
+	{"incomplete", withDefaultTime(tryParseIncompleteDate)}, // v1.1 Phase 4
+	{"ordinal", withDefaultTime(tryParseOrdinalDate)},       // v1.1 Phase 4
+	{"week", withDefaultTime(tryParseWeekNumber)},           // v1.2 Phase 5
+}
+
+// withDefaultTime wraps a date parser so its results get applyDefaultTime.
+func withDefaultTime(parse func(*parserContext) (time.Time, error)) func(*parserContext) (time.Time, error) {
+	return func(ctx *parserContext) (time.Time, error) {
+		result, err := parse(ctx)
+		if err != nil {
 			return time.Time{}, err
 		}
-		parseErrors = append(parseErrors, err)
+		return applyDefaultTime(ctx, result), nil
 	}
+}
 
-	// No parser succeeded - return helpful error
-	if len(parseErrors) > 0 {
-		return time.Time{}, newInvalidFormatError(input)
+// applyDefaultTime sets Settings.DefaultTime as the time of day of a date-only result.
+func applyDefaultTime(ctx *parserContext, result time.Time) time.Time {
+	offset := ctx.settings.DefaultTime
+	if ctx.hasTime || offset <= 0 || offset >= 24*time.Hour {
+		return result
 	}
 
-	return time.Time{}, newInvalidFormatError(input)
+	year, month, day := result.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, result.Location()).Add(offset)
+}
+
+// selectBestDate extracts all dates from input and returns the one with the
+// highest confidence, or ok == false if none was found.
+func selectBestDate(input string, opts, _ *Settings, cache *regexCache) (result time.Time, ok bool, err error) {
+	// Matches are parsed one by one, so they must not select again
+	// input has already been preprocessed
+	sub := *opts
+	sub.SelectBest = false
+	sub.Preprocessors = nil
+
+	results, err := extractDates(context.Background(), input, &sub, cache)
+	if err != nil || len(results) == 0 {
+		return time.Time{}, false, err
+	}
+
+	best := results[0]
+	for _, r := range results[1:] {
+		if r.Confidence > best.Confidence || (r.Confidence == best.Confidence && r.Position < best.Position) {
+			best = r
+		}
+	}
+	return best.Date, true, nil
+}
+
+// inLocation returns the time with t's wall clock in loc.
+func inLocation(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
+
+// withErrorInput records input on a specific error raised by a parser that
+// did not know the original input string.
+func withErrorInput(err error, input string) error {
+	var invalidErr *ErrInvalidDate
+	if errors.As(err, &invalidErr) && invalidErr.Input == "" {
+		invalidErr.Input = input
+	}
+	var ambiguousErr *ErrAmbiguousDate
+	if errors.As(err, &ambiguousErr) && ambiguousErr.Input == "" {
+		ambiguousErr.Input = input
+	}
+	return err
 }
 
 // isSpecificError checks if an error is a specific typed error that should be preserved
@@ -217,8 +755,87 @@ func isSpecificError(err error) bool {
 
 // ExtractDates scans text and extracts all recognizable dates with their positions.
 // If opts is nil, DefaultSettings() is used.
+//
+// Results are sorted by Position ascending, so the first result is the
+// earliest date in text; dates starting at the same position are ordered
+// longest match first. The order is deterministic: the same text and settings
+// always produce the same results in the same order.
 func ExtractDates(text string, opts *Settings) ([]ParsedDate, error) {
-	if text == "" {
+	return ExtractDatesContext(context.Background(), text, opts)
+}
+
+// ExtractDatesContext is like ExtractDates but stops scanning and returns
+// ctx.Err() as soon as ctx is canceled or its deadline expires.
+// Use it to bound the time spent on large or untrusted inputs.
+func ExtractDatesContext(ctx context.Context, text string, opts *Settings) ([]ParsedDate, error) {
+	return extractDates(ctx, text, opts, nil)
+}
+
+// ExtractDatesFunc is like ExtractDates but calls fn with each date as it
+// is found, in the order ExtractDates returns them, instead of building a
+// slice. Extraction stops when fn returns false. With
+// Settings.ResolveReferences, dates are only passed to fn once the whole
+// text has been scanned, since a reference may come before its anchor.
+func ExtractDatesFunc(text string, opts *Settings, fn func(ParsedDate) bool) error {
+	pctx, err := newExtractionContext(context.Background(), text, opts, nil)
+	if err != nil {
+		return err
+	}
+
+	if pctx.settings.ResolveReferences {
+		results, err := extractAllDates(pctx)
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			result.Position, result.Length = pctx.offsets.original(result.Position, result.Length)
+			if !fn(result) {
+				break
+			}
+		}
+		return nil
+	}
+
+	return scanDates(pctx, func(result ParsedDate) bool {
+		result.Position, result.Length = pctx.offsets.original(result.Position, result.Length)
+		return fn(result)
+	})
+}
+
+// ContainsDate reports whether text holds at least one date that
+// ExtractDates would find. It stops at the first date and builds no
+// ParsedDate results, so it is a cheap filter ahead of ExtractDates.
+// Settings such as EnableParsers and Languages apply as for ExtractDates;
+// blank or over-long text contains no date.
+// If opts is nil, DefaultSettings() is used.
+func ContainsDate(text string, opts *Settings) bool {
+	pctx, err := newExtractionContext(context.Background(), text, opts, nil)
+	if err != nil {
+		return false
+	}
+	return containsDate(pctx)
+}
+
+// extractDates implements ExtractDatesContext with an optional pattern cache.
+func extractDates(ctx context.Context, text string, opts *Settings, cache *regexCache) ([]ParsedDate, error) {
+	pctx, err := newExtractionContext(ctx, text, opts, cache)
+	if err != nil {
+		return nil, err
+	}
+	results, err := extractAllDates(pctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range results {
+		results[i].Position, results[i].Length = pctx.offsets.original(results[i].Position, results[i].Length)
+	}
+	return results, nil
+}
+
+// newExtractionContext validates text and opts and returns the context
+// extraction runs in.
+func newExtractionContext(ctx context.Context, text string, opts *Settings, cache *regexCache) (*parserContext, error) {
+	if isBlank(text) {
 		return nil, &ErrEmptyInput{}
 	}
 
@@ -231,49 +848,133 @@ func ExtractDates(text string, opts *Settings) ([]ParsedDate, error) {
 
 	settings := normalizeSettings(opts)
 
+	// Reject over-long input before running any patterns
+	if err := checkInputLength(text, settings); err != nil {
+		return nil, err
+	}
+
+	text, offsets := preprocessWithOffsets(text, opts.Preprocessors)
+	if isBlank(text) {
+		return nil, &ErrEmptyInput{}
+	}
+	if err := checkInputLength(text, settings); err != nil {
+		return nil, err
+	}
+
+	if len(opts.Languages) == 0 {
+		settings.Languages = detectLanguages(text, settings)
+	}
+
+	// Read ambiguous numeric dates in the order their siblings prove
+	siblingOrder := false
+	if settings.InferOrderFromSiblings {
+		if order := siblingDateOrder(text); order != "" {
+			settings.DateOrder = order
+			siblingOrder = true
+		}
+	}
+
 	// Load language translations
 	langs := translations.GlobalRegistry.GetMultiple(settings.Languages)
 
-	// Create parser context
-	ctx := &parserContext{
+	// Correct misspelled month and weekday names up front, so the
+	// extraction patterns see "September" in "Setpember 3 2024"
+	if settings.FuzzyMatching && !isFeatureDisabled(settings, "fuzzy") {
+		if corrected := translations.CorrectNameTypos(text, langs...); corrected != text {
+			text, offsets = corrected, composeOffsets(offsets, alignOffsets(text, corrected))
+		}
+	}
+
+	return &parserContext{
 		input:               text,
+		offsets:             offsets,
 		settings:            settings,
+		siblingOrder:        siblingOrder,
 		autoDetectDateOrder: autoDetect,
 		languages:           langs,
-	}
-
-	return extractAllDates(ctx)
+		cancel:              ctx,
+		cache:               cache,
+	}, nil
 }
 
 // parserContext holds the state during parsing operations.
 type parserContext struct {
 	input               string
+	offsets             *offsetMap // maps offsets of input back to the text before Settings.Preprocessors; nil if none ran
 	settings            *Settings
+	siblingOrder        bool                     // DateOrder was inferred from unambiguous numeric dates in input
 	autoDetectDateOrder bool                     // true if DateOrder should be auto-detected
 	languages           []*translations.Language // loaded language translations
+	cancel              context.Context          // checked during extraction scans; may be nil
+	cache               *regexCache              // compiled dynamic patterns; nil compiles on every call
+	hasTime             bool                     // set by date parsers when the input carried a time of day
+	vagueAmount         bool                     // input had a vague amount ("a few weeks") rewritten as a number
 }
 
 // normalizeSettings ensures settings have valid values.
 func normalizeSettings(opts *Settings) *Settings {
 	settings := &Settings{
-		DateOrder:         opts.DateOrder,
-		Languages:         opts.Languages,
-		RelativeBase:      opts.RelativeBase,
-		EnableParsers:     opts.EnableParsers,
-		Strict:            opts.Strict,
-		PreferredTimezone: opts.PreferredTimezone,
-		PreferDatesFrom:   opts.PreferDatesFrom,
+		DateOrder:              opts.DateOrder,
+		Languages:              languageCodes(opts.Languages),
+		DetectionThreshold:     opts.DetectionThreshold,
+		DefaultLanguage:        opts.DefaultLanguage,
+		RelativeBase:           opts.RelativeBase,
+		EnableParsers:          opts.EnableParsers,
+		Strict:                 opts.Strict,
+		PreferredTimezone:      opts.PreferredTimezone,
+		PreferDatesFrom:        opts.PreferDatesFrom,
+		DecimalSeparator:       opts.DecimalSeparator,
+		MaxInputLength:         opts.MaxInputLength,
+		WeekStartsOn:           opts.WeekStartsOn,
+		DefaultTime:            opts.DefaultTime,
+		CityTimezones:          opts.CityTimezones,
+		DayPartTimes:           opts.DayPartTimes,
+		SelectBest:             opts.SelectBest,
+		NormalizeToUTC:         opts.NormalizeToUTC,
+		Weekend:                opts.Weekend,
+		Holidays:               opts.Holidays,
+		NamedDates:             opts.NamedDates,
+		EndOfDay:               opts.EndOfDay,
+		FuzzyMatching:          opts.FuzzyMatching,
+		DisableFeatures:        opts.DisableFeatures,
+		MergeDateTime:          opts.MergeDateTime,
+		AllowBareMonths:        opts.AllowBareMonths,
+		RequireMonthContext:    opts.RequireMonthContext,
+		MaxResults:             opts.MaxResults,
+		ContextWindow:          opts.ContextWindow,
+		MinMatchLength:         opts.MinMatchLength,
+		InferOrderFromSiblings: opts.InferOrderFromSiblings,
+		AbbreviatedYears:       opts.AbbreviatedYears,
+		FewAmount:              opts.FewAmount,
+		AllowCompactISO:        opts.AllowCompactISO,
+		AllowRomanMonths:       opts.AllowRomanMonths,
+		AllowLeapSecond:        opts.AllowLeapSecond,
+		AllowExtendedHours:     opts.AllowExtendedHours,
+		FiscalCalendar:         opts.FiscalCalendar,
+		GroupedTimestamps:      opts.GroupedTimestamps,
+		ResolveReferences:      opts.ResolveReferences,
+		RejectFuture:           opts.RejectFuture,
+		RejectPast:             opts.RejectPast,
+		MinDate:                opts.MinDate,
+		MaxDate:                opts.MaxDate,
+		ClampToRange:           opts.ClampToRange,
+		ClampDayOfMonth:        opts.ClampDayOfMonth,
+		CollectWarnings:        opts.CollectWarnings,
 	}
 
 	// Set defaults for empty values
 	if settings.DateOrder == "" {
-		settings.DateOrder = "MDY"
+		settings.DateOrder = defaultDateOrder(opts.Languages)
 	}
 
 	if len(settings.Languages) == 0 {
 		settings.Languages = []string{"en"}
 	}
 
+	if settings.DefaultLanguage == "" {
+		settings.DefaultLanguage = "en"
+	}
+
 	if settings.RelativeBase.IsZero() {
 		settings.RelativeBase = time.Now()
 	}
@@ -290,9 +991,91 @@ func normalizeSettings(opts *Settings) *Settings {
 		settings.PreferDatesFrom = "future"
 	}
 
+	if settings.MaxInputLength == 0 {
+		settings.MaxInputLength = DefaultMaxInputLength
+	}
+
+	if settings.WeekStartsOn == "" {
+		settings.WeekStartsOn = "monday"
+	}
+
+	if settings.FewAmount == 0 {
+		settings.FewAmount = DefaultFewAmount
+	}
+
 	return settings
 }
 
+// defaultDateOrder returns the DateOrder used when Settings.DateOrder is empty:
+// the locale's order when exactly one language is active ("de" -> DMY,
+// "ja" -> YMD, "en-GB" -> DMY by its region), otherwise MDY.
+func defaultDateOrder(languages []string) string {
+	if len(languages) == 1 {
+		code, region := translations.ParseLanguageTag(languages[0])
+		if order := translations.RegionDateOrder(region); order != "" {
+			return order
+		}
+		if lang, ok := lookupLanguage(code); ok && lang.DefaultDateOrder != "" {
+			return lang.DefaultDateOrder
+		}
+	}
+	return "MDY"
+}
+
+// detectLanguages returns the languages autodetection picks for input when
+// Settings.Languages is empty: DefaultLanguage, followed by the
+// best-scoring language unless nothing scores or the best score is below
+// DetectionThreshold. DefaultLanguage stays first so that defaults taken
+// from the first language, such as the decimal separator, do not hinge on
+// a guess.
+func detectLanguages(input string, settings *Settings) []string {
+	fallback, _ := translations.ParseLanguageTag(settings.DefaultLanguage)
+
+	scores := translations.GlobalRegistry.DetectLanguageScores(input)
+	best := translations.GlobalRegistry.DetectLanguage(input)
+	if score := scores[best]; score == 0 || float64(score) < settings.DetectionThreshold || best == fallback {
+		return []string{fallback}
+	}
+	return []string{fallback, best}
+}
+
+// languageCodes turns the BCP 47 tags in languages ("en-GB", "pt-BR",
+// "zh-Hant") into registry codes ("en", "pt", "zh"), dropping duplicates.
+// Plain codes are returned as given.
+func languageCodes(languages []string) []string {
+	plain := true
+	for _, tag := range languages {
+		if strings.ContainsAny(tag, "-_") || strings.ToLower(tag) != tag {
+			plain = false
+			break
+		}
+	}
+	if plain {
+		return languages
+	}
+
+	codes := make([]string, 0, len(languages))
+	seen := make(map[string]bool)
+	for _, tag := range languages {
+		code, _ := translations.ParseLanguageTag(tag)
+		if !seen[code] {
+			seen[code] = true
+			codes = append(codes, code)
+		}
+	}
+	return codes
+}
+
+// checkInputLength returns ErrInputTooLong if input exceeds the configured limit.
+// Go's regexp engine runs in linear time, so this bounds the total matching work
+// (including the per-language patterns built at parse time) for untrusted input.
+func checkInputLength(input string, settings *Settings) error {
+	if settings.MaxInputLength > 0 && len(input) > settings.MaxInputLength {
+		return &ErrInputTooLong{Length: len(input), MaxLength: settings.MaxInputLength}
+	}
+	return nil
+}
+
 // isParserEnabled checks if a specific parser is enabled in settings.
 func isParserEnabled(settings *Settings, parserName string) bool {
 	for _, enabled := range settings.EnableParsers {
@@ -302,3 +1085,22 @@ func isParserEnabled(settings *Settings, parserName string) bool {
 	}
 	return false
 }
+
+// features lists the names accepted in Settings.DisableFeatures.
+var features = map[string]bool{
+	"ordinal_words":  true,
+	"fuzzy":          true,
+	"two_digit_year": true,
+	"bare_year":      true,
+	"unicode_spaces": true,
+}
+
+// isFeatureDisabled checks if a feature is listed in Settings.DisableFeatures.
+func isFeatureDisabled(settings *Settings, feature string) bool {
+	for _, disabled := range settings.DisableFeatures {
+		if disabled == feature {
+			return true
+		}
+	}
+	return false
+}
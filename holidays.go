@@ -0,0 +1,160 @@
+package godateparser
+
+import (
+	"strings"
+	"time"
+)
+
+// holidayDate gives the month and day a holiday falls on in year.
+type holidayDate func(year int) (time.Month, int)
+
+// fixedHoliday returns a holidayDate for a holiday on the same day every year.
+func fixedHoliday(month time.Month, day int) holidayDate {
+	return func(int) (time.Month, int) { return month, day }
+}
+
+// nthWeekdayHoliday returns a holidayDate for the nth given weekday of month,
+// such as the fourth Thursday of November.
+func nthWeekdayHoliday(month time.Month, weekday time.Weekday, n int) holidayDate {
+	return func(year int) (time.Month, int) {
+		first := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC).Weekday()
+		return month, 1 + (int(weekday-first)+7)%7 + 7*(n-1)
+	}
+}
+
+// easterSunday gives the date of Western Easter, by the anonymous Gregorian
+// algorithm.
+func easterSunday(year int) (time.Month, int) {
+	a := year % 19
+	b, c := year/100, year%100
+	d, e := b/4, b%4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i, k := c/4, c%4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	n := h + l - 7*m + 114
+	return time.Month(n / 31), n%31 + 1
+}
+
+var (
+	christmas     = fixedHoliday(time.December, 25)
+	christmasEve  = fixedHoliday(time.December, 24)
+	newYearsDay   = fixedHoliday(time.January, 1)
+	newYearsEve   = fixedHoliday(time.December, 31)
+	thanksgiving  = nthWeekdayHoliday(time.November, time.Thursday, 4)
+	valentinesDay = fixedHoliday(time.February, 14)
+)
+
+// builtinHolidays maps lowercase holiday names, in the languages with
+// countdown terms, to the day they fall on.
+var builtinHolidays = map[string]holidayDate{
+	// English
+	"christmas":        christmas,
+	"christmas day":    christmas,
+	"xmas":             christmas,
+	"christmas eve":    christmasEve,
+	"new year":         newYearsDay,
+	"new year's":       newYearsDay,
+	"new year's day":   newYearsDay,
+	"new years day":    newYearsDay,
+	"new year's eve":   newYearsEve,
+	"new years eve":    newYearsEve,
+	"valentine's day":  valentinesDay,
+	"valentines day":   valentinesDay,
+	"halloween":        fixedHoliday(time.October, 31),
+	"independence day": fixedHoliday(time.July, 4),
+	"easter":           easterSunday,
+	"easter sunday":    easterSunday,
+	"thanksgiving":     thanksgiving,
+	"thanksgiving day": thanksgiving,
+
+	// Spanish
+	"navidad":    christmas,
+	"nochebuena": christmasEve,
+	"año nuevo":  newYearsDay,
+	"nochevieja": newYearsEve,
+	"pascua":     easterSunday,
+
+	// French
+	"noël":      christmas,
+	"noel":      christmas,
+	"nouvel an": newYearsDay,
+	"pâques":    easterSunday,
+	"paques":    easterSunday,
+
+	// German
+	"weihnachten": christmas,
+	"heiligabend": christmasEve,
+	"neujahr":     newYearsDay,
+	"silvester":   newYearsEve,
+	"ostern":      easterSunday,
+
+	// Italian
+	"natale":            christmas,
+	"vigilia di natale": christmasEve,
+	"capodanno":         newYearsDay,
+	"pasqua":            easterSunday,
+
+	// Portuguese
+	"natal":            christmas,
+	"véspera de natal": christmasEve,
+	"ano novo":         newYearsDay,
+	"páscoa":           easterSunday,
+	"pascoa":           easterSunday,
+
+	// Dutch
+	"kerstmis":       christmas,
+	"kerst":          christmas,
+	"kerstavond":     christmasEve,
+	"nieuwjaarsdag":  newYearsDay,
+	"oudejaarsavond": newYearsEve,
+	"pasen":          easterSunday,
+}
+
+// namedDateArticles are leading articles ignored in holiday and named-date
+// names: "the deadline", "la navidad", "het nieuwjaar".
+var namedDateArticles = []string{"the ", "el ", "la ", "le ", "il ", "o ", "het ", "de "}
+
+// normalizeDateName lowercases name, straightens apostrophes and drops a
+// leading article.
+func normalizeDateName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	name = strings.ReplaceAll(name, "’", "'")
+	for _, article := range namedDateArticles {
+		if rest, ok := strings.CutPrefix(name, article); ok {
+			return strings.TrimSpace(rest)
+		}
+	}
+	return name
+}
+
+// resolveNamedDate resolves a holiday or named date such as "Christmas" or
+// "the deadline". Settings.NamedDates entries are returned as given; built-in
+// holidays fall on their next occurrence on or after RelativeBase's day, at
+// midnight in PreferredTimezone plus DefaultTime.
+func resolveNamedDate(name string, settings *Settings) (time.Time, bool) {
+	name = normalizeDateName(name)
+
+	for entry, date := range settings.NamedDates {
+		if normalizeDateName(entry) == name {
+			return date, true
+		}
+	}
+
+	holiday, ok := builtinHolidays[name]
+	if !ok {
+		return time.Time{}, false
+	}
+
+	base := settings.RelativeBase.In(settings.PreferredTimezone)
+	today := time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, settings.PreferredTimezone)
+	for year := base.Year(); ; year++ {
+		month, day := holiday(year)
+		date := time.Date(year, month, day, 0, 0, 0, 0, settings.PreferredTimezone)
+		if !date.Before(today) {
+			return date.Add(settings.DefaultTime), true
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package godateparser
+
+import (
+	"html"
+	"strings"
+)
+
+// ExtractDatesFromHTML extracts dates from the visible text of an HTML document.
+// Tags, comments, and the contents of <script> and <style> elements are
+// skipped and character references such as "&nbsp;" are decoded before
+// extraction. Position and Length of each result refer to the original html
+// string, so they can be used to highlight the raw markup; MatchedText is the
+// decoded text that was parsed.
+// If opts is nil, DefaultSettings() is used.
+func ExtractDatesFromHTML(htmlText string, opts *Settings) ([]ParsedDate, error) {
+	if isBlank(htmlText) {
+		return nil, &ErrEmptyInput{}
+	}
+
+	text, starts, ends := stripHTML(htmlText)
+	results, err := ExtractDates(text, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range results {
+		r := &results[i]
+		start := starts[r.Position]
+		end := ends[r.Position+r.Length-1]
+		r.Position = start
+		r.Length = end - start
+	}
+
+	return results, nil
+}
+
+// stripHTML returns the text content of s. For every byte of the text, starts
+// and ends hold the byte range of s it was produced from: each tag or comment
+// becomes a single space spanning the whole markup, and a decoded character
+// reference spans the reference.
+func stripHTML(s string) (text string, starts, ends []int) {
+	var b strings.Builder
+	b.Grow(len(s))
+	starts = make([]int, 0, len(s))
+	ends = make([]int, 0, len(s))
+
+	emit := func(str string, from, to int) {
+		b.WriteString(str)
+		for j := 0; j < len(str); j++ {
+			starts = append(starts, from)
+			ends = append(ends, to)
+		}
+	}
+
+	for i := 0; i < len(s); {
+		switch {
+		case strings.HasPrefix(s[i:], "<!--"):
+			to := len(s)
+			if end := strings.Index(s[i+4:], "-->"); end >= 0 {
+				to = i + 4 + end + 3
+			}
+			emit(" ", i, to)
+			i = to
+
+		case s[i] == '<' && i+1 < len(s) && isTagStart(s[i+1]):
+			end := strings.IndexByte(s[i:], '>')
+			if end < 0 {
+				// Unterminated tag: keep the rest as text
+				emit(s[i:i+1], i, i+1)
+				i++
+				continue
+			}
+			to := i + end + 1
+			if name := tagName(s[i+1 : to-1]); name == "script" || name == "style" {
+				to = skipRawText(s, to, name)
+			}
+			emit(" ", i, to)
+			i = to
+
+		case s[i] == '&':
+			if semi := strings.IndexByte(s[i:min(len(s), i+12)], ';'); semi > 0 {
+				ref := s[i : i+semi+1]
+				if decoded := html.UnescapeString(ref); decoded != ref {
+					if decoded == "\u00a0" {
+						decoded = " " // &nbsp; must separate words for the date patterns
+					}
+					emit(decoded, i, i+semi+1)
+					i += semi + 1
+					continue
+				}
+			}
+			emit(s[i:i+1], i, i+1)
+			i++
+
+		default:
+			emit(s[i:i+1], i, i+1)
+			i++
+		}
+	}
+
+	return b.String(), starts, ends
+}
+
+// isTagStart reports whether c can follow "<" in markup ("<p", "</p", "<!DOCTYPE", "<?xml").
+func isTagStart(c byte) bool {
+	return c == '/' || c == '!' || c == '?' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
+
+// tagName returns the lowercased element name of an opening tag's contents ("script type=..." -> "script").
+func tagName(tag string) string {
+	end := strings.IndexAny(tag, " \t\r\n/")
+	if end < 0 {
+		end = len(tag)
+	}
+	return strings.ToLower(tag[:end])
+}
+
+// skipRawText returns the offset just past the closing tag of a script or
+// style element whose content starts at from, or len(s) if it is unclosed.
+func skipRawText(s string, from int, name string) int {
+	closing := strings.Index(strings.ToLower(s[from:]), "</"+name)
+	if closing < 0 {
+		return len(s)
+	}
+	closing += from
+	if end := strings.IndexByte(s[closing:], '>'); end >= 0 {
+		return closing + end + 1
+	}
+	return len(s)
+}
@@ -0,0 +1,255 @@
+package godateparser
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"regexp"
+	"sync"
+	"time"
+
+	"github.com/coredds/godateparser/translations"
+)
+
+// Parser is a reusable date parser bound to a fixed set of settings.
+// Language-specific patterns are compiled once and cached on the Parser,
+// so reusing one Parser across many calls (servers, batch jobs) avoids
+// competing with other callers for the cache shared by the package-level
+// ParseDate and ExtractDates.
+// A Parser is safe for concurrent use.
+type Parser struct {
+	settings Settings
+	cache    *regexCache
+}
+
+// New creates a Parser with the given settings.
+// If opts is nil, DefaultSettings() is used. The settings are copied, so later
+// changes to opts do not affect the Parser. A zero RelativeBase is resolved to
+// time.Now() on every call, as with ParseDate.
+func New(opts *Settings) (*Parser, error) {
+	if opts == nil {
+		opts = DefaultSettings()
+	}
+
+	if err := validateSettings(opts); err != nil {
+		return nil, err
+	}
+
+	settings := *opts
+	settings.Languages = append([]string(nil), opts.Languages...)
+	settings.EnableParsers = append([]string(nil), opts.EnableParsers...)
+	settings.Weekend = append([]time.Weekday(nil), opts.Weekend...)
+	settings.Holidays = append([]time.Time(nil), opts.Holidays...)
+	settings.DisableFeatures = append([]string(nil), opts.DisableFeatures...)
+	settings.Preprocessors = append([]Preprocessor(nil), opts.Preprocessors...)
+	if opts.CityTimezones != nil {
+		settings.CityTimezones = maps.Clone(opts.CityTimezones)
+	}
+	if opts.DayPartTimes != nil {
+		settings.DayPartTimes = maps.Clone(opts.DayPartTimes)
+	}
+	if opts.NamedDates != nil {
+		settings.NamedDates = maps.Clone(opts.NamedDates)
+	}
+	if opts.FiscalCalendar != nil {
+		fiscal := *opts.FiscalCalendar
+		settings.FiscalCalendar = &fiscal
+	}
+
+	return &Parser{
+		settings: settings,
+		cache:    newRegexCache(),
+	}, nil
+}
+
+// Parse parses a date string using the Parser's settings.
+func (p *Parser) Parse(input string) (time.Time, error) {
+	return parseDateInRange(input, &p.settings, p.cache)
+}
+
+// Extract scans text and extracts all recognizable dates using the Parser's settings.
+func (p *Parser) Extract(text string) ([]ParsedDate, error) {
+	return extractDates(context.Background(), text, &p.settings, p.cache)
+}
+
+// ExtractContext is like Extract but stops when ctx is canceled.
+func (p *Parser) ExtractContext(ctx context.Context, text string) ([]ParsedDate, error) {
+	return extractDates(ctx, text, &p.settings, p.cache)
+}
+
+// validateSettings checks settings values that New can reject up front.
+func validateSettings(opts *Settings) error {
+	switch opts.DateOrder {
+	case "", "YMD", "MDY", "DMY":
+	default:
+		return fmt.Errorf("invalid DateOrder %q: must be YMD, MDY or DMY", opts.DateOrder)
+	}
+
+	switch opts.PreferDatesFrom {
+	case "", "future", "past":
+	default:
+		return fmt.Errorf("invalid PreferDatesFrom %q: must be future or past", opts.PreferDatesFrom)
+	}
+
+	switch opts.DecimalSeparator {
+	case "", ".", ",":
+	default:
+		return fmt.Errorf("invalid DecimalSeparator %q: must be \".\" or \",\"", opts.DecimalSeparator)
+	}
+
+	if opts.WeekStartsOn != "" {
+		if _, ok := weekdayByName(opts.WeekStartsOn); !ok {
+			return fmt.Errorf("invalid WeekStartsOn %q: must be a weekday name", opts.WeekStartsOn)
+		}
+	}
+
+	if opts.DefaultTime < 0 || opts.DefaultTime >= 24*time.Hour {
+		return fmt.Errorf("invalid DefaultTime %v: must be in [0, 24h)", opts.DefaultTime)
+	}
+
+	if opts.EndOfDay < 0 || opts.EndOfDay >= 24*time.Hour {
+		return fmt.Errorf("invalid EndOfDay %v: must be in [0, 24h)", opts.EndOfDay)
+	}
+
+	weekend := make(map[time.Weekday]bool)
+	for _, day := range opts.Weekend {
+		if day < time.Sunday || day > time.Saturday {
+			return fmt.Errorf("invalid Weekend day %d: must be a time.Weekday", day)
+		}
+		weekend[day] = true
+	}
+	if len(weekend) == 7 {
+		return fmt.Errorf("invalid Weekend: every day of the week is a weekend day, leaving no business days")
+	}
+
+	if opts.MaxResults < 0 {
+		return fmt.Errorf("invalid MaxResults %d: must not be negative", opts.MaxResults)
+	}
+
+	if opts.MinMatchLength < 0 {
+		return fmt.Errorf("invalid MinMatchLength %d: must not be negative", opts.MinMatchLength)
+	}
+
+	if opts.ContextWindow < 0 {
+		return fmt.Errorf("invalid ContextWindow %d: must not be negative", opts.ContextWindow)
+	}
+
+	for i, p := range opts.Preprocessors {
+		if p == nil {
+			return fmt.Errorf("invalid Preprocessors: entry %d is nil", i)
+		}
+	}
+
+	if opts.FewAmount < 0 {
+		return fmt.Errorf("invalid FewAmount %d: must not be negative", opts.FewAmount)
+	}
+
+	if opts.FiscalCalendar != nil && (opts.FiscalCalendar.StartMonth < 0 || opts.FiscalCalendar.StartMonth > time.December) {
+		return fmt.Errorf("invalid FiscalCalendar.StartMonth %d: must be 1-12, or 0 for January", opts.FiscalCalendar.StartMonth)
+	}
+
+	if !opts.MinDate.IsZero() && !opts.MaxDate.IsZero() && opts.MinDate.After(opts.MaxDate) {
+		return fmt.Errorf("invalid MinDate %s: after MaxDate %s", opts.MinDate.Format(time.RFC3339), opts.MaxDate.Format(time.RFC3339))
+	}
+
+	for city, tzName := range opts.CityTimezones {
+		if _, err := time.LoadLocation(tzName); err != nil {
+			return fmt.Errorf("invalid CityTimezones entry %q: unknown timezone %q", city, tzName)
+		}
+	}
+
+	for name, clock := range opts.DayPartTimes {
+		if _, _, ok := parseClockTime(clock); !ok {
+			return fmt.Errorf("invalid DayPartTimes entry %q: time %q is not HH:MM", name, clock)
+		}
+	}
+
+	for _, feature := range opts.DisableFeatures {
+		if !features[feature] {
+			return fmt.Errorf("unknown DisableFeatures entry %q", feature)
+		}
+	}
+
+	if opts.DetectionThreshold < 0 {
+		return fmt.Errorf("invalid DetectionThreshold %v: must not be negative", opts.DetectionThreshold)
+	}
+
+	if opts.DefaultLanguage != "" {
+		code, _ := translations.ParseLanguageTag(opts.DefaultLanguage)
+		if _, ok := lookupLanguage(code); !ok {
+			return fmt.Errorf("unsupported DefaultLanguage %q", opts.DefaultLanguage)
+		}
+	}
+
+	for _, tag := range opts.Languages {
+		code, _ := translations.ParseLanguageTag(tag)
+		if _, ok := lookupLanguage(code); !ok {
+			return fmt.Errorf("unsupported language %q", tag)
+		}
+	}
+
+	return nil
+}
+
+// lookupLanguage reports whether code is registered in the global registry.
+func lookupLanguage(code string) (*translations.Language, bool) {
+	langs := translations.GlobalRegistry.GetMultiple([]string{code})
+	if len(langs) == 1 && langs[0].Code == code {
+		return langs[0], true
+	}
+	return nil, false
+}
+
+// maxCachedPatterns bounds a regexCache. The patterns built at parse time
+// depend on the languages and settings in use, so a process normally sees a
+// limited set of them; the bound keeps settings that vary from call to call
+// from growing a cache without end.
+const maxCachedPatterns = 4096
+
+// sharedRegexCache holds the patterns compiled by the package-level
+// functions, which have no Parser cache of their own.
+var sharedRegexCache = newRegexCache()
+
+// regexCache stores compiled regular expressions keyed by their source,
+// holding at most maxCachedPatterns of them.
+type regexCache struct {
+	mu       sync.RWMutex
+	patterns map[string]*regexp.Regexp
+}
+
+func newRegexCache() *regexCache {
+	return &regexCache{patterns: make(map[string]*regexp.Regexp)}
+}
+
+// get returns the compiled form of pattern, compiling and storing it on
+// first use. When the cache is full, an arbitrary pattern is evicted.
+func (c *regexCache) get(pattern string) *regexp.Regexp {
+	c.mu.RLock()
+	re, ok := c.patterns[pattern]
+	c.mu.RUnlock()
+	if ok {
+		return re
+	}
+
+	re = regexp.MustCompile(pattern)
+	c.mu.Lock()
+	if _, ok := c.patterns[pattern]; !ok && len(c.patterns) >= maxCachedPatterns {
+		for evicted := range c.patterns {
+			delete(c.patterns, evicted)
+			break
+		}
+	}
+	c.patterns[pattern] = re
+	c.mu.Unlock()
+	return re
+}
+
+// compile returns the compiled regex for a pattern built at parse time,
+// reusing the Parser's cache when one is attached to the context and the
+// shared cache otherwise.
+func (ctx *parserContext) compile(pattern string) *regexp.Regexp {
+	if ctx.cache == nil {
+		return sharedRegexCache.get(pattern)
+	}
+	return ctx.cache.get(pattern)
+}
@@ -17,36 +17,82 @@ var absolutePatterns = []*absolutePattern{
 	{
 		regex:  regexp.MustCompile(`^(\d{4})年(\d{1,2})月(\d{1,2})日$`),
 		format: "YMD",
-		parser: parseCJKDate,
+		parser: parseYMDDate,
 	},
-	// ISO 8601: 2024-12-31, 2024-12-31T10:30:00
+	// Year-first numeric formats: 2024/12/31, 2024.12.31
+	// A leading 4-digit year can only be YMD, so DateOrder is not consulted
 	{
-		regex:  regexp.MustCompile(`(?i)^(\d{4})-(\d{1,2})-(\d{1,2})(?:[T\s](\d{1,2}):(\d{1,2})(?::(\d{1,2}))?)?`),
+		regex:  regexp.MustCompile(`^(\d{4})/(\d{1,2})/(\d{1,2})$`),
+		format: "YMD",
+		parser: parseYMDDate,
+	},
+	{
+		regex:  regexp.MustCompile(`^(\d{4})\.(\d{1,2})\.(\d{1,2})$`),
+		format: "YMD",
+		parser: parseYMDDate,
+	},
+	// ISO 8601: 2024-12-31, 2024-12-31T10:30:00, 2024-12-31 10:30:00.123456
+	// "T" and a space are interchangeable; fractional seconds may use "." or "," (1-9 digits)
+	{
+		regex:  regexp.MustCompile(`(?i)^(\d{4})-(\d{1,2})-(\d{1,2})(?:(?:T|\s+)(\d{1,2}):(\d{1,2})(?::(\d{1,2})(?:[.,](\d{1,9}))?)?)?\s*$`),
 		format: "YMD",
 		parser: parseISO8601,
 	},
+	// Compact ISO 8601: 20241231T153045, 20241231T1530Z; a bare 20241231 only with Settings.AllowCompactISO
+	{
+		regex:  regexp.MustCompile(`(?i)^(\d{4})(\d{2})(\d{2})(?:T(\d{2})(\d{2})(?:(\d{2})(?:[.,](\d{1,9}))?)?)?$`),
+		format: "YMD",
+		parser: parseCompactISO,
+	},
 	// ISO 8601 with 2-digit year: 24-12-31
 	{
 		regex:  regexp.MustCompile(`(?i)^(\d{2})-(\d{1,2})-(\d{1,2})$`),
 		format: "YMD",
 		parser: parseISO8601TwoDigitYear,
 	},
-	// Month name formats: "31 Dec 2024", "15 January 2024", "31 Dec 24"
+	// Month name formats: "31 Dec 2024", "15 January 2024", "31 Dec 24", "31 December, 2024"
+	// The month name fixes the day/year positions, so DateOrder is not consulted
 	{
-		regex:  regexp.MustCompile(`(?i)^(\d{1,2})\s+(Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|Jun(?:e)?|Jul(?:y)?|Aug(?:ust)?|Sep(?:tember)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)[,\s]*(\d{2,4})`),
+		regex:  regexp.MustCompile(`(?i)^(\d{1,2})\s+(Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|Jun(?:e)?|Jul(?:y)?|Aug(?:ust)?|Sep(?:t(?:ember)?)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)[,\s]+(\d{2,4})\b`),
 		format: "DMY",
 		parser: parseMonthName,
 	},
 	// Month name formats: "December 31, 2024", "Dec 31 2024", "Jan 15 2024", "Dec 31 24"
+	// The comma is optional, but some separator is required so "Dec 312024" is rejected
 	{
-		regex:  regexp.MustCompile(`(?i)^(Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|Jun(?:e)?|Jul(?:y)?|Aug(?:ust)?|Sep(?:tember)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)\s+(\d{1,2})[,\s]*(\d{2,4})`),
+		regex:  regexp.MustCompile(`(?i)^(Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|Jun(?:e)?|Jul(?:y)?|Aug(?:ust)?|Sep(?:t(?:ember)?)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)\s+(\d{1,2})[,\s]+(\d{2,4})\b`),
 		format: "MDY",
 		parser: parseMonthName,
 	},
-	// Numeric formats: 12/31/2024, 12-31-2024, 12/31/24, 12-31-24
-	// Note: MDY and DMY both use the same regex - disambiguation happens in parseNumericDate
+	// Roman-numeral months: 15.XII.2024, 15-XII-2024, 15 XII 2024 (only with Settings.AllowRomanMonths)
+	// Spaces alone separate only numerals of two or more letters ("1 V 2024" is rejected)
 	{
-		regex:  regexp.MustCompile(`^(\d{1,2})[/-](\d{1,2})[/-](\d{2,4})$`),
+		regex:  regexp.MustCompile(`(?i)^(\d{1,2})([./-]\s*|\s+)(?P<month>[IVX]+)([./-]\s*|\s+)(\d{2}|\d{4})$`),
+		format: "DMY",
+		parser: parseRomanMonthDate,
+	},
+	// Numeric formats: 12/31/2024, 12-31-2024, 12/31/24, 12-31-24, 31.12.2024
+	// Note: MDY and DMY both use the same regexes - disambiguation happens in parseNumericDate
+	// One pattern per separator, so mixed separators ("12/31-2024") are rejected
+	{
+		regex:  regexp.MustCompile(`^(\d{1,2})/(\d{1,2})/(\d{2,4})$`),
+		format: "MDY",
+		parser: parseNumericDate,
+	},
+	{
+		regex:  regexp.MustCompile(`^(\d{1,2})-(\d{1,2})-(\d{2,4})$`),
+		format: "MDY",
+		parser: parseNumericDate,
+	},
+	{
+		regex:  regexp.MustCompile(`^(\d{1,2})\.(\d{1,2})\.(\d{4})$`),
+		format: "MDY",
+		parser: parseNumericDate,
+	},
+	// Dotted dates with a two-digit year need two-digit day and month ("31.12.24"),
+	// so version numbers such as "1.2.24" are not dates
+	{
+		regex:  regexp.MustCompile(`^(\d{2})\.(\d{2})\.(\d{2})$`),
 		format: "MDY",
 		parser: parseNumericDate,
 	},
@@ -62,17 +108,64 @@ type absolutePattern struct {
 func parseAbsolute(ctx *parserContext) (time.Time, error) {
 	input := strings.TrimSpace(ctx.input)
 
+	// "Monday, December 30, 2024": parse the date and cross-check the weekday
+	if weekday, rest, ok := splitLeadingWeekday(input, ctx.languages); ok {
+		sub := *ctx
+		sub.input = rest
+		if result, err := parseAbsolute(&sub); err == nil {
+			ctx.hasTime = sub.hasTime
+			if result.Weekday() != weekday && ctx.settings.Strict {
+				return time.Time{}, &ErrInvalidDate{
+					Input:    ctx.input,
+					Year:     result.Year(),
+					Month:    int(result.Month()),
+					Day:      result.Day(),
+					Reason:   fmt.Sprintf("weekday %s does not match date (%s)", weekday, result.Weekday()),
+					Fragment: input[:strings.IndexAny(input, ", \t")],
+					Position: len(ctx.input) - len(strings.TrimLeft(ctx.input, " \t\r\n")),
+				}
+			}
+			// Outside strict mode the explicit date wins over the weekday
+			return result, nil
+		}
+	}
+
 	// Try to extract timezone first
 	dateStr, tzInfo, _ := ExtractTimezone(input)
 
+	// Drop ordinal suffixes on day numbers ("March 1st, 2024" -> "March 1, 2024")
+	if !isFeatureDisabled(ctx.settings, "ordinal_words") {
+		dateStr = stripOrdinalSuffixes(dateStr, ctx.languages)
+	}
+	dateStr = stripYearSuffix(dateStr, ctx.languages)
+
 	// Try multi-language month name formats first
-	if result, err := tryParseMultiLangMonthName(ctx, dateStr); err == nil {
+	result, err := tryParseMultiLangMonthName(ctx, dateStr)
+	if err == nil {
 		// Apply timezone if found
 		if tzInfo != nil {
 			result = ApplyTimezone(result, tzInfo)
 		}
 		return result, nil
 	}
+	// A month name matched but the day does not exist in it ("31 de abril")
+	var monthNameErr *ErrInvalidDate
+	if errors.As(err, &monthNameErr) {
+		return time.Time{}, err
+	}
+
+	// "2024年12月31日 15時30分", "12月31日(火)"
+	result, err = tryParseCJKDate(ctx, dateStr)
+	if err == nil {
+		if tzInfo != nil {
+			result = ApplyTimezone(result, tzInfo)
+		}
+		return result, nil
+	}
+	var cjkErr *ErrInvalidDate
+	if errors.As(err, &cjkErr) {
+		return time.Time{}, err
+	}
 
 	// Try each pattern on the date part
 	for _, pattern := range absolutePatterns {
@@ -90,20 +183,85 @@ func parseAbsolute(ctx *parserContext) (time.Time, error) {
 			// (pattern matched but date was invalid)
 			var invalidDateErr *ErrInvalidDate
 			if errors.As(err, &invalidDateErr) {
-				return time.Time{}, err
+				return time.Time{}, locateError(err, ctx.input, dateStr, pattern.regex)
 			}
 			var ambiguousErr *ErrAmbiguousDate
 			if errors.As(err, &ambiguousErr) {
-				return time.Time{}, err
+				return time.Time{}, locateError(err, ctx.input, dateStr, pattern.regex)
 			}
 		}
 	}
 
+	// "2024-12-31 3pm", "2024-12-31 at 10:00", "2024-12-31 10:00 PM"
+	if result, err := tryParseISODateWithTime(ctx, dateStr); err == nil || isSpecificError(err) {
+		if err == nil && tzInfo != nil {
+			result = ApplyTimezone(result, tzInfo)
+		}
+		return result, err
+	}
+
 	return time.Time{}, fmt.Errorf("no absolute date pattern matched")
 }
 
-// parseCJKDate handles Japanese/Chinese date format (YYYY年MM月DD日).
-func parseCJKDate(ctx *parserContext, matches []string) (time.Time, error) {
+// isoDateWithTimePattern matches an ISO 8601 date followed by a time of day
+// written some other way than ISO's "T10:30": after a space or comma,
+// optionally with a connector ("at").
+var isoDateWithTimePattern = regexp.MustCompile(`^(\d{4})-(\d{1,2})-(\d{1,2})(?:\s*,\s*|\s+)(\S.*)$`)
+
+// tryParseISODateWithTime parses an ISO 8601 date followed by a time the
+// time parser understands: "2024-12-31 3pm", "2024-12-31, 10:00",
+// "2024-12-31 at 10:00", "2024-12-31 10:00 PM".
+func tryParseISODateWithTime(ctx *parserContext, input string) (time.Time, error) {
+	matches := isoDateWithTimePattern.FindStringSubmatch(input)
+	if matches == nil {
+		return time.Time{}, fmt.Errorf("no ISO date with time matched")
+	}
+	date, err := parseISO8601(ctx, matches[:4])
+	if err != nil {
+		return time.Time{}, locateError(err, ctx.input, input, isoDateWithTimePattern)
+	}
+
+	rest := matches[4]
+	rest = rest[timeConnectorLength(rest, ctx.languages):]
+
+	// Parse the time against the date
+	settings := *ctx.settings
+	settings.RelativeBase = date
+	sub := *ctx
+	sub.input = rest
+	sub.settings = &settings
+	result, err := tryParseTime(&sub)
+	if err != nil {
+		return time.Time{}, err
+	}
+	ctx.hasTime = true
+	return result, nil
+}
+
+// splitLeadingWeekday splits a leading weekday name ("Monday, ...", "Mon. ...",
+// "lunes ...") from the rest of the input. It reports false when the first word
+// is not a weekday in any of the languages or nothing follows it.
+func splitLeadingWeekday(input string, langs []*translations.Language) (time.Weekday, string, bool) {
+	end := strings.IndexAny(input, ", \t")
+	if end <= 0 {
+		return 0, "", false
+	}
+
+	weekday, ok := translations.ParseWeekday(input[:end], langs...)
+	if !ok {
+		return 0, "", false
+	}
+
+	rest := strings.TrimLeft(input[end:], ", \t")
+	if rest == "" {
+		return 0, "", false
+	}
+	return weekday, rest, true
+}
+
+// parseYMDDate handles date-only year-first formats whose groups are year,
+// month and day: 2024年12月31日, 2024/12/31, 2024.12.31.
+func parseYMDDate(ctx *parserContext, matches []string) (time.Time, error) {
 	year, _ := strconv.Atoi(matches[1])
 	month, _ := strconv.Atoi(matches[2])
 	day, _ := strconv.Atoi(matches[3])
@@ -119,6 +277,92 @@ func parseCJKDate(ctx *parserContext, matches []string) (time.Time, error) {
 	return date, nil
 }
 
+// parseCompactISO handles ISO 8601 dates written without separators. The
+// time part makes "20241231T153045" unmistakable, but a bare "20241231" could
+// be any 8-digit number, so it is read as a date only with
+// Settings.AllowCompactISO and only when it forms a valid date.
+func parseCompactISO(ctx *parserContext, matches []string) (time.Time, error) {
+	if matches[4] == "" {
+		if !ctx.settings.AllowCompactISO {
+			return time.Time{}, fmt.Errorf("compact date %q requires AllowCompactISO", matches[0])
+		}
+		year, _ := strconv.Atoi(matches[1])
+		month, _ := strconv.Atoi(matches[2])
+		day, _ := strconv.Atoi(matches[3])
+		if validateDateComponents(year, month, day) != nil {
+			return time.Time{}, fmt.Errorf("%q is not a compact date", matches[0])
+		}
+	}
+	return parseISO8601(ctx, matches)
+}
+
+// parseRomanMonthDate handles day-month-year dates whose month is a Roman
+// numeral, as in some European legal and historical documents: "15.XII.2024".
+// They are read only with Settings.AllowRomanMonths; a well-formed numeral
+// outside I-XII ("XIII") is an invalid month. A single-letter numeral
+// between plain spaces ("1 V 2024") is too easily ordinary text and is not
+// read as a month.
+func parseRomanMonthDate(ctx *parserContext, matches []string) (time.Time, error) {
+	numeral := matches[3]
+	if !ctx.settings.AllowRomanMonths {
+		return time.Time{}, fmt.Errorf("Roman-numeral month %q requires AllowRomanMonths", numeral)
+	}
+	spaced := strings.TrimSpace(matches[2]) == "" || strings.TrimSpace(matches[4]) == ""
+	if spaced && len(numeral) < 2 {
+		return time.Time{}, fmt.Errorf("single-letter Roman numeral %q needs a '.', '-' or '/' separator", numeral)
+	}
+	month, ok := romanNumeralValue(numeral)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%q is not a Roman numeral", numeral)
+	}
+	day, _ := strconv.Atoi(matches[1])
+	yy, _ := strconv.Atoi(matches[5])
+	year, err := expandYear(ctx, yy)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if err := validateDateComponents(year, month, day); err != nil {
+		return time.Time{}, err
+	}
+
+	loc := ctx.settings.PreferredTimezone
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc), nil
+}
+
+// romanNumeralValue returns the value of a Roman numeral made of I, V and X
+// in either case, such as "XII" or "iv". It reports false for a numeral
+// that is not written in standard form, such as "IIII" or "VX".
+func romanNumeralValue(numeral string) (int, bool) {
+	values := map[byte]int{'I': 1, 'V': 5, 'X': 10}
+	numeral = strings.ToUpper(numeral)
+
+	total := 0
+	for i := 0; i < len(numeral); i++ {
+		value := values[numeral[i]]
+		if i+1 < len(numeral) && value < values[numeral[i+1]] {
+			total -= value
+		} else {
+			total += value
+		}
+	}
+	if total <= 0 || romanNumeral(total) != numeral {
+		return 0, false
+	}
+	return total, true
+}
+
+// romanNumeral writes n (1-39) as a Roman numeral in standard form.
+func romanNumeral(n int) string {
+	var b strings.Builder
+	for ; n >= 10; n -= 10 {
+		b.WriteByte('X')
+	}
+	ones := []string{"", "I", "II", "III", "IV", "V", "VI", "VII", "VIII", "IX"}
+	b.WriteString(ones[n])
+	return b.String()
+}
+
 // parseISO8601 handles ISO 8601 format dates.
 func parseISO8601(ctx *parserContext, matches []string) (time.Time, error) {
 	year, _ := strconv.Atoi(matches[1])
@@ -128,6 +372,7 @@ func parseISO8601(ctx *parserContext, matches []string) (time.Time, error) {
 	hour, minute, second := 0, 0, 0
 	if len(matches) > 4 && matches[4] != "" {
 		hour, _ = strconv.Atoi(matches[4])
+		ctx.hasTime = true
 	}
 	if len(matches) > 5 && matches[5] != "" {
 		minute, _ = strconv.Atoi(matches[5])
@@ -135,6 +380,12 @@ func parseISO8601(ctx *parserContext, matches []string) (time.Time, error) {
 	if len(matches) > 6 && matches[6] != "" {
 		second, _ = strconv.Atoi(matches[6])
 	}
+	second, leap := leapSecond(ctx, second)
+	hour, days := extendedHour(ctx, hour)
+	nanos := 0
+	if len(matches) > 7 && matches[7] != "" {
+		nanos = parseFraction(matches[7])
+	}
 
 	// Validate date and time components
 	if err := validateDateTime(year, month, day, hour, minute, second); err != nil {
@@ -142,7 +393,7 @@ func parseISO8601(ctx *parserContext, matches []string) (time.Time, error) {
 	}
 
 	loc := ctx.settings.PreferredTimezone
-	date := time.Date(year, time.Month(month), day, hour, minute, second, 0, loc)
+	date := time.Date(year, time.Month(month), day+days, hour, minute, second, nanos, loc).Add(leap)
 
 	return date, nil
 }
@@ -150,7 +401,10 @@ func parseISO8601(ctx *parserContext, matches []string) (time.Time, error) {
 // parseISO8601TwoDigitYear handles ISO 8601 format with 2-digit years.
 func parseISO8601TwoDigitYear(ctx *parserContext, matches []string) (time.Time, error) {
 	yy, _ := strconv.Atoi(matches[1])
-	year := parseTwoDigitYear(yy)
+	year, err := expandYear(ctx, yy)
+	if err != nil {
+		return time.Time{}, err
+	}
 	month, _ := strconv.Atoi(matches[2])
 	day, _ := strconv.Atoi(matches[3])
 
@@ -165,6 +419,19 @@ func parseISO8601TwoDigitYear(ctx *parserContext, matches []string) (time.Time,
 	return date, nil
 }
 
+// expandYear returns year as a full year, interpreting a two-digit year with
+// parseTwoDigitYear. It fails for two-digit years when the "two_digit_year"
+// feature is disabled.
+func expandYear(ctx *parserContext, year int) (int, error) {
+	if year >= 100 {
+		return year, nil
+	}
+	if isFeatureDisabled(ctx.settings, "two_digit_year") {
+		return 0, fmt.Errorf("two-digit year %02d is disabled", year)
+	}
+	return parseTwoDigitYear(year), nil
+}
+
 // parseNumericDate handles numeric date formats like 12/31/2024 or 31/12/2024.
 func parseNumericDate(ctx *parserContext, matches []string) (time.Time, error) {
 	num1, _ := strconv.Atoi(matches[1])
@@ -172,8 +439,9 @@ func parseNumericDate(ctx *parserContext, matches []string) (time.Time, error) {
 	year, _ := strconv.Atoi(matches[3])
 
 	// Handle 2-digit years
-	if year < 100 {
-		year = parseTwoDigitYear(year)
+	year, err := expandYear(ctx, year)
+	if err != nil {
+		return time.Time{}, err
 	}
 
 	var month, day int
@@ -243,8 +511,9 @@ func parseMonthName(ctx *parserContext, matches []string) (time.Time, error) {
 	}
 
 	// Handle 2-digit years
-	if year < 100 {
-		year = parseTwoDigitYear(year)
+	year, err := expandYear(ctx, year)
+	if err != nil {
+		return time.Time{}, err
 	}
 
 	month := parseMonthString(monthStr)
@@ -275,7 +544,7 @@ func parseMonthString(month string) int {
 		"jun": 6, "june": 6,
 		"jul": 7, "july": 7,
 		"aug": 8, "august": 8,
-		"sep": 9, "september": 9,
+		"sep": 9, "sept": 9, "september": 9,
 		"oct": 10, "october": 10,
 		"nov": 11, "november": 11,
 		"dec": 12, "december": 12,
@@ -301,7 +570,7 @@ func tryParseMultiLangMonthName(ctx *parserContext, input string) (time.Time, er
 	}{
 		// "31 diciembre 2024", "15 marzo 2024"
 		{
-			regex: regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})\s+(%s)[,\s]+(\d{2,4})$`, monthPattern)),
+			regex: ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})\s+(%s)[,\s]+(\d{2,4})$`, monthPattern)),
 			parse: func(matches []string) (int, time.Month, int, error) {
 				day, _ := strconv.Atoi(matches[1])
 				month := monthNameToNumberWithLangs(matches[2], ctx.languages)
@@ -311,7 +580,7 @@ func tryParseMultiLangMonthName(ctx *parserContext, input string) (time.Time, er
 		},
 		// "15 de marzo de 2024" (Spanish with two "de")
 		{
-			regex: regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})\s+de\s+(%s)\s+de\s+(\d{2,4})$`, monthPattern)),
+			regex: ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})\s+de\s+(%s)\s+de\s+(\d{2,4})$`, monthPattern)),
 			parse: func(matches []string) (int, time.Month, int, error) {
 				day, _ := strconv.Atoi(matches[1])
 				month := monthNameToNumberWithLangs(matches[2], ctx.languages)
@@ -321,7 +590,7 @@ func tryParseMultiLangMonthName(ctx *parserContext, input string) (time.Time, er
 		},
 		// "15 di marzo di 2024" (Italian with two "di")
 		{
-			regex: regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})\s+di\s+(%s)\s+di\s+(\d{2,4})$`, monthPattern)),
+			regex: ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})\s+di\s+(%s)\s+di\s+(\d{2,4})$`, monthPattern)),
 			parse: func(matches []string) (int, time.Month, int, error) {
 				day, _ := strconv.Atoi(matches[1])
 				month := monthNameToNumberWithLangs(matches[2], ctx.languages)
@@ -331,7 +600,7 @@ func tryParseMultiLangMonthName(ctx *parserContext, input string) (time.Time, er
 		},
 		// "3 de junio 2024" (Spanish with one "de")
 		{
-			regex: regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})\s+de\s+(%s)\s+(\d{2,4})$`, monthPattern)),
+			regex: ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})\s+de\s+(%s)\s+(\d{2,4})$`, monthPattern)),
 			parse: func(matches []string) (int, time.Month, int, error) {
 				day, _ := strconv.Atoi(matches[1])
 				month := monthNameToNumberWithLangs(matches[2], ctx.languages)
@@ -341,7 +610,7 @@ func tryParseMultiLangMonthName(ctx *parserContext, input string) (time.Time, er
 		},
 		// "3 di giugno 2024" (Italian with one "di")
 		{
-			regex: regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})\s+di\s+(%s)\s+(\d{2,4})$`, monthPattern)),
+			regex: ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})\s+di\s+(%s)\s+(\d{2,4})$`, monthPattern)),
 			parse: func(matches []string) (int, time.Month, int, error) {
 				day, _ := strconv.Atoi(matches[1])
 				month := monthNameToNumberWithLangs(matches[2], ctx.languages)
@@ -351,7 +620,7 @@ func tryParseMultiLangMonthName(ctx *parserContext, input string) (time.Time, er
 		},
 		// "marzo 15 2024", "diciembre 31 2024"
 		{
-			regex: regexp.MustCompile(fmt.Sprintf(`(?i)^(%s)\s+(\d{1,2})[,\s]+(\d{2,4})$`, monthPattern)),
+			regex: ctx.compile(fmt.Sprintf(`(?i)^(%s)\s+(\d{1,2})[,\s]+(\d{2,4})$`, monthPattern)),
 			parse: func(matches []string) (int, time.Month, int, error) {
 				month := monthNameToNumberWithLangs(matches[1], ctx.languages)
 				day, _ := strconv.Atoi(matches[2])
@@ -362,7 +631,7 @@ func tryParseMultiLangMonthName(ctx *parserContext, input string) (time.Time, er
 		// "март 2024", "December 2024" (Month + Year only, defaults to 1st of month)
 		// Note: Requires 4 digits to avoid ambiguity with "June 15" (month + day)
 		{
-			regex: regexp.MustCompile(fmt.Sprintf(`(?i)^(%s)\s+(\d{4})$`, monthPattern)),
+			regex: ctx.compile(fmt.Sprintf(`(?i)^(%s)\s+(\d{4})$`, monthPattern)),
 			parse: func(matches []string) (int, time.Month, int, error) {
 				month := monthNameToNumberWithLangs(matches[1], ctx.languages)
 				year, _ := strconv.Atoi(matches[2])
@@ -382,8 +651,9 @@ func tryParseMultiLangMonthName(ctx *parserContext, input string) (time.Time, er
 		}
 
 		// Handle 2-digit years
-		if year < 100 {
-			year = parseTwoDigitYear(year)
+		year, err = expandYear(ctx, year)
+		if err != nil {
+			continue
 		}
 
 		if month == 0 {
@@ -392,7 +662,7 @@ func tryParseMultiLangMonthName(ctx *parserContext, input string) (time.Time, er
 
 		// Validate date components
 		if err := validateDateComponents(year, int(month), day); err != nil {
-			return time.Time{}, err
+			return time.Time{}, locateError(err, ctx.input, input, pattern.regex)
 		}
 
 		loc := ctx.settings.PreferredTimezone
@@ -412,14 +682,128 @@ func buildMonthPattern(languages []*translations.Language) string {
 		}
 	}
 
-	months := make([]string, 0, len(monthsMap))
-	for month := range monthsMap {
-		months = append(months, month)
-	}
+	return joinAlternatives(monthsMap)
+}
 
-	if len(months) == 0 {
-		return ""
+// japaneseWeekdayKanji maps the one-kanji weekdays written in parentheses
+// after a Japanese date, as in "12月31日(火)".
+var japaneseWeekdayKanji = map[string]time.Weekday{
+	"日": time.Sunday, "月": time.Monday, "火": time.Tuesday, "水": time.Wednesday,
+	"木": time.Thursday, "金": time.Friday, "土": time.Saturday,
+}
+
+// tryParseCJKDate parses the Chinese and Japanese date format with year,
+// month and day markers, "2024年12月31日", or without the year, "12月31日".
+// A weekday ("火曜日", "(火)", "星期二") and a time of day ("15時30分",
+// "下午3点半", "15:30") may follow. It only runs when Chinese or Japanese is
+// among the languages.
+func tryParseCJKDate(ctx *parserContext, input string) (time.Time, error) {
+	for _, lang := range ctx.languages {
+		if lang.Code != "zh" && lang.Code != "ja" {
+			continue
+		}
+
+		weekdays := make([]string, 0, len(lang.Weekdays))
+		for name := range lang.Weekdays {
+			weekdays = append(weekdays, name)
+		}
+		if lang.Code == "ja" {
+			for name := range japaneseWeekdayKanji {
+				weekdays = append(weekdays, "("+name+")", "（"+name+"）")
+			}
+		}
+		var meridiems, hourMarks, halves []string
+		if terms := lang.TimeTerms; terms != nil {
+			meridiems = append(append(meridiems, terms.AM...), terms.PM...)
+			hourMarks = terms.OClock
+			halves = terms.Half
+		}
+
+		pattern := `^(?:(\d{4})\s*年\s*)?(\d{1,2})\s*月\s*(\d{1,2})\s*[日号]`
+		pattern += `(?:\s*[(（]?(` + termAlternation(weekdays) + `)[)）]?)?`
+		pattern += `(?:\s*(` + termAlternation(meridiems) + `)?\s*(\d{1,2})\s*(?:` + termAlternation(hourMarks) + `|:)` +
+			`(?:\s*(\d{1,2})\s*分?|(` + termAlternation(halves) + `))?(?:\s*:(\d{1,2})|\s*(\d{1,2})\s*秒)?)?$`
+		re := ctx.compile(pattern)
+		matches := re.FindStringSubmatch(input)
+		if matches == nil {
+			continue
+		}
+
+		month, _ := strconv.Atoi(matches[2])
+		day, _ := strconv.Atoi(matches[3])
+		year := 0
+		if matches[1] != "" {
+			year, _ = strconv.Atoi(matches[1])
+		} else {
+			year = yearForMonthDay(ctx, time.Month(month), day)
+		}
+		if err := validateDateComponents(year, month, day); err != nil {
+			return time.Time{}, locateError(err, ctx.input, input, re)
+		}
+
+		hour, minute, second := 0, 0, 0
+		var leap time.Duration
+		if matches[6] != "" {
+			hour, _ = strconv.Atoi(matches[6])
+			minute, _ = strconv.Atoi(matches[7])
+			if matches[8] != "" {
+				minute = 30
+			}
+			if matches[9] != "" {
+				second, _ = strconv.Atoi(matches[9])
+			} else if matches[10] != "" {
+				second, _ = strconv.Atoi(matches[10])
+			}
+			second, leap = leapSecond(ctx, second)
+			if meridiem := matches[5]; meridiem != "" {
+				switch {
+				case translations.MatchesRelativeTerm(meridiem, lang.TimeTerms.PM) && hour < 12:
+					hour += 12
+				case translations.MatchesRelativeTerm(meridiem, lang.TimeTerms.AM) && hour == 12:
+					hour = 0
+				}
+			}
+			if err := validateTime(hour, minute, second); err != nil {
+				return time.Time{}, locateError(err, ctx.input, input, re)
+			}
+			ctx.hasTime = true
+		}
+
+		result := time.Date(year, time.Month(month), day, hour, minute, second, 0, ctx.settings.PreferredTimezone).Add(leap)
+		weekday, ok := translations.ParseWeekday(matches[4], lang)
+		if !ok {
+			weekday, ok = japaneseWeekdayKanji[strings.Trim(matches[4], "()（）")]
+		}
+		if ok && weekday != result.Weekday() && ctx.settings.Strict {
+			loc := re.FindStringSubmatchIndex(input)
+			return time.Time{}, &ErrInvalidDate{
+				Input:    ctx.input,
+				Year:     year,
+				Month:    month,
+				Day:      day,
+				Reason:   fmt.Sprintf("weekday %s does not match date (%s)", weekday, result.Weekday()),
+				Fragment: matches[4],
+				Position: fragmentPosition(ctx.input, input, loc[8], matches[4]),
+			}
+		}
+		return result, nil
 	}
 
-	return strings.Join(months, "|")
+	return time.Time{}, fmt.Errorf("no CJK date pattern matched")
+}
+
+// yearForMonthDay picks the year of a date written without one: the current
+// year of RelativeBase, or the next (or, with PreferDatesFrom "past", the
+// previous) year when the date has already passed (or is yet to come).
+func yearForMonthDay(ctx *parserContext, month time.Month, day int) int {
+	base := ctx.settings.RelativeBase
+	year := base.Year()
+	if ctx.settings.PreferDatesFrom == "past" {
+		if month > base.Month() || (month == base.Month() && day > base.Day()) {
+			year--
+		}
+	} else if month < base.Month() || (month == base.Month() && day < base.Day()) {
+		year++
+	}
+	return year
 }
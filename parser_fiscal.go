@@ -0,0 +1,175 @@
+package godateparser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fiscal calendar patterns, resolved with Settings.FiscalCalendar
+// Examples: "FY2024", "Q1 FY2024", "FY2024 H2", "next fiscal year", "fiscal year to date"
+// Calendar periods to date ("YTD", "QTD", "MTD") are resolved here too
+
+var (
+	// "FY2024", "FY 2024", "fiscal year 2024"
+	fiscalYearPattern = regexp.MustCompile(`(?i)^(?:FY\s?|fiscal\s+year\s+)(\d{4})$`)
+
+	// "Q1 FY2024", "H2 fiscal year 2024", "FY2024 Q3", "FY2024-Q3"
+	fiscalPartPattern = regexp.MustCompile(`(?i)^(?:([QH])([1-4])\s+(?:FY\s?|fiscal\s+year\s+)(\d{4})|FY\s?(\d{4})[\s-]([QH])([1-4]))$`)
+
+	// "this fiscal year", "next fiscal quarter", "last fiscal year"
+	fiscalRelativePattern = regexp.MustCompile(`(?i)^(this|next|last)\s+fiscal\s+(year|quarter)$`)
+
+	// "fiscal year to date", "fiscal quarter to date", "FYTD"
+	fiscalToDatePattern = regexp.MustCompile(`(?i)^(?:fiscal\s+(year|quarter)\s+to\s+date|FYTD)$`)
+
+	// "YTD", "QTD", "MTD", "year to date", "quarter-to-date"
+	calendarToDatePattern = regexp.MustCompile(`(?i)^(?:([YQM])TD|(year|quarter|month)[\s-]+to[\s-]+date)$`)
+)
+
+// fiscalPeriod is a span of the fiscal calendar: a number of months from
+// start, or start through RelativeBase for "to date" expressions.
+type fiscalPeriod struct {
+	start  time.Time
+	months int
+	toDate bool
+}
+
+// end returns the last instant of the period, or base for a "to date" period.
+func (p fiscalPeriod) end(base time.Time) time.Time {
+	if p.toDate {
+		return base
+	}
+	return p.start.AddDate(0, p.months, 0).Add(-time.Nanosecond)
+}
+
+// tryParseFiscal parses a fiscal expression and returns the start of the
+// period it names.
+func tryParseFiscal(ctx *parserContext) (time.Time, error) {
+	if period, ok := parseFiscal(ctx); ok {
+		return period.start, nil
+	}
+	return time.Time{}, fmt.Errorf("no fiscal pattern matched")
+}
+
+// parseFiscal resolves a fiscal year, quarter or half, a fiscal period
+// relative to RelativeBase, a fiscal period to date or a calendar period
+// to date.
+func parseFiscal(ctx *parserContext) (fiscalPeriod, bool) {
+	input := strings.TrimSpace(ctx.input)
+	settings := ctx.settings
+
+	if matches := fiscalYearPattern.FindStringSubmatch(input); matches != nil {
+		year, _ := strconv.Atoi(matches[1])
+		return fiscalPeriod{start: fiscalYearStart(settings, year), months: 12}, true
+	}
+
+	if matches := fiscalPartPattern.FindStringSubmatch(input); matches != nil {
+		letter, number, yearText := matches[1], matches[2], matches[3]
+		if letter == "" {
+			yearText, letter, number = matches[4], matches[5], matches[6]
+		}
+		part, _ := strconv.Atoi(number)
+		year, _ := strconv.Atoi(yearText)
+
+		months := 3
+		if strings.EqualFold(letter, "H") {
+			if part > 2 {
+				return fiscalPeriod{}, false
+			}
+			months = 6
+		}
+		start := fiscalYearStart(settings, year).AddDate(0, (part-1)*months, 0)
+		return fiscalPeriod{start: start, months: months}, true
+	}
+
+	if matches := fiscalRelativePattern.FindStringSubmatch(input); matches != nil {
+		months := fiscalUnitMonths(matches[2])
+		start := currentFiscalPeriodStart(settings, months)
+		switch strings.ToLower(matches[1]) {
+		case "next":
+			start = start.AddDate(0, months, 0)
+		case "last":
+			start = start.AddDate(0, -months, 0)
+		}
+		return fiscalPeriod{start: start, months: months}, true
+	}
+
+	if matches := fiscalToDatePattern.FindStringSubmatch(input); matches != nil {
+		months := fiscalUnitMonths(matches[1])
+		return fiscalPeriod{start: currentFiscalPeriodStart(settings, months), months: months, toDate: true}, true
+	}
+
+	if matches := calendarToDatePattern.FindStringSubmatch(input); matches != nil {
+		unit := strings.ToLower(matches[1] + matches[2])
+		return fiscalPeriod{start: currentCalendarPeriodStart(settings, unit[0]), toDate: true}, true
+	}
+
+	return fiscalPeriod{}, false
+}
+
+// currentCalendarPeriodStart returns the first day of the calendar year
+// ('y'), quarter ('q') or month ('m') containing RelativeBase, whatever the
+// fiscal calendar.
+func currentCalendarPeriodStart(settings *Settings, unit byte) time.Time {
+	base := settings.RelativeBase
+	month := base.Month()
+	switch unit {
+	case 'y':
+		month = time.January
+	case 'q':
+		month = (month-1)/3*3 + 1
+	}
+	return time.Date(base.Year(), month, 1, 0, 0, 0, 0, settings.PreferredTimezone)
+}
+
+// fiscalUnitMonths returns the length in months of a fiscal "year" or
+// "quarter"; an empty unit (as in "FYTD") is a year.
+func fiscalUnitMonths(unit string) int {
+	if strings.EqualFold(unit, "quarter") {
+		return 3
+	}
+	return 12
+}
+
+// fiscalStartMonth returns the first month of the fiscal year.
+func fiscalStartMonth(settings *Settings) time.Month {
+	if settings.FiscalCalendar == nil || settings.FiscalCalendar.StartMonth == 0 {
+		return time.January
+	}
+	return settings.FiscalCalendar.StartMonth
+}
+
+// fiscalYearStart returns the first day of the fiscal year named year.
+func fiscalYearStart(settings *Settings, year int) time.Time {
+	month := fiscalStartMonth(settings)
+	if month != time.January && !settings.FiscalCalendar.NamedByStartYear {
+		// Named after the year it ends in, so it starts the year before
+		year--
+	}
+	return time.Date(year, month, 1, 0, 0, 0, 0, settings.PreferredTimezone)
+}
+
+// fiscalYearOf returns the name of the fiscal year containing t.
+func fiscalYearOf(settings *Settings, t time.Time) int {
+	month := fiscalStartMonth(settings)
+	year := t.Year()
+	if t.Month() < month {
+		year--
+	}
+	if month != time.January && !settings.FiscalCalendar.NamedByStartYear {
+		year++
+	}
+	return year
+}
+
+// currentFiscalPeriodStart returns the start of the fiscal year (months =
+// 12) or fiscal quarter (months = 3) containing RelativeBase.
+func currentFiscalPeriodStart(settings *Settings, months int) time.Time {
+	base := settings.RelativeBase
+	start := fiscalYearStart(settings, fiscalYearOf(settings, base))
+	elapsed := (base.Year()-start.Year())*12 + int(base.Month()) - int(start.Month())
+	return start.AddDate(0, elapsed/months*months, 0)
+}
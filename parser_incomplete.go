@@ -73,28 +73,8 @@ var incompleteDatePatterns = []*incompleteDatePattern{
 				return time.Time{}, err
 			}
 
-			base := ctx.settings.RelativeBase
-			currentYear := base.Year()
-			currentMonth := base.Month()
-			currentDay := base.Day()
-
-			// Determine year based on PreferDatesFrom setting
-			year := currentYear
-			if ctx.settings.PreferDatesFrom == "past" {
-				// If month/day is after current, use last year
-				if month > currentMonth || (month == currentMonth && day > currentDay) {
-					year--
-				}
-			} else {
-				// Default "future" behavior
-				// If month/day is before current, use next year
-				if month < currentMonth || (month == currentMonth && day < currentDay) {
-					year++
-				}
-			}
-
 			loc := ctx.settings.PreferredTimezone
-			return time.Date(year, month, day, 0, 0, 0, 0, loc), nil
+			return time.Date(monthDayYear(ctx, month, day), month, day, 0, 0, 0, 0, loc), nil
 		},
 	},
 	// Day and month without year: "15 June", "15 junio"
@@ -110,32 +90,80 @@ var incompleteDatePatterns = []*incompleteDatePattern{
 				return time.Time{}, err
 			}
 
-			base := ctx.settings.RelativeBase
-			currentYear := base.Year()
-			currentMonth := base.Month()
-			currentDay := base.Day()
-
-			// Determine year based on PreferDatesFrom setting
-			year := currentYear
-			if ctx.settings.PreferDatesFrom == "past" {
-				// If month/day is after current, use last year
-				if month > currentMonth || (month == currentMonth && day > currentDay) {
-					year--
-				}
-			} else {
-				// Default "future" behavior
-				// If month/day is before current, use next year
-				if month < currentMonth || (month == currentMonth && day < currentDay) {
-					year++
-				}
-			}
-
 			loc := ctx.settings.PreferredTimezone
-			return time.Date(year, month, day, 0, 0, 0, 0, loc), nil
+			return time.Date(monthDayYear(ctx, month, day), month, day, 0, 0, 0, 0, loc), nil
 		},
 	},
 }
 
+// monthDayYear returns the year of a month and day written without one,
+// following PreferDatesFrom: the next such day on or after RelativeBase by
+// default, the last one on or before it with "past". February 29 moves on
+// to the nearest leap year in that direction.
+func monthDayYear(ctx *parserContext, month time.Month, day int) int {
+	base := ctx.settings.RelativeBase
+	currentMonth, currentDay := base.Month(), base.Day()
+
+	past := ctx.settings.PreferDatesFrom == "past"
+	year := base.Year()
+	if past {
+		// If month/day is after current, use last year
+		if month > currentMonth || (month == currentMonth && day > currentDay) {
+			year--
+		}
+	} else {
+		// Default "future" behavior
+		// If month/day is before current, use next year
+		if month < currentMonth || (month == currentMonth && day < currentDay) {
+			year++
+		}
+	}
+
+	for month == time.February && day == 29 && validateDateComponents(year, 2, 29) != nil {
+		if past {
+			year--
+		} else {
+			year++
+		}
+	}
+	return year
+}
+
+// numericMonthDayPattern matches a month and day written as numbers without
+// a year: "12/31", "31/12", or "31.12." with the closing period of the
+// dotted style, which a decimal number such as "31.12" does not have.
+var numericMonthDayPattern = regexp.MustCompile(`^(\d{1,2})(?:/(\d{1,2})|\.(\d{1,2})\.)$`)
+
+// parseNumericMonthDay parses a month and day matched by
+// numericMonthDayPattern. A value above 12 can only be the day, which
+// settles the order ("31/12" and "12/31" are both December 31); otherwise
+// DateOrder decides, with DMY putting the day first.
+func parseNumericMonthDay(ctx *parserContext, matches []string) (time.Time, error) {
+	first, _ := strconv.Atoi(matches[1])
+	second, _ := strconv.Atoi(matches[2] + matches[3])
+
+	month, day := first, second
+	switch {
+	case first > 12 && second <= 12:
+		month, day = second, first
+	case second > 12:
+		// Month first whatever the order
+	case ctx.settings.DateOrder == "DMY":
+		month, day = second, first
+	}
+
+	if err := validateDateComponents(0, month, day); err != nil {
+		return time.Time{}, err
+	}
+
+	loc := ctx.settings.PreferredTimezone
+	return time.Date(monthDayYear(ctx, time.Month(month), day), time.Month(month), day, 0, 0, 0, 0, loc), nil
+}
+
+// abbreviatedYearPattern matches a two-digit year marked as a year by an
+// apostrophe or a fiscal-year prefix: "'24", "’99", "FY24", "FY 24".
+var abbreviatedYearPattern = regexp.MustCompile(`(?i)^(['’]|FY\s?)(\d{2})$`)
+
 // tryParseIncompleteDate attempts to parse incomplete date patterns
 func tryParseIncompleteDate(ctx *parserContext) (time.Time, error) {
 	input := strings.TrimSpace(ctx.input)
@@ -145,31 +173,54 @@ func tryParseIncompleteDate(ctx *parserContext) (time.Time, error) {
 
 	// Try dynamic month-only pattern
 	if monthPattern != "" {
-		re := regexp.MustCompile(fmt.Sprintf(`(?i)^(%s)$`, monthPattern))
+		re := ctx.compile(fmt.Sprintf(`(?i)^(%s)$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return incompleteDatePatterns[1].parser(ctx, matches)
 		}
 
 		// Try "month day" pattern
-		re = regexp.MustCompile(fmt.Sprintf(`(?i)^(%s)\s+(\d{1,2})$`, monthPattern))
+		re = ctx.compile(fmt.Sprintf(`(?i)^(%s)\s+(\d{1,2})$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return incompleteDatePatterns[2].parser(ctx, matches)
 		}
 
 		// Try "day month" pattern
-		re = regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})\s+(%s)$`, monthPattern))
+		re = ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})\s+(%s)$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return incompleteDatePatterns[3].parser(ctx, matches)
 		}
 
 		// Try "day de month" pattern (Spanish: "3 de junio")
-		re = regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})\s+de\s+(%s)$`, monthPattern))
+		re = ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})\s+de\s+(%s)$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return incompleteDatePatterns[3].parser(ctx, matches)
 		}
 	}
 
+	// Numeric month and day: "12/31", "31/12", "31.12."
+	if matches := numericMonthDayPattern.FindStringSubmatch(input); matches != nil {
+		return parseNumericMonthDay(ctx, matches)
+	}
+
+	// Two-digit years with a marker: "'24", "FY24"
+	if ctx.settings.AbbreviatedYears {
+		if matches := abbreviatedYearPattern.FindStringSubmatch(input); matches != nil {
+			year, _ := strconv.Atoi(matches[2])
+			year, err := expandYear(ctx, year)
+			if err != nil {
+				return time.Time{}, err
+			}
+			if strings.HasPrefix(strings.ToUpper(matches[1]), "FY") {
+				return fiscalYearStart(ctx.settings, year), nil
+			}
+			return time.Date(year, 1, 1, 0, 0, 0, 0, ctx.settings.PreferredTimezone), nil
+		}
+	}
+
 	// Try static patterns (year-only)
+	if isFeatureDisabled(ctx.settings, "bare_year") {
+		return time.Time{}, fmt.Errorf("bare years are disabled")
+	}
 	for _, pattern := range incompleteDatePatterns {
 		if pattern.regex != nil {
 			matches := pattern.regex.FindStringSubmatch(input)
@@ -192,14 +243,5 @@ func buildMonthPatternForIncomplete(languages []*translations.Language) string {
 		}
 	}
 
-	months := make([]string, 0, len(monthsMap))
-	for month := range monthsMap {
-		months = append(months, month)
-	}
-
-	if len(months) == 0 {
-		return ""
-	}
-
-	return strings.Join(months, "|")
+	return joinAlternatives(monthsMap)
 }
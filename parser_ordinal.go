@@ -6,6 +6,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"github.com/coredds/godateparser/translations"
 )
@@ -55,6 +56,17 @@ var ordinalDatePatterns = []*ordinalDatePattern{
 				}
 			}
 
+			// Skip months without the day: on January 31st, "30th" is
+			// March 30, or December 30 looking back
+			step := time.Month(1)
+			if ctx.settings.PreferDatesFrom == "past" {
+				step = -1
+			}
+			for day >= 1 && day <= 31 && day > daysIn(month, year) {
+				first := time.Date(year, month+step, 1, 0, 0, 0, 0, time.UTC)
+				year, month = first.Year(), first.Month()
+			}
+
 			// Validate day for the target month
 			if err := validateDateComponents(year, int(month), day); err != nil {
 				return time.Time{}, err
@@ -184,9 +196,9 @@ var ordinalDatePatterns = []*ordinalDatePattern{
 			day, _ := strconv.Atoi(matches[2])
 			year, _ := strconv.Atoi(matches[4])
 
-			// Handle 2-digit years
-			if year < 100 {
-				year = parseTwoDigitYear(year)
+			year, err := expandYear(ctx, year)
+			if err != nil {
+				return time.Time{}, err
 			}
 
 			// Validate day
@@ -207,9 +219,9 @@ var ordinalDatePatterns = []*ordinalDatePattern{
 			month := monthNameToNumberWithLangs(monthName, ctx.languages)
 			year, _ := strconv.Atoi(matches[4])
 
-			// Handle 2-digit years
-			if year < 100 {
-				year = parseTwoDigitYear(year)
+			year, err := expandYear(ctx, year)
+			if err != nil {
+				return time.Time{}, err
 			}
 
 			// Validate day
@@ -230,9 +242,9 @@ var ordinalDatePatterns = []*ordinalDatePattern{
 			month := monthNameToNumberWithLangs(monthName, ctx.languages)
 			year, _ := strconv.Atoi(matches[4])
 
-			// Handle 2-digit years
-			if year < 100 {
-				year = parseTwoDigitYear(year)
+			year, err := expandYear(ctx, year)
+			if err != nil {
+				return time.Time{}, err
 			}
 
 			// Validate day
@@ -248,6 +260,9 @@ var ordinalDatePatterns = []*ordinalDatePattern{
 
 // tryParseOrdinalDate attempts to parse ordinal date patterns
 func tryParseOrdinalDate(ctx *parserContext) (time.Time, error) {
+	if isFeatureDisabled(ctx.settings, "ordinal_words") {
+		return time.Time{}, fmt.Errorf("ordinal dates are disabled")
+	}
 	input := strings.TrimSpace(ctx.input)
 
 	// Build month pattern from enabled languages
@@ -256,42 +271,47 @@ func tryParseOrdinalDate(ctx *parserContext) (time.Time, error) {
 	// Try dynamic month-ordinal patterns
 	if monthPattern != "" {
 		// "June 3rd" or "junio 3"
-		re := regexp.MustCompile(fmt.Sprintf(`(?i)^(%s)\s+(\d{1,2})(st|nd|rd|th)?$`, monthPattern))
+		re := ctx.compile(fmt.Sprintf(`(?i)^(%s)\s+(\d{1,2})(st|nd|rd|th)?$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return ordinalDatePatterns[1].parser(ctx, matches)
 		}
 
 		// "3rd of June" or "3 de junio"
-		re = regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})(st|nd|rd|th)\s+(?:of|de)\s+(%s)$`, monthPattern))
+		re = ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})(st|nd|rd|th)\s+(?:of|de)\s+(%s)$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return ordinalDatePatterns[2].parser(ctx, matches)
 		}
 
 		// "3rd June" or "3 junio"
-		re = regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})(st|nd|rd|th)?\s+(%s)$`, monthPattern))
+		re = ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})(st|nd|rd|th)?\s+(%s)$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return ordinalDatePatterns[3].parser(ctx, matches)
 		}
 
 		// "June 3rd 2024" or "junio 3 2024"
-		re = regexp.MustCompile(fmt.Sprintf(`(?i)^(%s)\s+(\d{1,2})(st|nd|rd|th)?\s+(\d{2,4})$`, monthPattern))
+		re = ctx.compile(fmt.Sprintf(`(?i)^(%s)\s+(\d{1,2})(st|nd|rd|th)?\s+(\d{2,4})$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return ordinalDatePatterns[4].parser(ctx, matches)
 		}
 
 		// "3rd of June 2024" or "3 de junio 2024"
-		re = regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})(st|nd|rd|th)\s+(?:of|de)\s+(%s)\s+(\d{2,4})$`, monthPattern))
+		re = ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})(st|nd|rd|th)\s+(?:of|de)\s+(%s)\s+(\d{2,4})$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return ordinalDatePatterns[5].parser(ctx, matches)
 		}
 
 		// "3rd June 2024" or "3 junio 2024"
-		re = regexp.MustCompile(fmt.Sprintf(`(?i)^(\d{1,2})(st|nd|rd|th)?\s+(%s)\s+(\d{2,4})$`, monthPattern))
+		re = ctx.compile(fmt.Sprintf(`(?i)^(\d{1,2})(st|nd|rd|th)?\s+(%s)\s+(\d{2,4})$`, monthPattern))
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			return ordinalDatePatterns[6].parser(ctx, matches)
 		}
 	}
 
+	// A day of the month alone: "the 15th", "le 15", "am 15.", "15日"
+	if result, err := tryParseBareDay(ctx, input); err == nil || isSpecificError(err) {
+		return result, err
+	}
+
 	// Try static patterns (ordinal-only)
 	for _, pattern := range ordinalDatePatterns {
 		if pattern.regex != nil {
@@ -315,14 +335,47 @@ func buildMonthPatternForOrdinal(languages []*translations.Language) string {
 		}
 	}
 
-	months := make([]string, 0, len(monthsMap))
-	for month := range monthsMap {
-		months = append(months, month)
-	}
+	return joinAlternatives(monthsMap)
+}
 
-	if len(months) == 0 {
-		return ""
-	}
+// cjkDaySuffixes are written after a day of the month in Chinese and
+// Japanese: "15日", "15号".
+var cjkDaySuffixes = []string{"日", "号", "號"}
+
+// tryParseBareDay parses a day of the month written without a month, such
+// as "the 15th", "el 15", "am 15." or "15日", like "15th": in RelativeBase's
+// month, or the nearest month in the PreferDatesFrom direction once the day
+// has passed or when the month is too short for it. The day needs one of the language's DayArticles or an ordinal
+// suffix with a letter in it, so "15" and "15." alone are not dates.
+func tryParseBareDay(ctx *parserContext, input string) (time.Time, error) {
+	input = strings.ToLower(input)
+	for _, lang := range ctx.languages {
+		suffixes := lang.OrdinalSuffixes
+		if lang.Code == "zh" || lang.Code == "ja" {
+			suffixes = cjkDaySuffixes
+		}
+		if len(suffixes) == 0 && len(lang.DayArticles) == 0 {
+			continue
+		}
 
-	return strings.Join(months, "|")
+		pattern := `^()`
+		if len(lang.DayArticles) > 0 {
+			pattern = `^(?:(` + termAlternation(lang.DayArticles) + `)\s+)?`
+		}
+		pattern += `(\d{1,2})`
+		if len(suffixes) > 0 {
+			pattern += `(` + termAlternation(suffixes) + `)?$`
+		} else {
+			pattern += `()$`
+		}
+		matches := ctx.compile(pattern).FindStringSubmatch(input)
+		if matches == nil {
+			continue
+		}
+		if matches[1] == "" && !strings.ContainsFunc(matches[3], unicode.IsLetter) {
+			continue
+		}
+		return ordinalDatePatterns[0].parser(ctx, []string{matches[0], matches[2]})
+	}
+	return time.Time{}, fmt.Errorf("no day of the month matched")
 }
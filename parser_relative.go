@@ -2,6 +2,7 @@ package godateparser
 
 import (
 	"fmt"
+	"math"
 	"regexp"
 	"sort"
 	"strconv"
@@ -13,28 +14,40 @@ import (
 
 // Relative date patterns
 var relativePatterns = []*relativePattern{
-	// "2 days ago", "3 weeks ago", "a fortnight ago"
+	// "2 days ago", "3 weeks ago", "a fortnight ago", "12.5 hours ago"
 	{
-		regex: regexp.MustCompile(`(?i)^(a|an|\d+)\s+(second|minute|hour|day|week|fortnight|month|quarter|year|decade)s?\s+ago$`),
+		regex: regexp.MustCompile(`(?i)^(a|an|` + amountPattern + `)\s+(second|minute|hour|day|week|fortnight|month|quarter|year|decade)s?\s+ago$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
-			amount := 1
-			if matches[1] != "a" && matches[1] != "an" {
-				amount, _ = strconv.Atoi(matches[1])
+			amount, err := parseRelativeAmount(ctx, matches[1])
+			if err != nil {
+				return time.Time{}, err
 			}
 			unit := strings.ToLower(matches[2])
-			return addDuration(ctx.settings.RelativeBase, -amount, unit), nil
+			return addRelativeAmount(ctx, -amount, unit)
 		},
 	},
-	// "in 2 days", "in 3 weeks", "in a fortnight"
+	// "in 2 days", "in 3 weeks", "in a fortnight", "in 12.5 hours"
 	{
-		regex: regexp.MustCompile(`(?i)^in\s+(a|an|\d+)\s+(second|minute|hour|day|week|fortnight|month|quarter|year|decade)s?$`),
+		regex: regexp.MustCompile(`(?i)^in\s+(a|an|` + amountPattern + `)\s+(second|minute|hour|day|week|fortnight|month|quarter|year|decade)s?$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
-			amount := 1
-			if matches[1] != "a" && matches[1] != "an" {
-				amount, _ = strconv.Atoi(matches[1])
+			amount, err := parseRelativeAmount(ctx, matches[1])
+			if err != nil {
+				return time.Time{}, err
 			}
 			unit := strings.ToLower(matches[2])
-			return addDuration(ctx.settings.RelativeBase, amount, unit), nil
+			return addRelativeOffset(ctx, amount, unit)
+		},
+	},
+	// "2 days from now", "2.5 hours from now"
+	{
+		regex: regexp.MustCompile(`(?i)^(a|an|` + amountPattern + `)\s+(second|minute|hour|day|week|fortnight|month|quarter|year|decade)s?\s+from\s+now$`),
+		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
+			amount, err := parseRelativeAmount(ctx, matches[1])
+			if err != nil {
+				return time.Time{}, err
+			}
+			unit := strings.ToLower(matches[2])
+			return addRelativeAmount(ctx, amount, unit)
 		},
 	},
 	// "yesterday", "today", "tomorrow"
@@ -106,11 +119,42 @@ type relativePattern struct {
 func parseRelative(ctx *parserContext) (time.Time, error) {
 	input := strings.TrimSpace(ctx.input)
 
+	// Fiscal periods follow Settings.FiscalCalendar: "FY2024", "Q1 FY2024"
+	if result, err := tryParseFiscal(ctx); err == nil {
+		return result, nil
+	}
+
+	// Quarters resolve to quarter boundaries, so try them before the generic
+	// next/last unit patterns ("next quarter" is not simply three months ahead)
+	if result, err := tryParseMultiLangQuarter(ctx, strings.ToLower(input)); err == nil {
+		return result, nil
+	}
+
+	// "next March", "mars prochain": the first day of the named month
+	if result, err := tryParseMultiLangNamedMonth(ctx, strings.ToLower(input)); err == nil {
+		return result, nil
+	}
+
+	// A day of a relative month: "next month on the 15th", "the 3rd of last month"
+	if result, err := tryParseRelativeMonthDay(ctx, input); err == nil || isSpecificError(err) {
+		return result, err
+	}
+
+	// Signed offsets: "-3 days", "+2 weeks", "+1 month -2 days"
+	if result, err := tryParseSignedOffset(ctx, input); err == nil {
+		return result, nil
+	}
+
 	// Try multi-language relative patterns first
 	if result, err := tryParseMultiLangRelative(ctx, input); err == nil {
 		return result, nil
 	}
 
+	// Try a relative day with a time of day: "tomorrow at 3pm", "yesterday 15:00"
+	if result, err := tryParseDayWithTime(ctx, input); err == nil {
+		return result, nil
+	}
+
 	// Try extended relative patterns (v1.0 features) - these are more specific
 	// For example, "next quarter" should use quarter-aware logic, not simple +3 months
 	result, err := tryParseExtendedRelative(ctx)
@@ -129,6 +173,93 @@ func parseRelative(ctx *parserContext) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("no relative date pattern matched")
 }
 
+// tryParseDayWithTime parses a day word (yesterday, today, tomorrow, the day
+// after tomorrow, ...) followed by a time of day, optionally joined by a
+// localized connector ("tomorrow at 3pm", "mañana a las 15:00", "明日15時").
+// The day offset is applied first and the time clause is then parsed
+// by the time parser against the shifted date.
+func tryParseDayWithTime(ctx *parserContext, input string) (time.Time, error) {
+	lower := strings.ToLower(input)
+
+	for _, lang := range ctx.languages {
+		if lang.RelativeTerms == nil {
+			continue
+		}
+
+		for _, day := range dayOffsets(lang.RelativeTerms) {
+			term := strings.ToLower(day.term)
+			if term == "" || !strings.HasPrefix(lower, term) {
+				continue
+			}
+
+			rest := strings.TrimSpace(lower[len(term):])
+			if rest == "" {
+				continue
+			}
+			if lang.TimeTerms != nil {
+				rest = trimTimeConnector(rest, lang.TimeTerms.At)
+			}
+
+			settings := *ctx.settings
+			settings.RelativeBase = ctx.settings.RelativeBase.AddDate(0, 0, day.offset)
+			sub := *ctx
+			sub.input = rest
+			sub.settings = &settings
+
+			if result, err := tryParseTime(&sub); err == nil {
+				return result, nil
+			}
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("no day with time pattern matched")
+}
+
+// dayOffset is a day word ("tomorrow") and its offset in days from the base.
+type dayOffset struct {
+	term   string
+	offset int
+}
+
+// dayOffsets lists the day words of terms with their offsets: yesterday,
+// today and tomorrow with their variants, then the words for two days away.
+func dayOffsets(terms *translations.RelativeTerms) []dayOffset {
+	days := []dayOffset{
+		{terms.Yesterday, -1},
+		{terms.Today, 0},
+		{terms.Tomorrow, 1},
+	}
+	for offset, variants := range [][]string{terms.YesterdayVariants, terms.TodayVariants, terms.TomorrowVariants} {
+		for _, term := range variants {
+			days = append(days, dayOffset{term, offset - 1})
+		}
+	}
+	for _, term := range terms.DayAfterTomorrow {
+		days = append(days, dayOffset{term, 2})
+	}
+	for _, term := range terms.DayBeforeYesterday {
+		days = append(days, dayOffset{term, -2})
+	}
+	return days
+}
+
+// trimTimeConnector removes a leading connector word ("at", "a las") from s.
+// Word connectors must be followed by whitespace; symbols such as "@" need not be.
+func trimTimeConnector(s string, connectors []string) string {
+	for _, connector := range connectors {
+		connector = strings.ToLower(connector)
+		if !strings.HasPrefix(s, connector) {
+			continue
+		}
+
+		rest := s[len(connector):]
+		if connector == "@" || strings.HasPrefix(rest, " ") {
+			return strings.TrimSpace(rest)
+		}
+	}
+	return s
+}
+
 // addDuration adds a duration to a base time based on unit and amount.
 func addDuration(base time.Time, amount int, unit string) time.Time {
 	switch unit {
@@ -157,6 +288,400 @@ func addDuration(base time.Time, amount int, unit string) time.Time {
 	}
 }
 
+// parseRelativeAmount parses the amount of a relative expression: "a"/"an"
+// (meaning 1) or a number that may use the active decimal separator.
+func parseRelativeAmount(ctx *parserContext, s string) (float64, error) {
+	switch strings.ToLower(s) {
+	case "a", "an":
+		return 1, nil
+	}
+	return parseDecimal(s, decimalSeparator(ctx))
+}
+
+// replaceVagueAmounts rewrites vague amounts written before a time unit as
+// numbers, so "a couple of days ago" reads as "2 days ago" and "in a few
+// weeks" as "in 3 weeks" (with the default Settings.FewAmount).
+func replaceVagueAmounts(ctx *parserContext, input string) string {
+	few := strconv.Itoa(ctx.settings.FewAmount)
+	for _, lang := range ctx.languages {
+		terms := lang.RelativeTerms
+		if terms == nil || (len(terms.Couple) == 0 && len(terms.Few) == 0) {
+			continue
+		}
+		units := buildTimeUnitPattern(lang)
+		if units == "" {
+			continue
+		}
+		for _, vague := range []struct {
+			words  []string
+			amount string
+		}{{terms.Couple, "2"}, {terms.Few, few}} {
+			if len(vague.words) == 0 {
+				continue
+			}
+			pattern := fmt.Sprintf(`(?i)(^|\s)(?:%s)\s+((?:%s)(?:$|[^\p{L}]))`, termAlternation(vague.words), units)
+			input = ctx.compile(pattern).ReplaceAllString(input, "${1}"+vague.amount+" ${2}")
+		}
+	}
+	return input
+}
+
+// stripDateQualifier removes a leading deadline phrase ("by", "no later
+// than") or approximate-date phrase ("on or about") of any of langs from
+// input, returning the rest and the ParsedDate.Modifier it stands for:
+// "deadline" or "approximate". The longest phrase followed by a space wins.
+func stripDateQualifier(input string, langs []*translations.Language) (string, string) {
+	lower := strings.ToLower(input)
+	length, modifier := 0, ""
+	for _, lang := range langs {
+		if lang.RelativeTerms == nil {
+			continue
+		}
+		if term, ok := longestLeadingTerm(lower, lang.RelativeTerms.Deadline); ok && len(term) > length {
+			length, modifier = len(term), "deadline"
+		}
+		if term, ok := longestLeadingTerm(lower, lang.RelativeTerms.OnOrAbout); ok && len(term) > length {
+			length, modifier = len(term), "approximate"
+		}
+	}
+	if modifier == "" {
+		return input, ""
+	}
+	return strings.TrimSpace(input[length:]), modifier
+}
+
+// longestLeadingTerm returns the longest of terms that lower starts with,
+// followed by a space.
+func longestLeadingTerm(lower string, terms []string) (string, bool) {
+	best := ""
+	for _, term := range terms {
+		term = strings.ToLower(term)
+		if len(term) > len(best) && strings.HasPrefix(lower, term+" ") {
+			best = term
+		}
+	}
+	return best, best != ""
+}
+
+// parseQualifiedDate parses a date led by a deadline or approximate-date
+// phrase, such as "by December 31", "no later than Friday" or "on or about
+// March 3", as the date itself. It reports ok == false if input has no such
+// phrase or the rest cannot be parsed.
+func parseQualifiedDate(input string, opts, settings *Settings, cache *regexCache) (result time.Time, ok bool, err error) {
+	rest, modifier := stripDateQualifier(strings.TrimSpace(input), translations.GlobalRegistry.GetMultiple(settings.Languages))
+	if modifier == "" {
+		return time.Time{}, false, nil
+	}
+
+	result, err = parseDateInZone(rest, opts, cache)
+	if err != nil {
+		if isSpecificError(err) {
+			return time.Time{}, false, err
+		}
+		return time.Time{}, false, nil
+	}
+	return result, true, nil
+}
+
+// cjkAmountPattern matches an amount written with ASCII digits or, for
+// languages that define them, native numerals ("3日前", "三日前").
+func cjkAmountPattern(lang *translations.Language) string {
+	if native := translations.NativeNumeralPattern(lang); native != "" {
+		return `\d+|` + native + `+`
+	}
+	return `\d+`
+}
+
+// parseCJKAmount parses an amount matched by cjkAmountPattern.
+func parseCJKAmount(s string, lang *translations.Language) (int, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, true
+	}
+	return translations.ParseNativeNumber(s, lang)
+}
+
+// parseOffsetFrom resolves "45 minutes after 3pm" or "2 days before
+// December 31": the anchor after the localized After/Before term is parsed
+// by the regular parser chain and the duration is added to or subtracted
+// from it. It reports ok == false if input has no such form or the anchor
+// cannot be parsed; errors such as an invalid anchor date are returned as-is.
+func parseOffsetFrom(input string, opts, settings *Settings, cache *regexCache) (result time.Time, ok bool, err error) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	ctx := &parserContext{settings: settings, cache: cache}
+
+	for _, lang := range translations.GlobalRegistry.GetMultiple(settings.Languages) {
+		terms := lang.RelativeTerms
+		if terms == nil || (len(terms.After) == 0 && len(terms.Before) == 0) {
+			continue
+		}
+		units := buildTimeUnitPattern(lang)
+		if units == "" {
+			continue
+		}
+
+		directions := termAlternation(append(append([]string(nil), terms.After...), terms.Before...))
+		pattern := fmt.Sprintf(`^(%s)\s+(%s)\s+(%s)\s+(.+)$`, amountPattern, units, directions)
+		matches := ctx.compile(pattern).FindStringSubmatch(input)
+		if matches == nil {
+			continue
+		}
+
+		anchor, err := parseDateInZone(matches[4], opts, cache)
+		if err != nil {
+			if isSpecificError(err) {
+				return time.Time{}, false, err
+			}
+			continue
+		}
+
+		amount, err := parseRelativeAmount(ctx, matches[1])
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		if translations.MatchesRelativeTerm(matches[3], terms.Before) {
+			amount = -amount
+		}
+
+		// Offset from the anchor rather than from RelativeBase
+		anchored := *settings
+		anchored.RelativeBase = anchor
+		result, err := addRelativeAmount(&parserContext{settings: &anchored}, amount, normalizeTimeUnit(matches[2], lang))
+		if err != nil {
+			return time.Time{}, false, err
+		}
+		return result, true, nil
+	}
+
+	return time.Time{}, false, nil
+}
+
+// tryParseSignedOffset parses one or more offsets from RelativeBase written
+// with an explicit sign, as in scripts and command lines: "-3 days" is 3 days
+// ago, "+2 weeks" is in 2 weeks and "+1 month -2 days" applies each offset
+// in turn. Units are read in any of the configured languages.
+func tryParseSignedOffset(ctx *parserContext, input string) (time.Time, error) {
+	lower := strings.ToLower(input)
+	if !strings.HasPrefix(lower, "+") && !strings.HasPrefix(lower, "-") {
+		return time.Time{}, fmt.Errorf("no sign")
+	}
+
+	for _, lang := range ctx.languages {
+		units := buildTimeUnitPattern(lang)
+		if units == "" {
+			continue
+		}
+
+		offset := fmt.Sprintf(`([+-])\s*(%s)\s*(%s)`, amountPattern, units)
+		if !ctx.compile(`^` + offset + `(?:\s+` + offset + `)*$`).MatchString(lower) {
+			continue
+		}
+
+		result := ctx.settings.RelativeBase
+		for _, m := range ctx.compile(offset).FindAllStringSubmatch(lower, -1) {
+			amount, err := parseRelativeAmount(ctx, m[2])
+			if err != nil {
+				return time.Time{}, err
+			}
+			if m[1] == "-" {
+				amount = -amount
+			}
+
+			// Each offset applies to the result of the ones before it
+			shifted := *ctx.settings
+			shifted.RelativeBase = result
+			result, err = addRelativeAmount(&parserContext{settings: &shifted}, amount, normalizeTimeUnit(m[3], lang))
+			if err != nil {
+				return time.Time{}, err
+			}
+		}
+		return result, nil
+	}
+
+	return time.Time{}, fmt.Errorf("no signed offset matched")
+}
+
+// addRelativeOffset is addRelativeAmount for the explicit week forms "next
+// week", "last week" and "in 3 weeks": with the week parser enabled, a
+// whole number of weeks lands on the first day of the target week
+// (Settings.WeekStartsOn), as "W+3" does, rather than on the same weekday.
+// Vague amounts ("in a few weeks") and other offsets ("2 weeks ago") stay
+// plain durations.
+func addRelativeOffset(ctx *parserContext, amount float64, unit string) (time.Time, error) {
+	if unit == "week" && amount == math.Trunc(amount) && !ctx.vagueAmount && isParserEnabled(ctx.settings, "week") {
+		return relativeWeekStart(ctx, int(amount)), nil
+	}
+	return addRelativeAmount(ctx, amount, unit)
+}
+
+// addPeriodOffset resolves the bare period forms "next month" and "last
+// month" to the first day of the target month, as "this month" and "next
+// quarter" do, and "next week" and "last week" to the start of the target
+// week (see addRelativeOffset). Other units, such as "next year" or "next
+// fortnight", stay plain durations.
+func addPeriodOffset(ctx *parserContext, amount int, unit string) (time.Time, error) {
+	if unit == "month" {
+		return shiftedPeriodStart(ctx.settings.RelativeBase, unit, amount, ctx.weekStart()), nil
+	}
+	return addRelativeOffset(ctx, float64(amount), unit)
+}
+
+// addRelativeAmount adds amount of unit to the relative base. Business days
+// skip the weekend and holidays configured in the settings.
+func addRelativeAmount(ctx *parserContext, amount float64, unit string) (time.Time, error) {
+	if unit != "businessday" {
+		return addAmount(ctx.settings.RelativeBase, amount, unit, ctx.settings.Strict)
+	}
+	if amount != math.Trunc(amount) {
+		return time.Time{}, fmt.Errorf("fractional amount not supported for business days")
+	}
+	return addBusinessDays(ctx.settings.RelativeBase, int(amount), ctx.settings)
+}
+
+// addBusinessDays moves base by n business days, skipping Settings.Weekend
+// (Saturday and Sunday if empty) and Settings.Holidays. Whole weeks are
+// jumped at once, so only the remainder and the holidays are walked day by
+// day. It returns an error if the weekend covers every day of the week.
+func addBusinessDays(base time.Time, n int, settings *Settings) (time.Time, error) {
+	step := 1
+	if n < 0 {
+		step, n = -1, -n
+	}
+
+	workdays := 0
+	for i := 0; i < 7; i++ {
+		if !isWeekend(base.AddDate(0, 0, i), settings) {
+			workdays++
+		}
+	}
+	if workdays == 0 {
+		return time.Time{}, fmt.Errorf("no business days: every day of the week is a weekend day")
+	}
+
+	// Jump whole weeks, keeping at least one business day to walk so the
+	// result lands on one
+	result := base
+	if weeks := (n - 1) / workdays; weeks > 0 {
+		result = base.AddDate(0, 0, 7*weeks*step)
+		n -= weeks * workdays
+		n += holidaysBetween(base, result, settings)
+	}
+
+	for n > 0 {
+		result = result.AddDate(0, 0, step)
+		if isBusinessDay(result, settings) {
+			n--
+		}
+	}
+	return result, nil
+}
+
+// holidaysBetween counts the distinct Settings.Holidays after from, up to
+// and including to (which may come before from), that fall outside the
+// weekend.
+func holidaysBetween(from, to time.Time, settings *Settings) int {
+	if to.Before(from) {
+		from, to = to.AddDate(0, 0, -1), from.AddDate(0, 0, -1)
+	}
+	fromDate := civilDate(from)
+	toDate := civilDate(to)
+
+	seen := make(map[time.Time]bool)
+	for _, holiday := range settings.Holidays {
+		date := civilDate(holiday)
+		if date.After(fromDate) && !date.After(toDate) && !isWeekend(date, settings) {
+			seen[date] = true
+		}
+	}
+	return len(seen)
+}
+
+// civilDate returns the calendar date of t as midnight UTC, so dates in
+// different locations compare by year, month and day.
+func civilDate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// isBusinessDay reports whether t falls outside the weekend and holidays.
+func isBusinessDay(t time.Time, settings *Settings) bool {
+	if isWeekend(t, settings) {
+		return false
+	}
+
+	year, month, day := t.Date()
+	for _, holiday := range settings.Holidays {
+		if y, m, d := holiday.Date(); y == year && m == month && d == day {
+			return false
+		}
+	}
+	return true
+}
+
+// isWeekend reports whether t falls on one of Settings.Weekend, or on
+// Saturday or Sunday if it is empty.
+func isWeekend(t time.Time, settings *Settings) bool {
+	weekend := settings.Weekend
+	if len(weekend) == 0 {
+		weekend = []time.Weekday{time.Saturday, time.Sunday}
+	}
+	for _, day := range weekend {
+		if t.Weekday() == day {
+			return true
+		}
+	}
+	return false
+}
+
+// addAmount adds a possibly fractional amount of unit to base.
+// Whole amounts use calendar arithmetic via addDuration. Fractional amounts of
+// sub-day units are exact durations, and fractional days, weeks and fortnights
+// are proportional day counts (1.5 weeks = 10.5 days). Months, quarters, years
+// and decades have no fixed length: in strict mode a fractional amount of them
+// is rejected as ambiguous, otherwise the whole months are added on the
+// calendar and the remaining fraction of a month counts as 30 days.
+func addAmount(base time.Time, amount float64, unit string, strict bool) (time.Time, error) {
+	if amount == math.Trunc(amount) {
+		return addDuration(base, int(amount), unit), nil
+	}
+
+	switch unit {
+	case "second":
+		return base.Add(time.Duration(amount * float64(time.Second))), nil
+	case "minute":
+		return base.Add(time.Duration(amount * float64(time.Minute))), nil
+	case "hour":
+		return base.Add(time.Duration(amount * float64(time.Hour))), nil
+	case "day":
+		return addFractionalDays(base, amount), nil
+	case "week":
+		return addFractionalDays(base, amount*7), nil
+	case "fortnight":
+		return addFractionalDays(base, amount*14), nil
+	}
+
+	monthsPerUnit := map[string]float64{"month": 1, "quarter": 3, "year": 12, "decade": 120}
+	perUnit, ok := monthsPerUnit[unit]
+	if !ok {
+		return time.Time{}, fmt.Errorf("fractional amount not supported for unit %q", unit)
+	}
+	if strict {
+		return time.Time{}, &ErrAmbiguousDate{
+			Reason: fmt.Sprintf("a fractional %s has no fixed length", unit),
+		}
+	}
+
+	months := amount * perUnit
+	whole := math.Trunc(months)
+	return addFractionalDays(base.AddDate(0, int(whole), 0), (months-whole)*30), nil
+}
+
+// addFractionalDays adds whole days on the calendar and the remaining fraction
+// of a day as 24-hour time.
+func addFractionalDays(base time.Time, days float64) time.Time {
+	whole := math.Trunc(days)
+	return base.AddDate(0, 0, int(whole)).Add(time.Duration((days - whole) * float64(24*time.Hour)))
+}
+
 // parseWeekday converts weekday name to time.Weekday.
 func parseWeekday(weekday string) time.Weekday {
 	weekday = strings.ToLower(weekday)
@@ -205,15 +730,11 @@ func tryParseMultiLangRelative(ctx *parserContext, input string) (time.Time, err
 			continue
 		}
 
-		// Simple terms: yesterday, today, tomorrow
-		if strings.EqualFold(input, lang.RelativeTerms.Yesterday) {
-			return base.AddDate(0, 0, -1), nil
-		}
-		if strings.EqualFold(input, lang.RelativeTerms.Today) {
-			return base, nil
-		}
-		if strings.EqualFold(input, lang.RelativeTerms.Tomorrow) {
-			return base.AddDate(0, 0, 1), nil
+		// Day words: yesterday, today, tomorrow, the day after tomorrow, ...
+		for _, day := range dayOffsets(lang.RelativeTerms) {
+			if translations.MatchesRelativeTerm(input, []string{day.term}) {
+				return base.AddDate(0, 0, day.offset), nil
+			}
 		}
 
 		// Try "hace X días" (X days ago) pattern - PREFIX
@@ -268,13 +789,16 @@ func tryParseAgoPattern(ctx *parserContext, input string, lang *translations.Lan
 		return time.Time{}, fmt.Errorf("no time units")
 	}
 
-	pattern := fmt.Sprintf(`^%s\s+(\d+)\s+(%s)$`, regexp.QuoteMeta(strings.ToLower(agoTerm)), units)
-	re := regexp.MustCompile(pattern)
+	pattern := fmt.Sprintf(`^%s\s+(%s)\s+(%s)$`, regexp.QuoteMeta(strings.ToLower(agoTerm)), amountPattern, units)
+	re := ctx.compile(pattern)
 
 	if matches := re.FindStringSubmatch(input); matches != nil {
-		amount, _ := strconv.Atoi(matches[1])
+		amount, err := parseRelativeAmount(ctx, matches[1])
+		if err != nil {
+			return time.Time{}, err
+		}
 		unit := normalizeTimeUnit(matches[2], lang)
-		return addDuration(ctx.settings.RelativeBase, -amount, unit), nil
+		return addRelativeAmount(ctx, -amount, unit)
 	}
 
 	return time.Time{}, fmt.Errorf("no match")
@@ -289,24 +813,36 @@ func tryParseAgoSuffixPattern(ctx *parserContext, input string, lang *translatio
 	}
 
 	// Pattern: "2 días atrás" - number FIRST, unit SECOND, ago term LAST (with space)
-	pattern := fmt.Sprintf(`^(\d+)\s+(%s)\s+%s$`, units, regexp.QuoteMeta(strings.ToLower(agoTerm)))
-	re := regexp.MustCompile(pattern)
+	pattern := fmt.Sprintf(`^(%s)\s+(%s)\s+%s$`, amountPattern, units, regexp.QuoteMeta(strings.ToLower(agoTerm)))
+	amountGroup, unitGroup := 1, 2
+	if lang.UnitFirst {
+		// Pattern: "siku 3 zilizopita" - unit FIRST, number SECOND, ago term LAST
+		pattern = fmt.Sprintf(`^(%s)\s+(%s)\s+%s$`, units, amountPattern, regexp.QuoteMeta(strings.ToLower(agoTerm)))
+		amountGroup, unitGroup = 2, 1
+	}
+	re := ctx.compile(pattern)
 
 	if matches := re.FindStringSubmatch(input); matches != nil {
-		amount, _ := strconv.Atoi(matches[1])
-		unit := normalizeTimeUnit(matches[2], lang)
-		return addDuration(ctx.settings.RelativeBase, -amount, unit), nil
+		amount, err := parseRelativeAmount(ctx, matches[amountGroup])
+		if err != nil {
+			return time.Time{}, err
+		}
+		unit := normalizeTimeUnit(matches[unitGroup], lang)
+		return addRelativeAmount(ctx, -amount, unit)
 	}
 
 	// Pattern for CJK languages (Japanese/Chinese): "3日前" - number + unit + marker (no space)
 	// This handles patterns like 3日前, 2週前, 1ヶ月前
-	patternCJK := fmt.Sprintf(`^(\d+)(%s)%s$`, units, regexp.QuoteMeta(strings.ToLower(agoTerm)))
-	reCJK := regexp.MustCompile(patternCJK)
+	patternCJK := fmt.Sprintf(`^(%s)(%s)%s$`, cjkAmountPattern(lang), units, regexp.QuoteMeta(strings.ToLower(agoTerm)))
+	reCJK := ctx.compile(patternCJK)
 
 	if matches := reCJK.FindStringSubmatch(input); matches != nil {
-		amount, _ := strconv.Atoi(matches[1])
+		amount, ok := parseCJKAmount(matches[1], lang)
+		if !ok {
+			return time.Time{}, fmt.Errorf("invalid amount %q", matches[1])
+		}
 		unit := normalizeTimeUnit(matches[2], lang)
-		return addDuration(ctx.settings.RelativeBase, -amount, unit), nil
+		return addRelativeAmount(ctx, float64(-amount), unit)
 	}
 
 	return time.Time{}, fmt.Errorf("no match")
@@ -321,24 +857,49 @@ func tryParseInPattern(ctx *parserContext, input string, lang *translations.Lang
 	}
 
 	// Pattern: "en 3 semanas" - in term FIRST, number SECOND, unit LAST
-	pattern := fmt.Sprintf(`^%s\s+(\d+)\s+(%s)$`, regexp.QuoteMeta(strings.ToLower(inTerm)), units)
-	re := regexp.MustCompile(pattern)
+	pattern := fmt.Sprintf(`^%s\s+(%s)\s+(%s)$`, regexp.QuoteMeta(strings.ToLower(inTerm)), amountPattern, units)
+	amountGroup, unitGroup := 1, 2
+	if lang.UnitFirst {
+		// Pattern: "baada ya siku 3" - in term FIRST, unit SECOND, number LAST
+		pattern = fmt.Sprintf(`^%s\s+(%s)\s+(%s)$`, regexp.QuoteMeta(strings.ToLower(inTerm)), units, amountPattern)
+		amountGroup, unitGroup = 2, 1
+	}
+	re := ctx.compile(pattern)
 
 	if matches := re.FindStringSubmatch(input); matches != nil {
-		amount, _ := strconv.Atoi(matches[1])
+		amount, err := parseRelativeAmount(ctx, matches[amountGroup])
+		if err != nil {
+			return time.Time{}, err
+		}
+		unit := normalizeTimeUnit(matches[unitGroup], lang)
+		return addRelativeOffset(ctx, amount, unit)
+	}
+
+	// Suffix pattern: "৩ দিন পরে" - number FIRST, unit SECOND, in term LAST (with space)
+	patternSuffix := fmt.Sprintf(`^(%s)\s+(%s)\s+%s$`, amountPattern, units, regexp.QuoteMeta(strings.ToLower(inTerm)))
+	reSuffix := ctx.compile(patternSuffix)
+
+	if matches := reSuffix.FindStringSubmatch(input); matches != nil {
+		amount, err := parseRelativeAmount(ctx, matches[1])
+		if err != nil {
+			return time.Time{}, err
+		}
 		unit := normalizeTimeUnit(matches[2], lang)
-		return addDuration(ctx.settings.RelativeBase, amount, unit), nil
+		return addRelativeOffset(ctx, amount, unit)
 	}
 
 	// Pattern for CJK languages (Japanese/Chinese): "3日後" - number + unit + marker (no space)
 	// This handles patterns like 3日後, 2週後, 1ヶ月後
-	patternCJK := fmt.Sprintf(`^(\d+)(%s)%s$`, units, regexp.QuoteMeta(strings.ToLower(inTerm)))
-	reCJK := regexp.MustCompile(patternCJK)
+	patternCJK := fmt.Sprintf(`^(%s)(%s)%s$`, cjkAmountPattern(lang), units, regexp.QuoteMeta(strings.ToLower(inTerm)))
+	reCJK := ctx.compile(patternCJK)
 
 	if matches := reCJK.FindStringSubmatch(input); matches != nil {
-		amount, _ := strconv.Atoi(matches[1])
+		amount, ok := parseCJKAmount(matches[1], lang)
+		if !ok {
+			return time.Time{}, fmt.Errorf("invalid amount %q", matches[1])
+		}
 		unit := normalizeTimeUnit(matches[2], lang)
-		return addDuration(ctx.settings.RelativeBase, amount, unit), nil
+		return addRelativeOffset(ctx, float64(amount), unit)
 	}
 
 	return time.Time{}, fmt.Errorf("no match")
@@ -353,27 +914,31 @@ func tryParseNextPattern(ctx *parserContext, input string, lang *translations.La
 	}
 
 	pattern := fmt.Sprintf(`^%s\s+(%s)$`, regexp.QuoteMeta(strings.ToLower(nextTerm)), units)
-	re := regexp.MustCompile(pattern)
+	if lang.UnitFirst {
+		// Modifier follows the unit: "wiki ijayo"
+		pattern = fmt.Sprintf(`^(%s)\s+%s$`, units, regexp.QuoteMeta(strings.ToLower(nextTerm)))
+	}
+	re := ctx.compile(pattern)
 
 	if matches := re.FindStringSubmatch(input); matches != nil {
 		unit := normalizeTimeUnit(matches[1], lang)
-		return addDuration(ctx.settings.RelativeBase, 1, unit), nil
+		return addPeriodOffset(ctx, 1, unit)
 	}
 
 	// Try CJK pattern "来週" - next term + unit (no space)
 	patternCJK := fmt.Sprintf(`^%s(%s)$`, regexp.QuoteMeta(strings.ToLower(nextTerm)), units)
-	reCJK := regexp.MustCompile(patternCJK)
+	reCJK := ctx.compile(patternCJK)
 
 	if matches := reCJK.FindStringSubmatch(input); matches != nil {
 		unit := normalizeTimeUnit(matches[1], lang)
-		return addDuration(ctx.settings.RelativeBase, 1, unit), nil
+		return addPeriodOffset(ctx, 1, unit)
 	}
 
 	// Try "next [weekday]" patterns (with space)
 	weekdayPattern := buildWeekdayPattern(lang)
 	if weekdayPattern != "" {
 		pattern := fmt.Sprintf(`^%s\s+(%s)$`, regexp.QuoteMeta(strings.ToLower(nextTerm)), weekdayPattern)
-		re := regexp.MustCompile(pattern)
+		re := ctx.compile(pattern)
 
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			if weekday, ok := lang.Weekdays[matches[1]]; ok {
@@ -401,27 +966,31 @@ func tryParseLastPattern(ctx *parserContext, input string, lang *translations.La
 	}
 
 	pattern := fmt.Sprintf(`^%s\s+(%s)$`, regexp.QuoteMeta(strings.ToLower(lastTerm)), units)
-	re := regexp.MustCompile(pattern)
+	if lang.UnitFirst {
+		// Modifier follows the unit: "mwezi uliopita"
+		pattern = fmt.Sprintf(`^(%s)\s+%s$`, units, regexp.QuoteMeta(strings.ToLower(lastTerm)))
+	}
+	re := ctx.compile(pattern)
 
 	if matches := re.FindStringSubmatch(input); matches != nil {
 		unit := normalizeTimeUnit(matches[1], lang)
-		return addDuration(ctx.settings.RelativeBase, -1, unit), nil
+		return addPeriodOffset(ctx, -1, unit)
 	}
 
 	// Try CJK pattern "先週" - last term + unit (no space)
 	patternCJK := fmt.Sprintf(`^%s(%s)$`, regexp.QuoteMeta(strings.ToLower(lastTerm)), units)
-	reCJK := regexp.MustCompile(patternCJK)
+	reCJK := ctx.compile(patternCJK)
 
 	if matches := reCJK.FindStringSubmatch(input); matches != nil {
 		unit := normalizeTimeUnit(matches[1], lang)
-		return addDuration(ctx.settings.RelativeBase, -1, unit), nil
+		return addPeriodOffset(ctx, -1, unit)
 	}
 
 	// Try "last [weekday]" patterns (with space)
 	weekdayPattern := buildWeekdayPattern(lang)
 	if weekdayPattern != "" {
 		pattern := fmt.Sprintf(`^%s\s+(%s)$`, regexp.QuoteMeta(strings.ToLower(lastTerm)), weekdayPattern)
-		re := regexp.MustCompile(pattern)
+		re := ctx.compile(pattern)
 
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			if weekday, ok := lang.Weekdays[matches[1]]; ok {
@@ -455,38 +1024,15 @@ func buildTimeUnitPattern(lang *translations.Language) string {
 	}
 
 	units := make(map[string]bool)
-	addUnits := func(terms []string) {
-		for _, term := range terms {
-			if term != "" {
-				units[regexp.QuoteMeta(strings.ToLower(term))] = true
+	for _, unit := range lang.RelativeTerms.Units() {
+		for _, form := range unit.Forms {
+			if form != "" {
+				units[regexp.QuoteMeta(strings.ToLower(form))] = true
 			}
 		}
 	}
 
-	addUnits(lang.RelativeTerms.Second)
-	addUnits(lang.RelativeTerms.Minute)
-	addUnits(lang.RelativeTerms.Hour)
-	addUnits(lang.RelativeTerms.Day)
-	addUnits(lang.RelativeTerms.Week)
-	addUnits(lang.RelativeTerms.Fortnight)
-	addUnits(lang.RelativeTerms.Month)
-	addUnits(lang.RelativeTerms.Quarter)
-	addUnits(lang.RelativeTerms.Year)
-	addUnits(lang.RelativeTerms.Decade)
-
-	if len(units) == 0 {
-		return ""
-	}
-
-	result := ""
-	for unit := range units {
-		if result != "" {
-			result += "|"
-		}
-		result += unit
-	}
-
-	return result
+	return joinAlternatives(units)
 }
 
 // buildWeekdayPattern creates a regex pattern for weekdays in a language
@@ -496,70 +1042,12 @@ func buildWeekdayPattern(lang *translations.Language) string {
 		weekdays[regexp.QuoteMeta(strings.ToLower(weekday))] = true
 	}
 
-	if len(weekdays) == 0 {
-		return ""
-	}
-
-	result := ""
-	for weekday := range weekdays {
-		if result != "" {
-			result += "|"
-		}
-		result += weekday
-	}
-
-	return result
+	return joinAlternatives(weekdays)
 }
 
 // normalizeTimeUnit normalizes a time unit from any language to English
 func normalizeTimeUnit(unit string, lang *translations.Language) string {
-	unit = strings.ToLower(unit)
-
-	if lang.RelativeTerms == nil {
-		return unit
-	}
-
-	checkUnit := func(terms []string, _ string) bool {
-		for _, term := range terms {
-			if strings.EqualFold(term, unit) {
-				return true
-			}
-		}
-		return false
-	}
-
-	if checkUnit(lang.RelativeTerms.Second, "second") {
-		return "second"
-	}
-	if checkUnit(lang.RelativeTerms.Minute, "minute") {
-		return "minute"
-	}
-	if checkUnit(lang.RelativeTerms.Hour, "hour") {
-		return "hour"
-	}
-	if checkUnit(lang.RelativeTerms.Day, "day") {
-		return "day"
-	}
-	if checkUnit(lang.RelativeTerms.Week, "week") {
-		return "week"
-	}
-	if checkUnit(lang.RelativeTerms.Fortnight, "fortnight") {
-		return "fortnight"
-	}
-	if checkUnit(lang.RelativeTerms.Month, "month") {
-		return "month"
-	}
-	if checkUnit(lang.RelativeTerms.Quarter, "quarter") {
-		return "quarter"
-	}
-	if checkUnit(lang.RelativeTerms.Year, "year") {
-		return "year"
-	}
-	if checkUnit(lang.RelativeTerms.Decade, "decade") {
-		return "decade"
-	}
-
-	return unit
+	return translations.NormalizeTimeUnit(unit, []*translations.Language{lang})
 }
 
 // tryCJKWeekdayModifier handles CJK patterns like "来週月曜" (next week Monday)
@@ -17,56 +17,89 @@ import (
 var periodBoundaryPatterns = []*relativePattern{
 	// Beginning/start of period
 	{
-		regex: regexp.MustCompile(`(?i)^(beginning|start|first day) of (month|year|week)$`),
+		regex: regexp.MustCompile(`(?i)^(beginning|start|first day) of (?:the )?(month|quarter|year|week)$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
 			period := strings.ToLower(matches[2])
-			return getStartOfPeriod(ctx.settings.RelativeBase, period), nil
+			return getStartOfPeriod(ctx.settings.RelativeBase, period, ctx.weekStart()), nil
 		},
 	},
 	// End/last day of period
 	{
-		regex: regexp.MustCompile(`(?i)^(end|last day) of (month|year|week)$`),
+		regex: regexp.MustCompile(`(?i)^(end|last day) of (?:the )?(month|quarter|year|week)$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
 			period := strings.ToLower(matches[2])
-			return getEndOfPeriod(ctx.settings.RelativeBase, period), nil
+			return getEndOfPeriod(ctx.settings.RelativeBase, period, ctx.weekStart()), nil
 		},
 	},
 	// Beginning/start of last/next period
 	{
-		regex: regexp.MustCompile(`(?i)^(beginning|start|first day) of (last|next) (month|year|week)$`),
+		regex: regexp.MustCompile(`(?i)^(beginning|start|first day) of (?:the )?(last|next) (month|quarter|year|week)$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
 			direction := strings.ToLower(matches[2])
 			period := strings.ToLower(matches[3])
-			base := ctx.settings.RelativeBase
-
-			// Move to next/last period first
+			amount := -1
 			if direction == "next" {
-				base = addPeriod(base, period, 1)
-			} else {
-				base = addPeriod(base, period, -1)
+				amount = 1
 			}
 
-			return getStartOfPeriod(base, period), nil
+			start := shiftedPeriodStart(ctx.settings.RelativeBase, period, amount, ctx.weekStart())
+			return getStartOfPeriod(start, period, ctx.weekStart()), nil
 		},
 	},
 	// End of last/next period
 	{
-		regex: regexp.MustCompile(`(?i)^(end|last day) of (last|next) (month|year|week)$`),
+		regex: regexp.MustCompile(`(?i)^(end|last day) of (?:the )?(last|next) (month|quarter|year|week)$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
 			direction := strings.ToLower(matches[2])
 			period := strings.ToLower(matches[3])
-			base := ctx.settings.RelativeBase
-
-			// Move to next/last period first
+			amount := -1
 			if direction == "next" {
-				base = addPeriod(base, period, 1)
-			} else {
-				base = addPeriod(base, period, -1)
+				amount = 1
 			}
 
-			return getEndOfPeriod(base, period), nil
+			start := shiftedPeriodStart(ctx.settings.RelativeBase, period, amount, ctx.weekStart())
+			return getEndOfPeriod(start, period, ctx.weekStart()), nil
+		},
+	},
+}
+
+// Workplace shorthands: "EOD", "COB Friday", "EOM", "EOY"
+var businessShorthandPatterns = []*relativePattern{
+	// End of the (business) day, optionally of a given day: "EOD", "by COB tomorrow"
+	{
+		regex: regexp.MustCompile(`(?i)^(?:by\s+)?(?:eod|cob|close of business|end of (?:the )?(?:business )?day)(?:\s+(today|tomorrow|monday|tuesday|wednesday|thursday|friday|saturday|sunday))?$`),
+		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
+			day := ctx.settings.RelativeBase
+			switch when := strings.ToLower(matches[1]); when {
+			case "", "today":
+			case "tomorrow":
+				day = day.AddDate(0, 0, 1)
+			default:
+				// The coming occurrence, today included ("COB Friday" on a Friday)
+				day = day.AddDate(0, 0, (int(parseWeekday(when))-int(day.Weekday())+7)%7)
+			}
+			return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location()).Add(ctx.endOfDay()), nil
 		},
 	},
+	// End of week/month/quarter/year: "EOW", "EOM", "EOQ", "EOY"
+	{
+		regex: regexp.MustCompile(`(?i)^(?:by\s+)?eo(w|m|q|y)$`),
+		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
+			period := map[string]string{"w": "week", "m": "month", "q": "quarter", "y": "year"}[strings.ToLower(matches[1])]
+			return getEndOfPeriod(ctx.settings.RelativeBase, period, ctx.weekStart()), nil
+		},
+	},
+}
+
+// defaultEndOfDay is the time "EOD" and "COB" resolve to when Settings.EndOfDay is zero.
+const defaultEndOfDay = 17 * time.Hour
+
+// endOfDay returns Settings.EndOfDay, or defaultEndOfDay if it is unset or out of range.
+func (ctx *parserContext) endOfDay() time.Duration {
+	if eod := ctx.settings.EndOfDay; eod > 0 && eod < 24*time.Hour {
+		return eod
+	}
+	return defaultEndOfDay
 }
 
 // This/next/last disambiguation patterns
@@ -91,17 +124,84 @@ var thisNextPatterns = []*relativePattern{
 			return base.AddDate(0, 0, daysAhead), nil
 		},
 	},
+	// "this weekend", "next weekend", "the weekend": the first day of the weekend
+	{
+		regex: weekendPattern,
+		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
+			start, _, ok := weekendSpan(ctx, matches)
+			if !ok {
+				return time.Time{}, fmt.Errorf("no weekend configured")
+			}
+			return start, nil
+		},
+	},
+	// "next weekday", "last weekday": the nearest day outside the weekend
+	{
+		regex: regexp.MustCompile(`(?i)^(next|last|previous) weekday$`),
+		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
+			step := 1
+			if strings.ToLower(matches[1]) != "next" {
+				step = -1
+			}
+			day := ctx.settings.RelativeBase.AddDate(0, 0, step)
+			for i := 0; i < 7 && isWeekend(day, ctx.settings); i++ {
+				day = day.AddDate(0, 0, step)
+			}
+			if isWeekend(day, ctx.settings) {
+				return time.Time{}, fmt.Errorf("every day is a weekend day")
+			}
+			return day, nil
+		},
+	},
 	// "this month", "this year", "this week"
 	{
 		regex: regexp.MustCompile(`(?i)^this (month|year|week)$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
 			// "this month/year/week" returns the start of current period
 			period := strings.ToLower(matches[1])
-			return getStartOfPeriod(ctx.settings.RelativeBase, period), nil
+			return getStartOfPeriod(ctx.settings.RelativeBase, period, ctx.weekStart()), nil
 		},
 	},
 }
 
+// weekendPattern matches "this weekend", "next weekend", "last weekend",
+// "the weekend" and "weekend"
+var weekendPattern = regexp.MustCompile(`(?i)^(?:(this|next|last)\s+|the\s+)?weekend$`)
+
+// weekendSpan returns the first and last instant of the weekend named by a
+// weekendPattern match. The weekend is the run of consecutive
+// Settings.Weekend days (Saturday and Sunday if empty) that RelativeBase
+// falls in, or else the next one; "next" and "last" move it a week. It
+// reports ok == false when every day of the week is a weekend day.
+func weekendSpan(ctx *parserContext, matches []string) (start, end time.Time, ok bool) {
+	base := ctx.settings.RelativeBase
+	day := time.Date(base.Year(), base.Month(), base.Day(), 0, 0, 0, 0, base.Location())
+
+	for i := 0; i < 7 && !isWeekend(day, ctx.settings); i++ {
+		day = day.AddDate(0, 0, 1)
+	}
+	// Back to the first day of the run
+	for i := 0; i < 7 && isWeekend(day.AddDate(0, 0, -1), ctx.settings); i++ {
+		day = day.AddDate(0, 0, -1)
+	}
+	if isWeekend(day.AddDate(0, 0, -1), ctx.settings) || !isWeekend(day, ctx.settings) {
+		return time.Time{}, time.Time{}, false
+	}
+
+	switch strings.ToLower(matches[1]) {
+	case "next":
+		day = day.AddDate(0, 0, 7)
+	case "last":
+		day = day.AddDate(0, 0, -7)
+	}
+
+	last := day
+	for isWeekend(last.AddDate(0, 0, 1), ctx.settings) {
+		last = last.AddDate(0, 0, 1)
+	}
+	return day, time.Date(last.Year(), last.Month(), last.Day(), 23, 59, 59, 999999999, last.Location()), true
+}
+
 // Complex relative expression patterns
 var complexRelativePatterns = []*relativePattern{
 	// "a week from Tuesday", "2 days from Monday"
@@ -175,12 +275,25 @@ var complexRelativePatterns = []*relativePattern{
 
 // Quarter patterns
 var quarterPatterns = []*relativePattern{
-	// "Q1", "Q2", "Q3", "Q4"
+	// "Q1", "Q2", "Q3", "Q4": the year follows PreferDatesFrom
 	{
 		regex: regexp.MustCompile(`(?i)^Q([1-4])$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
 			quarter, _ := strconv.Atoi(matches[1])
-			year := ctx.settings.RelativeBase.Year()
+			base := ctx.settings.RelativeBase
+			current := getQuarter(base)
+
+			year := base.Year()
+			if ctx.settings.PreferDatesFrom == "past" {
+				// A quarter still ahead this year refers to last year
+				if quarter > current {
+					year--
+				}
+			} else if quarter < current {
+				// Default "future": a quarter already over refers to next year
+				year++
+			}
+
 			return getQuarterStart(year, quarter), nil
 		},
 	},
@@ -193,6 +306,35 @@ var quarterPatterns = []*relativePattern{
 			return getQuarterStart(year, quarter), nil
 		},
 	},
+	// "H1", "H2": the year follows PreferDatesFrom
+	{
+		regex: regexp.MustCompile(`(?i)^H([12])$`),
+		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
+			half, _ := strconv.Atoi(matches[1])
+			base := ctx.settings.RelativeBase
+			current := getHalf(base)
+
+			year := base.Year()
+			if ctx.settings.PreferDatesFrom == "past" {
+				if half > current {
+					year--
+				}
+			} else if half < current {
+				year++
+			}
+
+			return getHalfStart(year, half), nil
+		},
+	},
+	// "H1 2024", "H2 2025"
+	{
+		regex: regexp.MustCompile(`(?i)^H([12])\s+(\d{4})$`),
+		parser: func(_ *parserContext, matches []string) (time.Time, error) {
+			half, _ := strconv.Atoi(matches[1])
+			year, _ := strconv.Atoi(matches[2])
+			return getHalfStart(year, half), nil
+		},
+	},
 	// "last quarter", "next quarter"
 	{
 		regex: regexp.MustCompile(`(?i)^(last|next|this) quarter$`),
@@ -225,45 +367,58 @@ var quarterPatterns = []*relativePattern{
 
 // Helper functions
 
-// getStartOfPeriod returns the start of the given period
-func getStartOfPeriod(t time.Time, period string) time.Time {
+// getStartOfPeriod returns the start of the given period.
+// Weeks begin on weekStart.
+func getStartOfPeriod(t time.Time, period string, weekStart time.Weekday) time.Time {
 	switch period {
 	case "week":
-		// Monday of current week
-		days := int(t.Weekday())
-		if days == 0 { // Sunday
-			days = 7
-		}
-		return time.Date(t.Year(), t.Month(), t.Day()-(days-1), 0, 0, 0, 0, t.Location())
+		days := (int(t.Weekday()) - int(weekStart) + 7) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-days, 0, 0, 0, 0, t.Location())
 	case "month":
 		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	case "quarter":
+		month := time.Month((getQuarter(t)-1)*3 + 1)
+		return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
+	case "half":
+		month := time.Month((getHalf(t)-1)*6 + 1)
+		return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
 	case "year":
 		return time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
 	}
 	return t
 }
 
-// getEndOfPeriod returns the end of the given period
-func getEndOfPeriod(t time.Time, period string) time.Time {
+// getEndOfPeriod returns the last instant of the given period.
+// Weeks begin on weekStart, so they end the day before it.
+func getEndOfPeriod(t time.Time, period string, weekStart time.Weekday) time.Time {
 	switch period {
 	case "week":
-		// Sunday of current week
-		days := int(t.Weekday())
-		if days == 0 { // Sunday
-			return time.Date(t.Year(), t.Month(), t.Day(), 23, 59, 59, 999999999, t.Location())
-		}
-		return time.Date(t.Year(), t.Month(), t.Day()+(7-days), 23, 59, 59, 999999999, t.Location())
+		start := getStartOfPeriod(t, period, weekStart)
+		return time.Date(start.Year(), start.Month(), start.Day()+6, 23, 59, 59, 999999999, t.Location())
 	case "month":
 		// Last day of month
 		firstOfNextMonth := time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, t.Location())
 		lastOfMonth := firstOfNextMonth.AddDate(0, 0, -1)
 		return time.Date(lastOfMonth.Year(), lastOfMonth.Month(), lastOfMonth.Day(), 23, 59, 59, 999999999, t.Location())
+	case "quarter":
+		start := getStartOfPeriod(t, period, weekStart)
+		return time.Date(start.Year(), start.Month()+3, 0, 23, 59, 59, 999999999, t.Location())
+	case "half":
+		start := getStartOfPeriod(t, period, weekStart)
+		return time.Date(start.Year(), start.Month()+6, 0, 23, 59, 59, 999999999, t.Location())
 	case "year":
 		return time.Date(t.Year(), 12, 31, 23, 59, 59, 999999999, t.Location())
 	}
 	return t
 }
 
+// shiftedPeriodStart returns the start of the period amount periods after
+// the one containing t. It snaps to the period start before moving, so a
+// month-end date such as January 31 is not carried past the next month.
+func shiftedPeriodStart(t time.Time, period string, amount int, weekStart time.Weekday) time.Time {
+	return addPeriod(getStartOfPeriod(t, period, weekStart), period, amount)
+}
+
 // addPeriod adds/subtracts a period from a date
 func addPeriod(t time.Time, period string, amount int) time.Time {
 	switch period {
@@ -271,12 +426,35 @@ func addPeriod(t time.Time, period string, amount int) time.Time {
 		return t.AddDate(0, 0, amount*7)
 	case "month":
 		return t.AddDate(0, amount, 0)
+	case "quarter":
+		return t.AddDate(0, amount*3, 0)
+	case "half":
+		return t.AddDate(0, amount*6, 0)
 	case "year":
 		return t.AddDate(amount, 0, 0)
 	}
 	return t
 }
 
+// weekdayByName maps English weekday names to time.Weekday for Settings.WeekStartsOn.
+func weekdayByName(name string) (time.Weekday, bool) {
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		if strings.EqualFold(name, d.String()) {
+			return d, true
+		}
+	}
+	return 0, false
+}
+
+// weekStart returns the first day of the week configured by Settings.WeekStartsOn,
+// defaulting to Monday.
+func (ctx *parserContext) weekStart() time.Weekday {
+	if d, ok := weekdayByName(ctx.settings.WeekStartsOn); ok {
+		return d
+	}
+	return time.Monday
+}
+
 // getQuarter returns the quarter (1-4) for a given date
 func getQuarter(t time.Time) int {
 	month := int(t.Month())
@@ -289,6 +467,54 @@ func getQuarterStart(year, quarter int) time.Time {
 	return time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
 }
 
+// getHalf returns the half of the year (1 or 2) for a given date
+func getHalf(t time.Time) int {
+	if t.Month() <= time.June {
+		return 1
+	}
+	return 2
+}
+
+// getHalfStart returns the start of a half year
+func getHalfStart(year, half int) time.Time {
+	return time.Date(year, time.Month((half-1)*6+1), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Day of a relative month: "next month on the 15th", "last month the 3rd",
+// "the 31st of next month"
+var (
+	monthThenDayPattern = regexp.MustCompile(`(?i)^(this|next|last)\s+month,?\s+(?:on\s+)?(?:the\s+)?(\d{1,2})(?:st|nd|rd|th)?$`)
+	dayThenMonthPattern = regexp.MustCompile(`(?i)^(?:on\s+)?(?:the\s+)?(\d{1,2})(?:st|nd|rd|th)?\s+(?:of\s+)?(this|next|last)\s+month$`)
+)
+
+// tryParseRelativeMonthDay resolves a day of this, next or last month at
+// midnight. A day the month does not have is an ErrInvalidDate, or the
+// month's last day with Settings.ClampDayOfMonth.
+func tryParseRelativeMonthDay(ctx *parserContext, input string) (time.Time, error) {
+	var direction, dayText string
+	if matches := monthThenDayPattern.FindStringSubmatch(input); matches != nil {
+		direction, dayText = matches[1], matches[2]
+	} else if matches := dayThenMonthPattern.FindStringSubmatch(input); matches != nil {
+		dayText, direction = matches[1], matches[2]
+	} else {
+		return time.Time{}, fmt.Errorf("no relative month and day matched")
+	}
+
+	offset := map[string]int{"this": 0, "next": 1, "last": -1}[strings.ToLower(direction)]
+	base := ctx.settings.RelativeBase
+	// From the first of the month, so January 31 plus a month is February
+	month := time.Date(base.Year(), base.Month()+time.Month(offset), 1, 0, 0, 0, 0, base.Location())
+
+	day, _ := strconv.Atoi(dayText)
+	if last := daysIn(month.Month(), month.Year()); day > last && ctx.settings.ClampDayOfMonth {
+		day = last
+	}
+	if err := validateDateComponents(month.Year(), int(month.Month()), day); err != nil {
+		return time.Time{}, err
+	}
+	return month.AddDate(0, 0, day-1), nil
+}
+
 // tryParseExtendedRelative attempts to parse extended relative patterns
 func tryParseExtendedRelative(ctx *parserContext) (time.Time, error) {
 	input := strings.ToLower(strings.TrimSpace(ctx.input))
@@ -299,6 +525,14 @@ func tryParseExtendedRelative(ctx *parserContext) (time.Time, error) {
 	}
 
 	// Try English-only patterns as fallback
+	// Try workplace shorthands
+	for _, pattern := range businessShorthandPatterns {
+		matches := pattern.regex.FindStringSubmatch(input)
+		if matches != nil {
+			return pattern.parser(ctx, matches)
+		}
+	}
+
 	// Try period boundaries
 	for _, pattern := range periodBoundaryPatterns {
 		matches := pattern.regex.FindStringSubmatch(input)
@@ -334,6 +568,125 @@ func tryParseExtendedRelative(ctx *parserContext) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("no extended relative pattern matched")
 }
 
+// relativeModifiers maps a language's this/next/last words to a period offset
+// (0, 1, -1) and returns a regex alternation matching any of them.
+func relativeModifiers(terms *translations.RelativeTerms) (map[string]int, string) {
+	directions := make(map[string]int)
+	for offset, words := range map[int][]string{0: terms.This, 1: terms.Next, -1: terms.Last} {
+		for _, word := range words {
+			directions[strings.ToLower(word)] = offset
+		}
+	}
+
+	words := make([]string, 0, len(directions))
+	for word := range directions {
+		words = append(words, word)
+	}
+	return directions, termAlternation(words)
+}
+
+// termAlternation returns a regex alternation matching any of the given words.
+func termAlternation(words []string) string {
+	set := make(map[string]bool)
+	for _, word := range words {
+		if word != "" {
+			set[regexp.QuoteMeta(strings.ToLower(word))] = true
+		}
+	}
+	return joinAlternatives(set)
+}
+
+// tryParseMultiLangQuarter parses "this/next/last quarter" in any of the
+// configured languages, with the modifier before ("próximo trimestre", "下季度")
+// or after ("trimestre prochain") the quarter word, and returns the start of that quarter.
+func tryParseMultiLangQuarter(ctx *parserContext, input string) (time.Time, error) {
+	for _, lang := range ctx.languages {
+		terms := lang.RelativeTerms
+		if terms == nil || len(terms.Quarter) == 0 {
+			continue
+		}
+
+		directions, modifierPattern := relativeModifiers(terms)
+		quarterPattern := termAlternation(terms.Quarter)
+		pattern := fmt.Sprintf(`^(?:(%s)\s*(?:%s)|(?:%s)\s+(%s))$`, modifierPattern, quarterPattern, quarterPattern, modifierPattern)
+
+		matches := ctx.compile(pattern).FindStringSubmatch(input)
+		if matches == nil {
+			continue
+		}
+
+		modifier := matches[1]
+		if modifier == "" {
+			modifier = matches[2]
+		}
+
+		base := ctx.settings.RelativeBase
+		quarter := getQuarter(base) + directions[modifier]
+		year := base.Year()
+		switch {
+		case quarter > 4:
+			quarter, year = 1, year+1
+		case quarter < 1:
+			quarter, year = 4, year-1
+		}
+		return getQuarterStart(year, quarter), nil
+	}
+
+	return time.Time{}, fmt.Errorf("no quarter pattern matched")
+}
+
+// tryParseMultiLangNamedMonth parses "this/next/last" with a month name in any
+// of the configured languages, with the modifier before ("next March",
+// "próximo marzo") or after ("mars prochain") the month, and returns the first
+// day of that month. "Next" is the nearest such month after the current one
+// and "last" the nearest before it; "this" is the month in the current year.
+func tryParseMultiLangNamedMonth(ctx *parserContext, input string) (time.Time, error) {
+	for _, lang := range ctx.languages {
+		terms := lang.RelativeTerms
+		if terms == nil || len(lang.Months) == 0 {
+			continue
+		}
+
+		directions, modifierPattern := relativeModifiers(terms)
+		monthNames := make([]string, 0, len(lang.Months))
+		for name := range lang.Months {
+			monthNames = append(monthNames, name)
+		}
+		monthPattern := termAlternation(monthNames)
+		pattern := fmt.Sprintf(`^(?:(%s)\s+(%s)|(%s)\s+(%s))$`, modifierPattern, monthPattern, monthPattern, modifierPattern)
+
+		matches := ctx.compile(pattern).FindStringSubmatch(input)
+		if matches == nil {
+			continue
+		}
+
+		modifier, monthName := matches[1], matches[2]
+		if modifier == "" {
+			modifier, monthName = matches[4], matches[3]
+		}
+		month, ok := translations.ParseMonth(monthName, lang)
+		if !ok {
+			continue
+		}
+
+		base := ctx.settings.RelativeBase
+		year := base.Year()
+		switch directions[modifier] {
+		case 1:
+			if month <= base.Month() {
+				year++
+			}
+		case -1:
+			if month >= base.Month() {
+				year--
+			}
+		}
+		return time.Date(year, month, 1, 0, 0, 0, 0, ctx.settings.PreferredTimezone), nil
+	}
+
+	return time.Time{}, fmt.Errorf("no named month pattern matched")
+}
+
 // tryParseMultiLangExtended attempts to parse extended patterns in multiple languages
 func tryParseMultiLangExtended(ctx *parserContext, input string) (time.Time, error) {
 	for _, lang := range ctx.languages {
@@ -355,6 +708,11 @@ func tryParseMultiLangExtended(ctx *parserContext, input string) (time.Time, err
 	return time.Time{}, fmt.Errorf("no multi-lang extended pattern matched")
 }
 
+// boundaryLinkPattern matches the optional preposition and article between a
+// boundary term and its period: "fin de mes", "fin de la próxima semana",
+// "début du prochain mois", "inizio del mese".
+const boundaryLinkPattern = `(?:(?:de|di|van|del|du)\s+)?(?:(?:la|el|le|il)\s+)?`
+
 // tryParsePeriodBoundary parses "comienzo de mes", "fin de año", etc.
 func tryParsePeriodBoundary(ctx *parserContext, input string, lang *translations.Language) (time.Time, error) {
 	terms := lang.RelativeTerms
@@ -425,67 +783,67 @@ func tryParsePeriodBoundary(ctx *parserContext, input string, lang *translations
 	for periodEs, periodEn := range periods {
 		// Try "comienzo de mes" (Spanish/Portuguese/French: de, Italian: di, Dutch: van, Russian: no preposition)
 		for _, beginTerm := range beginTerms {
-			pattern := fmt.Sprintf(`^%s\s+(de\s+|di\s+|van\s+)?%s$`, regexp.QuoteMeta(beginTerm), regexp.QuoteMeta(periodEs))
-			if matched, _ := regexp.MatchString(pattern, input); matched {
-				return getStartOfPeriod(base, periodEn), nil
+			pattern := fmt.Sprintf(`^%s\s+`+boundaryLinkPattern+`%s$`, regexp.QuoteMeta(beginTerm), regexp.QuoteMeta(periodEs))
+			if ctx.compile(pattern).MatchString(input) {
+				return getStartOfPeriod(base, periodEn, ctx.weekStart()), nil
 			}
 		}
 
 		// Try "fin de mes"
 		for _, endTerm := range endTerms {
-			pattern := fmt.Sprintf(`^%s\s+(de\s+|di\s+|van\s+)?%s$`, regexp.QuoteMeta(endTerm), regexp.QuoteMeta(periodEs))
-			if matched, _ := regexp.MatchString(pattern, input); matched {
-				return getEndOfPeriod(base, periodEn), nil
+			pattern := fmt.Sprintf(`^%s\s+`+boundaryLinkPattern+`%s$`, regexp.QuoteMeta(endTerm), regexp.QuoteMeta(periodEs))
+			if ctx.compile(pattern).MatchString(input) {
+				return getEndOfPeriod(base, periodEn, ctx.weekStart()), nil
 			}
 		}
 
 		// Try "próximo mes", "último año"
 		for _, nextTerm := range terms.Next {
 			pattern := fmt.Sprintf(`^%s\s+%s$`, regexp.QuoteMeta(nextTerm), regexp.QuoteMeta(periodEs))
-			if matched, _ := regexp.MatchString(pattern, input); matched {
-				return addPeriod(base, periodEn, 1), nil
+			if ctx.compile(pattern).MatchString(input) {
+				return addPeriodOffset(ctx, 1, periodEn)
 			}
 
 			// "comienzo de próximo mes" (with various prepositions)
 			for _, beginTerm := range beginTerms {
-				pattern := fmt.Sprintf(`^%s\s+(de\s+|di\s+|van\s+)?%s\s+%s$`, regexp.QuoteMeta(beginTerm), regexp.QuoteMeta(nextTerm), regexp.QuoteMeta(periodEs))
-				if matched, _ := regexp.MatchString(pattern, input); matched {
-					nextPeriod := addPeriod(base, periodEn, 1)
-					return getStartOfPeriod(nextPeriod, periodEn), nil
+				pattern := fmt.Sprintf(`^%s\s+`+boundaryLinkPattern+`%s\s+%s$`, regexp.QuoteMeta(beginTerm), regexp.QuoteMeta(nextTerm), regexp.QuoteMeta(periodEs))
+				if ctx.compile(pattern).MatchString(input) {
+					nextPeriod := shiftedPeriodStart(base, periodEn, 1, ctx.weekStart())
+					return getStartOfPeriod(nextPeriod, periodEn, ctx.weekStart()), nil
 				}
 			}
 
 			// "fin de próximo mes"
 			for _, endTerm := range endTerms {
-				pattern := fmt.Sprintf(`^%s\s+(de\s+|di\s+|van\s+)?%s\s+%s$`, regexp.QuoteMeta(endTerm), regexp.QuoteMeta(nextTerm), regexp.QuoteMeta(periodEs))
-				if matched, _ := regexp.MatchString(pattern, input); matched {
-					nextPeriod := addPeriod(base, periodEn, 1)
-					return getEndOfPeriod(nextPeriod, periodEn), nil
+				pattern := fmt.Sprintf(`^%s\s+`+boundaryLinkPattern+`%s\s+%s$`, regexp.QuoteMeta(endTerm), regexp.QuoteMeta(nextTerm), regexp.QuoteMeta(periodEs))
+				if ctx.compile(pattern).MatchString(input) {
+					nextPeriod := shiftedPeriodStart(base, periodEn, 1, ctx.weekStart())
+					return getEndOfPeriod(nextPeriod, periodEn, ctx.weekStart()), nil
 				}
 			}
 		}
 
 		for _, lastTerm := range terms.Last {
 			pattern := fmt.Sprintf(`^%s\s+%s$`, regexp.QuoteMeta(lastTerm), regexp.QuoteMeta(periodEs))
-			if matched, _ := regexp.MatchString(pattern, input); matched {
-				return addPeriod(base, periodEn, -1), nil
+			if ctx.compile(pattern).MatchString(input) {
+				return addPeriodOffset(ctx, -1, periodEn)
 			}
 
 			// "comienzo de último mes" (with various prepositions)
 			for _, beginTerm := range beginTerms {
-				pattern := fmt.Sprintf(`^%s\s+(de\s+|di\s+|van\s+)?%s\s+%s$`, regexp.QuoteMeta(beginTerm), regexp.QuoteMeta(lastTerm), regexp.QuoteMeta(periodEs))
-				if matched, _ := regexp.MatchString(pattern, input); matched {
-					lastPeriod := addPeriod(base, periodEn, -1)
-					return getStartOfPeriod(lastPeriod, periodEn), nil
+				pattern := fmt.Sprintf(`^%s\s+`+boundaryLinkPattern+`%s\s+%s$`, regexp.QuoteMeta(beginTerm), regexp.QuoteMeta(lastTerm), regexp.QuoteMeta(periodEs))
+				if ctx.compile(pattern).MatchString(input) {
+					lastPeriod := shiftedPeriodStart(base, periodEn, -1, ctx.weekStart())
+					return getStartOfPeriod(lastPeriod, periodEn, ctx.weekStart()), nil
 				}
 			}
 
 			// "fin de último mes"
 			for _, endTerm := range endTerms {
-				pattern := fmt.Sprintf(`^%s\s+(de\s+|di\s+|van\s+)?%s\s+%s$`, regexp.QuoteMeta(endTerm), regexp.QuoteMeta(lastTerm), regexp.QuoteMeta(periodEs))
-				if matched, _ := regexp.MatchString(pattern, input); matched {
-					lastPeriod := addPeriod(base, periodEn, -1)
-					return getEndOfPeriod(lastPeriod, periodEn), nil
+				pattern := fmt.Sprintf(`^%s\s+`+boundaryLinkPattern+`%s\s+%s$`, regexp.QuoteMeta(endTerm), regexp.QuoteMeta(lastTerm), regexp.QuoteMeta(periodEs))
+				if ctx.compile(pattern).MatchString(input) {
+					lastPeriod := shiftedPeriodStart(base, periodEn, -1, ctx.weekStart())
+					return getEndOfPeriod(lastPeriod, periodEn, ctx.weekStart()), nil
 				}
 			}
 		}
@@ -503,7 +861,7 @@ func tryParseThisNextLast(ctx *parserContext, input string, lang *translations.L
 	for _, thisTerm := range terms.This {
 		for weekdayName, weekday := range lang.Weekdays {
 			pattern := fmt.Sprintf(`^%s\s+%s$`, regexp.QuoteMeta(thisTerm), regexp.QuoteMeta(weekdayName))
-			if matched, _ := regexp.MatchString(pattern, input); matched {
+			if ctx.compile(pattern).MatchString(input) {
 				current := base.Weekday()
 				daysAhead := int(weekday - current)
 				if daysAhead < 0 {
@@ -568,8 +926,8 @@ func tryParseThisNextLast(ctx *parserContext, input string, lang *translations.L
 		}
 		for periodEs, periodEn := range periods {
 			pattern := fmt.Sprintf(`^%s\s+%s$`, regexp.QuoteMeta(thisTerm), regexp.QuoteMeta(periodEs))
-			if matched, _ := regexp.MatchString(pattern, input); matched {
-				return getStartOfPeriod(base, periodEn), nil
+			if ctx.compile(pattern).MatchString(input) {
+				return getStartOfPeriod(base, periodEn, ctx.weekStart()), nil
 			}
 		}
 	}
@@ -0,0 +1,184 @@
+package godateparser
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+)
+
+// Tests for the reusable Parser type
+
+func TestNew_InvalidSettings(t *testing.T) {
+	tests := []struct {
+		name string
+		opts *Settings
+	}{
+		{"bad date order", &Settings{DateOrder: "DYM"}},
+		{"bad prefer dates from", &Settings{PreferDatesFrom: "sometime"}},
+		{"bad decimal separator", &Settings{DecimalSeparator: ";"}},
+		{"unknown language", &Settings{Languages: []string{"xx"}}},
+		{"bad week start", &Settings{WeekStartsOn: "someday"}},
+		{"default time past midnight", &Settings{DefaultTime: 25 * time.Hour}},
+		{"negative end of day", &Settings{EndOfDay: -time.Hour}},
+		{"unknown city timezone", &Settings{CityTimezones: map[string]string{"Atlantis": "Ocean/Atlantis"}}},
+		{"unknown disabled feature", &Settings{DisableFeatures: []string{"bare_years"}}},
+		{"negative max results", &Settings{MaxResults: -1}},
+		{"negative context window", &Settings{ContextWindow: -1}},
+		{"negative min match length", &Settings{MinMatchLength: -1}},
+		{"negative few amount", &Settings{FewAmount: -1}},
+		{"malformed day part time", &Settings{DayPartTimes: map[string]string{"teatime": "4pm"}}},
+		{"out of range day part time", &Settings{DayPartTimes: map[string]string{"teatime": "25:00"}}},
+		{"invalid fiscal start month", &Settings{FiscalCalendar: &FiscalCalendar{StartMonth: 13}}},
+		{"MinDate after MaxDate", &Settings{MinDate: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), MaxDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}},
+		{"negative DetectionThreshold", &Settings{DetectionThreshold: -1}},
+		{"unsupported DefaultLanguage", &Settings{DefaultLanguage: "xx"}},
+		{"weekend covers the week", &Settings{Weekend: []time.Weekday{
+			time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday,
+		}}},
+		{"invalid weekend day", &Settings{Weekend: []time.Weekday{7}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.opts); err == nil {
+				t.Errorf("New(%+v) expected error", tt.opts)
+			}
+		})
+	}
+}
+
+func TestParser_MatchesPackageFunctions(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := &Settings{RelativeBase: base, Languages: []string{"en", "es"}}
+
+	p, err := New(settings)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	inputs := []string{"2024-12-31", "June 15", "3rd of June", "hace 2 días", "next week", "15 de marzo de 2024", "3:30 PM"}
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			want, wantErr := ParseDate(input, settings)
+			// Parse twice so the second call runs against the warm cache
+			for i := 0; i < 2; i++ {
+				got, err := p.Parse(input)
+				if (err != nil) != (wantErr != nil) {
+					t.Fatalf("Parse(%q) error = %v, ParseDate error = %v", input, err, wantErr)
+				}
+				if !got.Equal(want) {
+					t.Errorf("Parse(%q) = %v, want %v", input, got, want)
+				}
+			}
+		})
+	}
+
+	results, err := p.Extract("Due 2024-12-31, review yesterday")
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if len(results) != 2 {
+		t.Errorf("Extract() found %d dates, want 2", len(results))
+	}
+}
+
+func TestParser_SettingsAreCopied(t *testing.T) {
+	settings := &Settings{DateOrder: "DMY"}
+	p, err := New(settings)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+	settings.DateOrder = "MDY"
+
+	result, err := p.Parse("01/02/2024")
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if result.Month() != time.February || result.Day() != 1 {
+		t.Errorf("Parse() = %v, want 2024-02-01 (DMY)", result)
+	}
+}
+
+func TestParser_Concurrent(t *testing.T) {
+	p, err := New(&Settings{Languages: []string{"en", "fr"}})
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 20; j++ {
+				if _, err := p.Parse("15 mars 2024"); err != nil {
+					t.Errorf("Parse() error = %v", err)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestRegexCache_Bounded(t *testing.T) {
+	cache := newRegexCache()
+	for i := 0; i < maxCachedPatterns+100; i++ {
+		pattern := fmt.Sprintf(`^x%d$`, i)
+		if re := cache.get(pattern); !re.MatchString(fmt.Sprintf("x%d", i)) {
+			t.Fatalf("get(%q) = %v", pattern, re)
+		}
+	}
+	if n := len(cache.patterns); n > maxCachedPatterns {
+		t.Errorf("cache holds %d patterns, want at most %d", n, maxCachedPatterns)
+	}
+}
+
+// Benchmarks
+
+func BenchmarkParser_Parse(b *testing.B) {
+	p, _ := New(&Settings{Languages: []string{"en", "es"}})
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Parse("15 de marzo de 2024")
+	}
+}
+
+func BenchmarkParser_PackageLevelParse(b *testing.B) {
+	settings := &Settings{Languages: []string{"en", "es"}}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseDate("15 de marzo de 2024", settings)
+	}
+}
+
+// BenchmarkParser_PackageLevelParseDetected parses with the language
+// detected from the input, so localized patterns are built on every call.
+func BenchmarkParser_PackageLevelParseDetected(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = ParseDate("15 de marzo de 2024", nil)
+	}
+}
+
+func BenchmarkParser_Extract(b *testing.B) {
+	p, _ := New(nil)
+	text := "Meeting on 2024-12-31, follow-up next week, deadline December 15, 2024"
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = p.Extract(text)
+	}
+}
+
+func BenchmarkParser_PackageLevelExtract(b *testing.B) {
+	text := "Meeting on 2024-12-31, follow-up next week, deadline December 15, 2024"
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = ExtractDates(text, nil)
+	}
+}
@@ -3,9 +3,12 @@ package godateparser
 import (
 	"fmt"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/coredds/godateparser/translations"
 )
@@ -32,6 +35,7 @@ var timePatterns = []*timePattern{
 			if matches[3] != "" {
 				second, _ = strconv.Atoi(matches[3])
 			}
+			second, leap := leapSecond(ctx, second)
 			period := strings.ToUpper(matches[4])
 
 			// Convert to 24-hour format
@@ -48,7 +52,7 @@ var timePatterns = []*timePattern{
 
 			// Use base date from settings
 			base := ctx.settings.RelativeBase
-			return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, second, 0, base.Location()), nil
+			return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, second, 0, base.Location()).Add(leap), nil
 		},
 	},
 	// 12-hour format without colon seconds (9:15AM, 3:30PM)
@@ -76,9 +80,9 @@ var timePatterns = []*timePattern{
 			return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, 0, 0, base.Location()), nil
 		},
 	},
-	// Short format (9am, 3pm, 12pm)
+	// Short format (9am, 3pm, 12pm, 7 PM)
 	{
-		regex: regexp.MustCompile(`(?i)^(\d{1,2})(am|pm)$`),
+		regex: regexp.MustCompile(`(?i)^(\d{1,2})\s*(am|pm)$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
 			hour, _ := strconv.Atoi(matches[1])
 			period := strings.ToLower(matches[2])
@@ -100,13 +104,21 @@ var timePatterns = []*timePattern{
 			return time.Date(base.Year(), base.Month(), base.Day(), hour, 0, 0, 0, base.Location()), nil
 		},
 	},
-	// 24-hour format with seconds (14:30:00, 09:15:45)
+	// 24-hour format with seconds (14:30:00, 09:15:45, 09:15:45.500, 09:15:45,500)
 	{
-		regex: regexp.MustCompile(`^(\d{1,2}):(\d{2}):(\d{2})$`),
+		regex: regexp.MustCompile(`^(\d{1,2}):(\d{2}):(\d{2})(?:([.,])(\d{1,9}))?$`),
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
 			hour, _ := strconv.Atoi(matches[1])
 			minute, _ := strconv.Atoi(matches[2])
 			second, _ := strconv.Atoi(matches[3])
+			second, leap := leapSecond(ctx, second)
+
+			// Fractional seconds must use the active decimal separator
+			if matches[4] != "" && matches[4] != decimalSeparator(ctx) {
+				return time.Time{}, fmt.Errorf("unexpected decimal separator %q in time", matches[4])
+			}
+			nanos := parseFraction(matches[5])
+			hour, days := extendedHour(ctx, hour)
 
 			// Validate time components
 			if err := validateTime(hour, minute, second); err != nil {
@@ -115,7 +127,7 @@ var timePatterns = []*timePattern{
 
 			// Use base date from settings
 			base := ctx.settings.RelativeBase
-			return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, second, 0, base.Location()), nil
+			return time.Date(base.Year(), base.Month(), base.Day()+days, hour, minute, second, nanos, base.Location()).Add(leap), nil
 		},
 	},
 	// 24-hour format without seconds (14:30, 09:15, 23:59)
@@ -124,6 +136,7 @@ var timePatterns = []*timePattern{
 		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
 			hour, _ := strconv.Atoi(matches[1])
 			minute, _ := strconv.Atoi(matches[2])
+			hour, days := extendedHour(ctx, hour)
 
 			// Validate time components
 			if err := validateTime(hour, minute, 0); err != nil {
@@ -132,7 +145,7 @@ var timePatterns = []*timePattern{
 
 			// Use base date from settings
 			base := ctx.settings.RelativeBase
-			return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, 0, 0, base.Location()), nil
+			return time.Date(base.Year(), base.Month(), base.Day()+days, hour, minute, 0, 0, base.Location()), nil
 		},
 	},
 	// Natural language time expressions (v1.2 Phase 5)
@@ -230,21 +243,54 @@ var timePatterns = []*timePattern{
 // validateTime validates time components
 func validateTime(hour, minute, second int) error {
 	if hour < 0 || hour > 23 {
-		return &ErrInvalidDate{Year: 0, Month: 0, Day: 0, Reason: fmt.Sprintf("hour %d out of range (0-23)", hour)}
+		return &ErrInvalidDate{Year: 0, Month: 0, Day: 0, Reason: fmt.Sprintf("hour %d out of range (0-23)", hour), field: "hour", value: hour}
 	}
 	if minute < 0 || minute > 59 {
-		return &ErrInvalidDate{Year: 0, Month: 0, Day: 0, Reason: fmt.Sprintf("minute %d out of range (0-59)", minute)}
+		return &ErrInvalidDate{Year: 0, Month: 0, Day: 0, Reason: fmt.Sprintf("minute %d out of range (0-59)", minute), field: "minute", value: minute}
 	}
 	if second < 0 || second > 59 {
-		return &ErrInvalidDate{Year: 0, Month: 0, Day: 0, Reason: fmt.Sprintf("second %d out of range (0-59)", second)}
+		return &ErrInvalidDate{Year: 0, Month: 0, Day: 0, Reason: fmt.Sprintf("second %d out of range (0-59)", second), field: "second", value: second}
 	}
 	return nil
 }
 
+// leapSecond reads a ":60" second when Settings.AllowLeapSecond is set. It
+// returns 59 and one second for the caller to add to the result, which rolls
+// the leap second over to the following second. Any other second, or a leap
+// second without the setting, is returned as is for validation to reject.
+func leapSecond(ctx *parserContext, second int) (int, time.Duration) {
+	if second == 60 && ctx.settings.AllowLeapSecond {
+		return 59, time.Second
+	}
+	return second, 0
+}
+
+// extendedHour reads an hour from 24 to 47, as in the "25:30" of transit
+// and broadcast schedules, when Settings.AllowExtendedHours is set. It
+// returns the hour of the following day and one day for the caller to add.
+// Any other hour, or an extended hour without the setting, is returned as is
+// for validation to reject.
+func extendedHour(ctx *parserContext, hour int) (int, int) {
+	if hour >= 24 && hour < 48 && ctx.settings.AllowExtendedHours {
+		return hour - 24, 1
+	}
+	return hour, 0
+}
+
 // tryParseTime attempts to parse time-only inputs
 func tryParseTime(ctx *parserContext) (time.Time, error) {
 	input := strings.TrimSpace(ctx.input)
 
+	// "15:30:45 PST", "3:30pmEST": read the time on the base date in the zone
+	if rest, tzInfo, _ := ExtractTimezone(input); tzInfo != nil && rest != "" {
+		sub := *ctx
+		sub.input = rest
+		if result, err := tryParseTime(&sub); err == nil {
+			return time.Date(result.Year(), result.Month(), result.Day(), result.Hour(), result.Minute(),
+				result.Second(), result.Nanosecond(), tzInfo.Location), nil
+		}
+	}
+
 	// Try multi-language time expressions first
 	if result, err := tryParseMultiLangTime(ctx, input); err == nil {
 		return result, nil
@@ -253,7 +299,11 @@ func tryParseTime(ctx *parserContext) (time.Time, error) {
 	for _, pattern := range timePatterns {
 		matches := pattern.regex.FindStringSubmatch(input)
 		if matches != nil {
-			return pattern.parser(ctx, matches)
+			result, err := pattern.parser(ctx, matches)
+			if err != nil {
+				return time.Time{}, locateError(err, ctx.input, input, pattern.regex)
+			}
+			return result, nil
 		}
 	}
 
@@ -279,6 +329,11 @@ func tryParseMultiLangTime(ctx *parserContext, input string) (time.Time, error)
 	input = strings.ToLower(strings.TrimSpace(input))
 	base := ctx.settings.RelativeBase
 
+	// Day parts named in Settings.DayPartTimes override the language defaults
+	if hour, minute, ok := lookupDayPart(input, ctx.settings.DayPartTimes); ok {
+		return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, 0, 0, base.Location()), nil
+	}
+
 	// Try each language's time terms
 	for _, lang := range ctx.languages {
 		if lang.TimeTerms == nil {
@@ -298,6 +353,11 @@ func tryParseMultiLangTime(ctx *parserContext, input string) (time.Time, error)
 			}
 		}
 
+		// Try named parts of the day: "teatime", "dusk"
+		if hour, minute, ok := lookupDayPart(input, lang.TimeTerms.DayParts); ok {
+			return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, 0, 0, base.Location()), nil
+		}
+
 		// Try "X y cuarto" (X and quarter) patterns - Spanish "quarter past"
 		if result, err := tryParseYCuarto(ctx, input, lang); err == nil {
 			return result, nil
@@ -333,8 +393,8 @@ func tryParseMultiLangTime(ctx *parserContext, input string) (time.Time, error)
 			return result, nil
 		}
 
-		// Try "half 4" patterns - Dutch "half past" (special: means 3:30, not 4:30)
-		if result, err := tryParseDutchHalf(ctx, input, lang); err == nil {
+		// Try spoken times: "ten to six", "half ten", "halb zehn", "fünf vor halb zehn"
+		if result, err := tryParseSpokenTime(ctx, input, lang); err == nil {
 			return result, nil
 		}
 
@@ -357,6 +417,34 @@ func tryParseMultiLangTime(ctx *parserContext, input string) (time.Time, error)
 	return time.Time{}, fmt.Errorf("no multi-language time pattern matched")
 }
 
+// lookupDayPart returns the time of day a named part of the day stands for,
+// matching names in dayParts case-insensitively.
+func lookupDayPart(name string, dayParts map[string]string) (int, int, bool) {
+	for part, clock := range dayParts {
+		if strings.EqualFold(part, name) {
+			return parseClockTime(clock)
+		}
+	}
+	return 0, 0, false
+}
+
+// clockTimePattern matches a time of day written as "HH:MM" or "H:MM".
+var clockTimePattern = regexp.MustCompile(`^(\d{1,2}):(\d{2})$`)
+
+// parseClockTime parses an "HH:MM" time of day, as used by day parts.
+func parseClockTime(s string) (int, int, bool) {
+	matches := clockTimePattern.FindStringSubmatch(strings.TrimSpace(s))
+	if matches == nil {
+		return 0, 0, false
+	}
+	hour, _ := strconv.Atoi(matches[1])
+	minute, _ := strconv.Atoi(matches[2])
+	if validateTime(hour, minute, 0) != nil {
+		return 0, 0, false
+	}
+	return hour, minute, true
+}
+
 // tryParseYCuarto parses Spanish "X y cuarto" (quarter past X)
 func tryParseYCuarto(ctx *parserContext, input string, lang *translations.Language) (time.Time, error) {
 	if lang.TimeTerms == nil {
@@ -379,7 +467,7 @@ func tryParseYCuarto(ctx *parserContext, input string, lang *translations.Langua
 			pattern := fmt.Sprintf(`^(\d{1,2})\s+%s\s+%s$`,
 				regexp.QuoteMeta(strings.ToLower(pastTerm)),
 				regexp.QuoteMeta(strings.ToLower(quarterTerm)))
-			re := regexp.MustCompile(pattern)
+			re := ctx.compile(pattern)
 
 			if matches := re.FindStringSubmatch(input); matches != nil {
 				hour, _ := strconv.Atoi(matches[1])
@@ -425,7 +513,7 @@ func tryParseYMedia(ctx *parserContext, input string, lang *translations.Languag
 			pattern := fmt.Sprintf(`^(\d{1,2})\s+%s\s+%s$`,
 				regexp.QuoteMeta(strings.ToLower(pastTerm)),
 				regexp.QuoteMeta(strings.ToLower(halfTerm)))
-			re := regexp.MustCompile(pattern)
+			re := ctx.compile(pattern)
 
 			if matches := re.FindStringSubmatch(input); matches != nil {
 				hour, _ := strconv.Atoi(matches[1])
@@ -471,7 +559,7 @@ func tryParseMenosCuarto(ctx *parserContext, input string, lang *translations.La
 			pattern := fmt.Sprintf(`^%s\s+%s\s+(?:las\s+|as\s+)?(\d{1,2})$`,
 				regexp.QuoteMeta(strings.ToLower(toTerm)),
 				regexp.QuoteMeta(strings.ToLower(quarterTerm)))
-			re := regexp.MustCompile(pattern)
+			re := ctx.compile(pattern)
 
 			if matches := re.FindStringSubmatch(input); matches != nil {
 				hour, _ := strconv.Atoi(matches[1])
@@ -504,7 +592,7 @@ func tryParseMenosCuarto(ctx *parserContext, input string, lang *translations.La
 func tryParseFrenchHFormat(ctx *parserContext, input string, _ *translations.Language) (time.Time, error) {
 	// Pattern: "15h30" or "15h" (h is the separator)
 	pattern := `^(\d{1,2})h(\d{2})?$`
-	re := regexp.MustCompile(pattern)
+	re := ctx.compile(pattern)
 
 	if matches := re.FindStringSubmatch(input); matches != nil {
 		hour, _ := strconv.Atoi(matches[1])
@@ -540,7 +628,7 @@ func tryParseFrenchHeures(ctx *parserContext, input string, lang *translations.L
 		// Pattern: "3 heures 30" or "3 heure 30"
 		pattern := fmt.Sprintf(`^(\d{1,2})\s+%s\s+(\d{1,2})$`,
 			regexp.QuoteMeta(strings.ToLower(oclockTerm)))
-		re := regexp.MustCompile(pattern)
+		re := ctx.compile(pattern)
 
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			hour, _ := strconv.Atoi(matches[1])
@@ -581,7 +669,7 @@ func tryParseQuarterTo(ctx *parserContext, input string, lang *translations.Lang
 			pattern := fmt.Sprintf(`^%s\s+%s\s+(?:as\s+|o\s+)?(\d{1,2})$`,
 				regexp.QuoteMeta(strings.ToLower(quarterTerm)),
 				regexp.QuoteMeta(strings.ToLower(toTerm)))
-			re := regexp.MustCompile(pattern)
+			re := ctx.compile(pattern)
 
 			if matches := re.FindStringSubmatch(input); matches != nil {
 				hour, _ := strconv.Atoi(matches[1])
@@ -630,7 +718,7 @@ func tryParseDutchKwartOver(ctx *parserContext, input string, lang *translations
 			pattern := fmt.Sprintf(`^%s\s+%s\s+(\d{1,2})$`,
 				regexp.QuoteMeta(strings.ToLower(quarterTerm)),
 				regexp.QuoteMeta(strings.ToLower(pastTerm)))
-			re := regexp.MustCompile(pattern)
+			re := ctx.compile(pattern)
 
 			if matches := re.FindStringSubmatch(input); matches != nil {
 				hour, _ := strconv.Atoi(matches[1])
@@ -654,48 +742,114 @@ func tryParseDutchKwartOver(ctx *parserContext, input string, lang *translations
 	return time.Time{}, fmt.Errorf("no match")
 }
 
-// tryParseDutchHalf parses Dutch "half 4" (means 3:30, not 4:30!)
-func tryParseDutchHalf(ctx *parserContext, input string, lang *translations.Language) (time.Time, error) {
-	if lang.TimeTerms == nil {
+// spokenWordPattern matches one or two words of a spoken time: a number word
+// ("ten", "twenty-five", "twenty five"), a fraction word or an hour in digits.
+const spokenWordPattern = `\d{1,2}|[\p{L}]+(?:[- ][\p{L}]+)?`
+
+// tryParseSpokenTime parses a time spoken in words: "ten to six" (5:50),
+// "twenty past eight", "quarter past nine" and "half ten". How "half" with an
+// hour reads depends on the language: British "half ten" is 10:30, while with
+// TimeTerms.HalfToNext German "halb zehn" and Dutch "half 4" are the half hour
+// before (9:30, 3:30), and minutes may count from it ("fünf vor halb zehn" is 9:25).
+func tryParseSpokenTime(ctx *parserContext, input string, lang *translations.Language) (time.Time, error) {
+	terms := lang.TimeTerms
+	if terms == nil || len(terms.Half) == 0 {
 		return time.Time{}, fmt.Errorf("no time terms")
 	}
 
-	// Try with each "half" term
-	for _, halfTerm := range lang.TimeTerms.Half {
-		if halfTerm == "" {
-			continue
-		}
-
-		// Pattern: "half 4" - half + hour
-		pattern := fmt.Sprintf(`^%s\s+(\d{1,2})$`,
-			regexp.QuoteMeta(strings.ToLower(halfTerm)))
-		re := regexp.MustCompile(pattern)
+	hour, minute, ok := spokenClock(ctx, input, lang)
+	if !ok {
+		return time.Time{}, fmt.Errorf("no match")
+	}
+	if err := validateTime(hour, minute, 0); err != nil {
+		return time.Time{}, err
+	}
 
-		if matches := re.FindStringSubmatch(input); matches != nil {
-			hour, _ := strconv.Atoi(matches[1])
-			// Dutch "half 4" means "half to 4" = 3:30
-			hour--
-			minute := 30
+	base := ctx.settings.RelativeBase
+	return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, 0, 0, base.Location()), nil
+}
 
-			if hour < 0 {
-				hour = 23
+// spokenClock reads the hour and minute of a spoken time from the patterns
+// tryParseSpokenTime accepts.
+func spokenClock(ctx *parserContext, input string, lang *translations.Language) (int, int, bool) {
+	terms := lang.TimeTerms
+	halfToNext := terms.HalfToNext
+	half := termAlternation(terms.Half)
+	direction := fmt.Sprintf(`(%s)|(%s)`, termAlternation(terms.Past), termAlternation(terms.To))
+
+	// "half ten", "halb zehn"
+	if matches := ctx.compile(fmt.Sprintf(`^(?:%s)\s+(%s)$`, half, spokenWordPattern)).FindStringSubmatch(input); matches != nil {
+		if h, ok := spokenHour(matches[1], lang); ok {
+			if halfToNext {
+				return previousHour(h), 30, true
 			}
+			return h, 30, true
+		}
+	}
 
-			if hour > 23 {
-				return time.Time{}, &ErrInvalidDate{
-					Year:   0,
-					Month:  0,
-					Day:    0,
-					Reason: fmt.Sprintf("hour %d out of range (0-23)", hour),
+	// "fünf vor halb zehn", "fünf nach halb zehn"
+	if halfToNext {
+		if matches := ctx.compile(fmt.Sprintf(`^(%s)\s+(?:%s)\s+(?:%s)\s+(%s)$`, spokenWordPattern, direction, half, spokenWordPattern)).FindStringSubmatch(input); matches != nil {
+			m, okMinute := spokenMinutes(matches[1], lang)
+			h, okHour := spokenHour(matches[4], lang)
+			if okMinute && okHour && m < 30 {
+				if matches[3] != "" {
+					return previousHour(h), 30 - m, true
 				}
+				return previousHour(h), 30 + m, true
 			}
+		}
+	}
 
-			base := ctx.settings.RelativeBase
-			return time.Date(base.Year(), base.Month(), base.Day(), hour, minute, 0, 0, base.Location()), nil
+	// "ten to six", "twenty past eight", "quarter past nine"
+	if matches := ctx.compile(fmt.Sprintf(`^(%s)\s+(?:%s)\s+(%s)$`, spokenWordPattern, direction, spokenWordPattern)).FindStringSubmatch(input); matches != nil {
+		m, okMinute := spokenMinutes(matches[1], lang)
+		h, okHour := spokenHour(matches[4], lang)
+		if okMinute && okHour {
+			if matches[3] != "" {
+				return previousHour(h), 60 - m, true
+			}
+			return h, m, true
 		}
 	}
 
-	return time.Time{}, fmt.Errorf("no match")
+	return 0, 0, false
+}
+
+// previousHour returns the hour a spoken time counts back into. Spoken hours
+// are on a 12-hour clock, so the hour before one is twelve ("ten to one" is
+// 12:50) and the hour before 0 is 23.
+func previousHour(hour int) int {
+	switch hour {
+	case 0:
+		return 23
+	case 1:
+		return 12
+	}
+	return hour - 1
+}
+
+// spokenHour reads the hour of a spoken time, in digits or as a number word.
+func spokenHour(s string, lang *translations.Language) (int, bool) {
+	if hour, err := strconv.Atoi(s); err == nil {
+		return hour, true
+	}
+	hour, ok := translations.ParseNumberWord(s, lang)
+	return hour, ok && hour >= 1 && hour <= 12
+}
+
+// spokenMinutes reads the minutes of a spoken time: a quarter (15), a half
+// (30) or a number word from one to fifty-nine. Digits are not accepted, so
+// "10 to 6" is left to range parsing.
+func spokenMinutes(s string, lang *translations.Language) (int, bool) {
+	switch {
+	case translations.MatchesRelativeTerm(s, lang.TimeTerms.Quarter):
+		return 15, true
+	case translations.MatchesRelativeTerm(s, lang.TimeTerms.Half):
+		return 30, true
+	}
+	minutes, ok := translations.ParseNumberWord(s, lang)
+	return minutes, ok && minutes >= 1 && minutes <= 59
 }
 
 // tryParseItalianQuarto parses Italian "3 e un quarto" (3 and a quarter = 3:15)
@@ -713,7 +867,7 @@ func tryParseItalianQuarto(ctx *parserContext, input string, lang *translations.
 		// Pattern: "3 e un quarto" - hour + e + un + quarter
 		pattern := fmt.Sprintf(`^(\d{1,2})\s+e\s+un\s+%s$`,
 			regexp.QuoteMeta(strings.ToLower(quarterTerm)))
-		re := regexp.MustCompile(pattern)
+		re := ctx.compile(pattern)
 
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			hour, _ := strconv.Atoi(matches[1])
@@ -751,7 +905,7 @@ func tryParseItalianMenoQuarto(ctx *parserContext, input string, lang *translati
 		// Pattern: "meno un quarto le 3" - meno + un + quarter + le + hour
 		pattern := fmt.Sprintf(`^meno\s+un\s+%s\s+le\s+(\d{1,2})$`,
 			regexp.QuoteMeta(strings.ToLower(quarterTerm)))
-		re := regexp.MustCompile(pattern)
+		re := ctx.compile(pattern)
 
 		if matches := re.FindStringSubmatch(input); matches != nil {
 			hour, _ := strconv.Atoi(matches[1])
@@ -801,7 +955,7 @@ func tryParseRussianHoursAMPM(ctx *parserContext, input string, lang *translatio
 			pattern := fmt.Sprintf(`^(\d{1,2})\s+%s\s+%s$`,
 				regexp.QuoteMeta(strings.ToLower(oclockTerm)),
 				regexp.QuoteMeta(strings.ToLower(amTerm)))
-			re := regexp.MustCompile(pattern)
+			re := ctx.compile(pattern)
 
 			if matches := re.FindStringSubmatch(input); matches != nil {
 				hour, _ := strconv.Atoi(matches[1])
@@ -836,7 +990,7 @@ func tryParseRussianHoursAMPM(ctx *parserContext, input string, lang *translatio
 			pattern := fmt.Sprintf(`^(\d{1,2})\s+%s\s+%s$`,
 				regexp.QuoteMeta(strings.ToLower(oclockTerm)),
 				regexp.QuoteMeta(strings.ToLower(pmTerm)))
-			re := regexp.MustCompile(pattern)
+			re := ctx.compile(pattern)
 
 			if matches := re.FindStringSubmatch(input); matches != nil {
 				hour, _ := strconv.Atoi(matches[1])
@@ -864,3 +1018,137 @@ func tryParseRussianHoursAMPM(ctx *parserContext, input string, lang *translatio
 
 	return time.Time{}, fmt.Errorf("no match")
 }
+
+// approximateHourPattern matches a bare hour left after stripping an
+// approximation qualifier ("around 3", "3-ish").
+var approximateHourPattern = regexp.MustCompile(`^(\d{1,2})$`)
+
+// stripApproximation removes an approximation qualifier from input: a "~"
+// or a leading term such as "around" or "vers", or a trailing one such as
+// "-ish" or "左右". It reports whether a qualifier was found.
+func stripApproximation(input string, langs []*translations.Language) (string, bool) {
+	input = strings.ToLower(strings.TrimSpace(input))
+	if rest := strings.TrimPrefix(input, "~"); rest != input {
+		return strings.TrimSpace(rest), true
+	}
+
+	var before, after []string
+	for _, lang := range langs {
+		if lang.TimeTerms != nil {
+			before = append(before, lang.TimeTerms.Around...)
+			after = append(after, lang.TimeTerms.AroundAfter...)
+		}
+	}
+	return stripTimeQualifier(input, before, after)
+}
+
+// stripPrecision removes a precision marker from input: a leading term such
+// as "exactly" or "genau", or a trailing one such as "sharp", "on the dot" or
+// "pile". It reports whether a marker was found.
+func stripPrecision(input string, langs []*translations.Language) (string, bool) {
+	input = strings.ToLower(strings.TrimSpace(input))
+
+	var before, after []string
+	for _, lang := range langs {
+		if lang.TimeTerms != nil {
+			before = append(before, lang.TimeTerms.Exact...)
+			after = append(after, lang.TimeTerms.ExactAfter...)
+		}
+	}
+	return stripTimeQualifier(input, before, after)
+}
+
+// stripTimeQualifier removes the first of the before terms that leads the
+// lowercase input, or else the first of the after terms that ends it, and
+// reports whether one was found.
+func stripTimeQualifier(input string, before, after []string) (string, bool) {
+	// Longest first, so "-ish" is stripped whole rather than as "ish"
+	sort.Slice(before, func(i, j int) bool { return len(before[i]) > len(before[j]) })
+	sort.Slice(after, func(i, j int) bool { return len(after[i]) > len(after[j]) })
+
+	for _, term := range before {
+		term = strings.ToLower(term)
+		rest, found := strings.CutPrefix(input, term)
+		if !found || rest == "" {
+			continue
+		}
+		// "about" must not match the start of "aboutface"
+		last, _ := utf8.DecodeLastRuneInString(term)
+		next, _ := utf8.DecodeRuneInString(rest)
+		if isAlphabetic(last) && unicode.IsLetter(next) {
+			continue
+		}
+		return strings.TrimSpace(rest), true
+	}
+	for _, term := range after {
+		if rest, found := strings.CutSuffix(input, strings.ToLower(term)); found && strings.TrimSpace(rest) != "" {
+			return strings.TrimSpace(rest), true
+		}
+	}
+	return input, false
+}
+
+// isAlphabetic reports whether r is a letter of a script that separates
+// words with spaces, so CJK qualifiers such as "约" may run into the time.
+func isAlphabetic(r rune) bool {
+	return unicode.IsLetter(r) && !unicode.In(r, unicode.Han, unicode.Hiragana, unicode.Katakana)
+}
+
+// parseExactTime parses a time carrying a precision marker, such as "3pm
+// sharp", "exactly noon" or "15h pile", as the time itself; the marker does
+// not change the value. A connector left after a leading marker ("exactly at
+// 3pm", "genau um 15:00") is dropped too.
+func parseExactTime(input string, opts, settings *Settings, cache *regexCache) (time.Time, bool, error) {
+	langs := translations.GlobalRegistry.GetMultiple(settings.Languages)
+	rest, ok := stripPrecision(input, langs)
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	for _, lang := range langs {
+		if lang.TimeTerms != nil {
+			rest = trimTimeConnector(rest, lang.TimeTerms.At)
+		}
+	}
+
+	result, err := parseDateInZone(rest, opts, cache)
+	if err != nil {
+		if isSpecificError(err) {
+			return time.Time{}, false, err
+		}
+		return time.Time{}, false, nil
+	}
+	return result, true, nil
+}
+
+// parseApproximateTime parses a time carrying an approximation qualifier,
+// such as "around 3pm", "noonish" or "vers midi", as the time itself. A bare
+// hour ("around 3", "3-ish") is read on a daytime clock: 1 to 6 are taken as
+// afternoon, 7 to 11 as morning.
+func parseApproximateTime(input string, opts, settings *Settings, cache *regexCache) (time.Time, bool, error) {
+	langs := translations.GlobalRegistry.GetMultiple(settings.Languages)
+	rest, ok := stripApproximation(input, langs)
+	if !ok {
+		return time.Time{}, false, nil
+	}
+
+	if matches := approximateHourPattern.FindStringSubmatch(rest); matches != nil {
+		hour, _ := strconv.Atoi(matches[1])
+		if err := validateTime(hour, 0, 0); err != nil {
+			return time.Time{}, false, err
+		}
+		if hour >= 1 && hour <= 6 {
+			hour += 12
+		}
+		base := settings.RelativeBase
+		return time.Date(base.Year(), base.Month(), base.Day(), hour, 0, 0, 0, base.Location()), true, nil
+	}
+
+	result, err := parseDateInZone(rest, opts, cache)
+	if err != nil {
+		if isSpecificError(err) {
+			return time.Time{}, false, err
+		}
+		return time.Time{}, false, nil
+	}
+	return result, true, nil
+}
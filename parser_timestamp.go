@@ -9,8 +9,8 @@ import (
 )
 
 var (
-	// Unix timestamp patterns (seconds or milliseconds)
-	timestampRegex = regexp.MustCompile(`^\s*(\d{10,13})\s*$`)
+	// Unix timestamp patterns (seconds or milliseconds, optionally fractional)
+	timestampRegex = regexp.MustCompile(`^\s*(\d{10,13})(?:([.,])(\d{1,9}))?\s*$`)
 )
 
 // parseTimestamp attempts to parse Unix timestamps (seconds or milliseconds).
@@ -19,10 +19,48 @@ func parseTimestamp(ctx *parserContext) (time.Time, error) {
 
 	matches := timestampRegex.FindStringSubmatch(input)
 	if matches == nil {
+		if ctx.settings.GroupedTimestamps {
+			return parseGroupedTimestamp(ctx, input)
+		}
 		return time.Time{}, fmt.Errorf("not a valid timestamp")
 	}
 
-	timestampStr := matches[1]
+	// A fractional part must use the active decimal separator
+	if matches[2] != "" && matches[2] != decimalSeparator(ctx) {
+		return time.Time{}, fmt.Errorf("unexpected decimal separator %q in timestamp", matches[2])
+	}
+
+	return unixTimestamp(matches[1], matches[3])
+}
+
+// parseGroupedTimestamp parses a timestamp whose digits are grouped in
+// threes by the thousands separator of the active locale, "1,702,635,045"
+// with "." decimals or "1.702.635.045" with "," decimals. Every group after
+// the first must have exactly three digits, so a list such as "17,26,35" or
+// "1,702, 635,045" is not read as one number.
+func parseGroupedTimestamp(ctx *parserContext, input string) (time.Time, error) {
+	decimalSep := decimalSeparator(ctx)
+	pattern := fmt.Sprintf(`^(\d{1,3}(?:%s\d{3}){3,4})(?:%s(\d{1,9}))?$`,
+		regexp.QuoteMeta(thousandsSeparator(decimalSep)), regexp.QuoteMeta(decimalSep))
+
+	matches := ctx.compile(pattern).FindStringSubmatch(input)
+	if matches == nil {
+		return time.Time{}, fmt.Errorf("not a valid timestamp")
+	}
+
+	digits := strings.ReplaceAll(matches[1], thousandsSeparator(decimalSep), "")
+	if len(digits) > 13 {
+		return time.Time{}, fmt.Errorf("not a valid timestamp")
+	}
+
+	return unixTimestamp(digits, matches[2])
+}
+
+// unixTimestamp converts the 10-13 digits of a Unix timestamp and the
+// digits of an optional fractional part to a UTC time.
+func unixTimestamp(timestampStr, fractionDigits string) (time.Time, error) {
+	fraction := parseFraction(fractionDigits)
+
 	timestamp, err := strconv.ParseInt(timestampStr, 10, 64)
 	if err != nil {
 		return time.Time{}, fmt.Errorf("invalid timestamp format: %w", err)
@@ -32,10 +70,10 @@ func parseTimestamp(ctx *parserContext) (time.Time, error) {
 	var result time.Time
 	if timestamp > 1e12 {
 		// Milliseconds
-		result = time.Unix(0, timestamp*int64(time.Millisecond))
+		result = time.Unix(0, timestamp*int64(time.Millisecond)+int64(fraction/1000))
 	} else {
 		// Seconds
-		result = time.Unix(timestamp, 0)
+		result = time.Unix(timestamp, int64(fraction))
 	}
 
 	// Return in UTC to ensure consistent timezone handling
@@ -1,7 +1,13 @@
 package godateparser
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/coredds/godateparser/translations"
 )
@@ -13,3 +19,176 @@ func monthNameToNumberWithLangs(name string, langs []*translations.Language) tim
 	}
 	return 0
 }
+
+// stripOrdinalSuffixes removes ordinal suffixes written directly after a day
+// number (e.g. "1st", "1er", "1º", "1-го") using the suffix sets of the given
+// languages, so that "March 1st, 2024" or "1st/2/2024" reach the absolute
+// patterns as "March 1, 2024" and "1/2/2024".
+// Only one- or two-digit numbers are considered and the suffix must be
+// followed by a non-alphanumeric character, which keeps inputs such as
+// "31.12.2024" intact for languages using "." as the ordinal marker.
+func stripOrdinalSuffixes(input string, langs []*translations.Language) string {
+	var suffixes []string
+	for _, lang := range langs {
+		suffixes = append(suffixes, lang.OrdinalSuffixes...)
+	}
+	if len(suffixes) == 0 {
+		return input
+	}
+
+	// Try longer suffixes first so "ème" wins over "e"
+	sort.SliceStable(suffixes, func(i, j int) bool {
+		return len(suffixes[i]) > len(suffixes[j])
+	})
+
+	var b strings.Builder
+	b.Grow(len(input))
+
+	for i := 0; i < len(input); {
+		if !isASCIIDigit(input[i]) || (i > 0 && isASCIIDigit(input[i-1])) {
+			b.WriteByte(input[i])
+			i++
+			continue
+		}
+
+		j := i
+		for j < len(input) && isASCIIDigit(input[j]) {
+			j++
+		}
+		b.WriteString(input[i:j])
+
+		if j-i <= 2 {
+			for _, suffix := range suffixes {
+				end := j + len(suffix)
+				if end <= len(input) && strings.EqualFold(input[j:end], suffix) && isOrdinalBoundary(input[end:]) {
+					j = end
+					break
+				}
+			}
+		}
+		i = j
+	}
+
+	return b.String()
+}
+
+// stripYearSuffix removes a year suffix such as the trailing "." in the
+// Croatian "15. prosinca 2024." when it directly follows a four-digit year
+// at the end of input.
+func stripYearSuffix(input string, langs []*translations.Language) string {
+	for _, lang := range langs {
+		if lang.YearSuffix == "" || !strings.HasSuffix(input, lang.YearSuffix) {
+			continue
+		}
+		rest := input[:len(input)-len(lang.YearSuffix)]
+		n := len(rest)
+		if n < 4 || (n > 4 && isASCIIDigit(rest[n-5])) {
+			continue
+		}
+		if isASCIIDigit(rest[n-4]) && isASCIIDigit(rest[n-3]) && isASCIIDigit(rest[n-2]) && isASCIIDigit(rest[n-1]) {
+			return rest
+		}
+	}
+	return input
+}
+
+// isOrdinalBoundary reports whether rest starts at a position where an
+// ordinal suffix may end (end of input or a non-alphanumeric character).
+func isOrdinalBoundary(rest string) bool {
+	if rest == "" {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(rest)
+	return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+}
+
+// isBlank reports whether s holds nothing but whitespace, control and
+// invisible format characters (such as a zero-width space or byte order
+// mark), so that it is treated like empty input.
+func isBlank(s string) bool {
+	for _, r := range s {
+		if !unicode.IsSpace(r) && !unicode.IsControl(r) && !unicode.Is(unicode.Cf, r) {
+			return false
+		}
+	}
+	return true
+}
+
+// isASCIIDigit reports whether b is an ASCII digit.
+func isASCIIDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+// amountPattern matches an integer or decimal amount such as "3", "1.5" or "12,5".
+const amountPattern = `\d+(?:[.,]\d+)?`
+
+// decimalSeparator returns the decimal separator in effect for the context:
+// the explicit setting, otherwise the first language's separator, otherwise ".".
+func decimalSeparator(ctx *parserContext) string {
+	if ctx.settings != nil && ctx.settings.DecimalSeparator != "" {
+		return ctx.settings.DecimalSeparator
+	}
+	if len(ctx.languages) > 0 && ctx.languages[0].DecimalSeparator != "" {
+		return ctx.languages[0].DecimalSeparator
+	}
+	return "."
+}
+
+// thousandsSeparator returns the digit grouping separator that goes with
+// decimalSep: "," for "." decimals and "." for "," decimals.
+func thousandsSeparator(decimalSep string) string {
+	if decimalSep == "," {
+		return "."
+	}
+	return ","
+}
+
+// parseDecimal parses a number written with the given decimal separator.
+// The other separator is accepted as a thousands separator when it groups
+// exactly three digits ("1,000" with "." decimals, "1.000" with "," decimals).
+func parseDecimal(s, decimalSep string) (float64, error) {
+	thousandsSep := thousandsSeparator(decimalSep)
+
+	if strings.Contains(s, thousandsSep) {
+		groups := strings.Split(s, thousandsSep)
+		for _, group := range groups[1:] {
+			digits := group
+			if idx := strings.Index(group, decimalSep); idx >= 0 {
+				digits = group[:idx]
+			}
+			if len(digits) != 3 {
+				return 0, fmt.Errorf("invalid number %q for decimal separator %q", s, decimalSep)
+			}
+		}
+		s = strings.Join(groups, "")
+	}
+
+	return strconv.ParseFloat(strings.Replace(s, decimalSep, ".", 1), 64)
+}
+
+// parseFraction converts the digits of a decimal fraction (e.g. "5" or "123456")
+// to nanoseconds, truncating anything beyond nanosecond precision.
+func parseFraction(digits string) int {
+	if len(digits) > 9 {
+		digits = digits[:9]
+	}
+	nanos, _ := strconv.Atoi(digits + strings.Repeat("0", 9-len(digits)))
+	return nanos
+}
+
+// joinAlternatives joins quoted regex alternatives into a single "a|b|c" pattern.
+// Alternatives are ordered longest first (then alphabetically) so the result is
+// deterministic, which keeps cached patterns stable and prefers longer names.
+func joinAlternatives(set map[string]bool) string {
+	alternatives := make([]string, 0, len(set))
+	for alt := range set {
+		alternatives = append(alternatives, alt)
+	}
+	sort.Slice(alternatives, func(i, j int) bool {
+		if len(alternatives[i]) != len(alternatives[j]) {
+			return len(alternatives[i]) > len(alternatives[j])
+		}
+		return alternatives[i] < alternatives[j]
+	})
+	return strings.Join(alternatives, "|")
+}
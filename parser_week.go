@@ -30,6 +30,8 @@ var weekPatterns = []*weekPattern{
 					Month:  0,
 					Day:    0,
 					Reason: fmt.Sprintf("week number %d out of range (1-53)", week),
+					field:  "week",
+					value:  week,
 				}
 			}
 
@@ -49,6 +51,8 @@ var weekPatterns = []*weekPattern{
 					Month:  0,
 					Day:    0,
 					Reason: fmt.Sprintf("week number %d out of range (1-53)", week),
+					field:  "week",
+					value:  week,
 				}
 			}
 
@@ -68,6 +72,8 @@ var weekPatterns = []*weekPattern{
 					Month:  0,
 					Day:    0,
 					Reason: fmt.Sprintf("week number %d out of range (1-53)", week),
+					field:  "week",
+					value:  week,
 				}
 			}
 
@@ -86,6 +92,8 @@ var weekPatterns = []*weekPattern{
 					Month:  0,
 					Day:    0,
 					Reason: fmt.Sprintf("week number %d out of range (1-53)", week),
+					field:  "week",
+					value:  week,
 				}
 			}
 
@@ -94,6 +102,14 @@ var weekPatterns = []*weekPattern{
 			return getDateFromISOWeek(year, week, 1), nil
 		},
 	},
+	// Signed week offset from the current week: "W+1", "W-2", "week +3"
+	{
+		regex: regexp.MustCompile(`(?i)^w(?:eek)?\s*([+-]\d{1,2})$`),
+		parser: func(ctx *parserContext, matches []string) (time.Time, error) {
+			offset, _ := strconv.Atoi(matches[1])
+			return relativeWeekStart(ctx, offset), nil
+		},
+	},
 	// ISO 8601 with weekday: "2024-W15-3" (Wednesday of week 15)
 	{
 		regex: regexp.MustCompile(`^(\d{4})-?W(\d{1,2})-?(\d)$`),
@@ -108,6 +124,8 @@ var weekPatterns = []*weekPattern{
 					Month:  0,
 					Day:    0,
 					Reason: fmt.Sprintf("week number %d out of range (1-53)", week),
+					field:  "week",
+					value:  week,
 				}
 			}
 
@@ -117,6 +135,8 @@ var weekPatterns = []*weekPattern{
 					Month:  0,
 					Day:    0,
 					Reason: fmt.Sprintf("weekday %d out of range (1-7)", weekday),
+					field:  "weekday",
+					value:  weekday,
 				}
 			}
 
@@ -125,6 +145,33 @@ var weekPatterns = []*weekPattern{
 	},
 }
 
+// weekOfPattern matches "week of <date>" and "the week of <date>".
+var weekOfPattern = regexp.MustCompile(`(?i)^(?:the\s+)?week\s+of\s+(.+)$`)
+
+// parseWeekOf resolves "week of March 3" to the first day (per
+// Settings.WeekStartsOn) of the week containing the date, which is parsed
+// by the regular parser chain. It reports ok == false if input is not a
+// "week of" expression or the date cannot be parsed; errors such as an
+// invalid date are returned as-is.
+func parseWeekOf(input string, opts, settings *Settings, cache *regexCache) (result time.Time, ok bool, err error) {
+	matches := weekOfPattern.FindStringSubmatch(strings.TrimSpace(input))
+	if matches == nil {
+		return time.Time{}, false, nil
+	}
+
+	date, err := parseDateInZone(matches[1], opts, cache)
+	if err != nil {
+		if isSpecificError(err) {
+			return time.Time{}, false, err
+		}
+		return time.Time{}, false, nil
+	}
+
+	ctx := &parserContext{settings: settings}
+	start := getStartOfPeriod(date, "week", ctx.weekStart())
+	return applyDefaultTime(ctx, start), true, nil
+}
+
 // getDateFromISOWeek converts an ISO week number to a date.
 // ISO week date: Year, week number (1-53), and weekday (1=Monday, 7=Sunday)
 // Algorithm from ISO 8601 standard.
@@ -152,9 +199,56 @@ func tryParseWeekNumber(ctx *parserContext) (time.Time, error) {
 	for _, pattern := range weekPatterns {
 		matches := pattern.regex.FindStringSubmatch(input)
 		if matches != nil {
-			return pattern.parser(ctx, matches)
+			result, err := pattern.parser(ctx, matches)
+			if err != nil {
+				return time.Time{}, locateError(err, ctx.input, input, pattern.regex)
+			}
+			return result, nil
 		}
 	}
 
+	if result, err := tryParseMultiLangRelativeWeek(ctx, strings.ToLower(input)); err == nil {
+		return result, nil
+	}
+
 	return time.Time{}, fmt.Errorf("no week number pattern matched")
 }
+
+// tryParseMultiLangRelativeWeek parses week offsets written with a localized
+// week word: signed ("semana -2", "Woche +1") or with a this/next/last
+// modifier before or after it ("next week", "semaine prochaine", "来週").
+// The relative parser resolves "next week" to the same week start when the
+// week parser is enabled; this covers the forms it does not know.
+func tryParseMultiLangRelativeWeek(ctx *parserContext, input string) (time.Time, error) {
+	for _, lang := range ctx.languages {
+		terms := lang.RelativeTerms
+		if terms == nil || len(terms.Week) == 0 {
+			continue
+		}
+
+		weekPattern := termAlternation(terms.Week)
+		if matches := ctx.compile(`^(?:` + weekPattern + `)\s*([+-]\d{1,2})$`).FindStringSubmatch(input); matches != nil {
+			offset, _ := strconv.Atoi(matches[1])
+			return relativeWeekStart(ctx, offset), nil
+		}
+
+		directions, modifierPattern := relativeModifiers(terms)
+		pattern := fmt.Sprintf(`^(?:(%s)\s*(?:%s)|(?:%s)\s+(%s))$`, modifierPattern, weekPattern, weekPattern, modifierPattern)
+		if matches := ctx.compile(pattern).FindStringSubmatch(input); matches != nil {
+			modifier := matches[1]
+			if modifier == "" {
+				modifier = matches[2]
+			}
+			return relativeWeekStart(ctx, directions[modifier]), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("no relative week pattern matched")
+}
+
+// relativeWeekStart returns the first day (per Settings.WeekStartsOn) of the
+// week offset weeks away from the week containing RelativeBase.
+func relativeWeekStart(ctx *parserContext, offset int) time.Time {
+	start := getStartOfPeriod(ctx.settings.RelativeBase, "week", ctx.weekStart())
+	return start.AddDate(0, 0, 7*offset)
+}
@@ -0,0 +1,236 @@
+package godateparser
+
+// Preprocessor rewrites input before it is parsed, for normalization the
+// library does not know about, such as expanding "Xmas" to "December 25" or
+// stripping markdown. Settings.Preprocessors applies a chain of them.
+type Preprocessor interface {
+	Process(input string) string
+}
+
+// PreprocessorFunc adapts an ordinary function to the Preprocessor interface.
+type PreprocessorFunc func(input string) string
+
+// Process calls f(input).
+func (f PreprocessorFunc) Process(input string) string {
+	return f(input)
+}
+
+// maxPreprocessEdits bounds the diff aligning a preprocessor's output with
+// its input. Past it, the whole changed span is mapped as one edit.
+const maxPreprocessEdits = 1000
+
+// offsetMap maps byte offsets of preprocessed text back to the text it was
+// produced from: a match starting at offset i starts at starts[i] in the
+// original, and one ending at i ends at ends[i]. A nil offsetMap maps every
+// offset to itself.
+type offsetMap struct {
+	starts, ends []int
+}
+
+// original returns the byte range of the original text that produced
+// text[position:position+length].
+func (m *offsetMap) original(position, length int) (int, int) {
+	if m == nil {
+		return position, length
+	}
+	start := m.starts[position]
+	end := m.ends[position+length]
+	if end < start {
+		end = start
+	}
+	return start, end - start
+}
+
+// preprocess runs input through preprocessors in order.
+func preprocess(input string, preprocessors []Preprocessor) string {
+	for _, p := range preprocessors {
+		input = p.Process(input)
+	}
+	return input
+}
+
+// preprocessWithOffsets runs text through preprocessors in order and
+// returns the result with the map from its offsets back to text. The map
+// is nil when there are no preprocessors.
+func preprocessWithOffsets(text string, preprocessors []Preprocessor) (string, *offsetMap) {
+	var offsets *offsetMap
+	for _, p := range preprocessors {
+		processed := p.Process(text)
+		text, offsets = processed, composeOffsets(offsets, alignOffsets(text, processed))
+	}
+	return text, offsets
+}
+
+// composeOffsets returns the map of step, whose original text was produced
+// by the rewrite earlier maps, back to the text earlier maps to. A nil
+// earlier map leaves step as it is.
+func composeOffsets(earlier, step *offsetMap) *offsetMap {
+	if earlier == nil {
+		return step
+	}
+	for i := range step.starts {
+		step.starts[i] = earlier.starts[step.starts[i]]
+		step.ends[i] = earlier.ends[step.ends[i]]
+	}
+	return step
+}
+
+// alignOffsets maps the offsets of processed back to original. The text
+// the two share, found by a rune-level diff, maps one to one; a span of
+// processed that replaces part of original maps to the whole replaced span,
+// and a match next to deleted text does not take the deleted text in.
+func alignOffsets(original, processed string) *offsetMap {
+	a, b := []rune(original), []rune(processed)
+	aOffsets, bOffsets := runeOffsets(original), runeOffsets(processed)
+
+	m := &offsetMap{
+		starts: make([]int, len(processed)+1),
+		ends:   make([]int, len(processed)+1),
+	}
+	m.starts[len(processed)] = len(original)
+
+	// equal maps n shared runes starting at runes x of a and y of b
+	equal := func(x, y, n int) {
+		for i := 0; i < n; i++ {
+			from, to := bOffsets[y+i], bOffsets[y+i+1]
+			for j := from; j < to; j++ {
+				m.starts[j] = aOffsets[x+i] + j - from
+				m.ends[j+1] = aOffsets[x+i] + j + 1 - from
+			}
+		}
+	}
+	// replace maps runes b[y1:y2] to the span of runes a[x1:x2]
+	replace := func(x1, x2, y1, y2 int) {
+		for j := bOffsets[y1]; j < bOffsets[y2]; j++ {
+			m.starts[j] = aOffsets[x1]
+			m.ends[j+1] = aOffsets[x2]
+		}
+	}
+
+	x, y := 0, 0
+	for _, match := range diffRunes(a, b) {
+		if match[0] > x || match[1] > y {
+			replace(x, match[0], y, match[1])
+		}
+		equal(match[0], match[1], 1)
+		x, y = match[0]+1, match[1]+1
+	}
+	if x < len(a) || y < len(b) {
+		replace(x, len(a), y, len(b))
+	}
+	return m
+}
+
+// runeOffsets returns the byte offset of every rune of s, followed by len(s).
+func runeOffsets(s string) []int {
+	offsets := make([]int, 0, len(s)+1)
+	for i := range s {
+		offsets = append(offsets, i)
+	}
+	return append(offsets, len(s))
+}
+
+// diffRunes returns the pairs of indexes of a and b holding the runes the
+// two have in common, in order, as found by Myers' diff algorithm after
+// trimming the common prefix and suffix. If a and b differ by more than
+// maxPreprocessEdits runes, the part between prefix and suffix is left
+// unmatched.
+func diffRunes(a, b []rune) [][2]int {
+	var matches [][2]int
+
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		matches = append(matches, [2]int{prefix, prefix})
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	middle := myersMatches(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])
+	for _, match := range middle {
+		matches = append(matches, [2]int{match[0] + prefix, match[1] + prefix})
+	}
+
+	for i := suffix; i > 0; i-- {
+		matches = append(matches, [2]int{len(a) - i, len(b) - i})
+	}
+	return matches
+}
+
+// myersMatches returns the pairs of indexes of the runes a and b have in
+// common along a shortest edit script, or nil if that script is longer than
+// maxPreprocessEdits.
+func myersMatches(a, b []rune) [][2]int {
+	n, m := len(a), len(b)
+	if n == 0 || m == 0 {
+		return nil
+	}
+
+	limit := n + m
+	if limit > maxPreprocessEdits {
+		limit = maxPreprocessEdits
+	}
+
+	// v[k+offset] is the furthest x reached on diagonal k; trace keeps the
+	// diagonals -d..d of v as they were before step d
+	offset := limit + 1
+	v := make([]int, 2*limit+3)
+	var trace [][]int
+	found := false
+	for d := 0; d <= limit && !found; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d:offset+d+1]...))
+		for k := -d; k <= d; k += 2 {
+			var x int
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y := x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		return nil
+	}
+
+	// Walk the edit script back from the end, collecting diagonal moves
+	var matches [][2]int
+	x, y := n, m
+	for d := len(trace) - 1; d >= 0; d-- {
+		k := x - y
+		prevX, prevY := 0, 0
+		if d > 0 {
+			prev := trace[d]
+			var prevK int
+			if k == -d || (k != d && prev[k-1+d] < prev[k+1+d]) {
+				prevK = k + 1
+			} else {
+				prevK = k - 1
+			}
+			prevX = prev[prevK+d]
+			prevY = prevX - prevK
+		}
+		for x > prevX && y > prevY {
+			x--
+			y--
+			matches = append(matches, [2]int{x, y})
+		}
+		x, y = prevX, prevY
+	}
+
+	for i, j := 0, len(matches)-1; i < j; i, j = i+1, j-1 {
+		matches[i], matches[j] = matches[j], matches[i]
+	}
+	return matches
+}
@@ -6,12 +6,18 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/coredds/godateparser/translations"
 )
 
 // DateRange represents a parsed date range with start and end dates
 type DateRange struct {
 	Start time.Time
 	End   time.Time
+	// OpenStart and OpenEnd report a side left unbounded by the input
+	// ("until Friday", "since 2020"); the corresponding time is zero.
+	OpenStart bool
+	OpenEnd   bool
 	// MatchedText is the original text that was parsed
 	MatchedText string
 }
@@ -24,6 +30,11 @@ type rangePattern struct {
 
 // Range patterns for Phase 3B
 var rangePatterns = []*rangePattern{
+	// ISO 8601 intervals: "2024-01-01/2024-12-31", "2024-01-01/P1M", "P1M/2024-12-31"
+	{
+		regex:  regexp.MustCompile(`^(\d{4}-\d{2}-\d{2}\S*|P\S*)/(\d{4}-\d{2}-\d{2}\S*|P\S*)$`),
+		parser: parseISOInterval,
+	},
 	// "from X to Y" pattern
 	{
 		regex: regexp.MustCompile(`(?i)^from\s+(.+)\s+to\s+(.+)$`),
@@ -134,69 +145,87 @@ var rangePatterns = []*rangePattern{
 			}, nil
 		},
 	},
-	// "next N days/weeks/months/years" - returns range from now to now+N
-	{
-		regex: regexp.MustCompile(`(?i)^next\s+(\d+)\s+(day|week|month|year)s?$`),
-		parser: func(ctx *parserContext, matches []string) (*DateRange, error) {
-			amount, _ := strconv.Atoi(matches[1])
-			unit := strings.ToLower(matches[2])
-
-			base := ctx.settings.RelativeBase
-			start := base
-			var end time.Time
-
-			switch unit {
-			case "day":
-				end = base.AddDate(0, 0, amount)
-			case "week":
-				end = base.AddDate(0, 0, amount*7)
-			case "month":
-				end = base.AddDate(0, amount, 0)
-			case "year":
-				end = base.AddDate(amount, 0, 0)
-			}
+}
 
-			return &DateRange{
-				Start:       start,
-				End:         end,
-				MatchedText: ctx.input,
-			}, nil
-		},
-	},
-	// "last N days/weeks/months/years" - returns range from now-N to now
-	{
-		regex: regexp.MustCompile(`(?i)^last\s+(\d+)\s+(day|week|month|year)s?$`),
-		parser: func(ctx *parserContext, matches []string) (*DateRange, error) {
-			amount, _ := strconv.Atoi(matches[1])
-			unit := strings.ToLower(matches[2])
-
-			base := ctx.settings.RelativeBase
-			end := base
-			var start time.Time
-
-			switch unit {
-			case "day":
-				start = base.AddDate(0, 0, -amount)
-			case "week":
-				start = base.AddDate(0, 0, -amount*7)
-			case "month":
-				start = base.AddDate(0, -amount, 0)
-			case "year":
-				start = base.AddDate(-amount, 0, 0)
-			}
+// isoIntervalDatePattern matches an ISO 8601 date or date-time used as the
+// endpoint of an interval.
+var isoIntervalDatePattern = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}(?:T\d{2}:\d{2}(?::\d{2}(?:[.,]\d{1,9})?)?(?:Z|[+-]\d{2}:?\d{2})?)?$`)
+
+// isoDurationPattern matches an ISO 8601 duration: "P1M", "P1Y2M10D",
+// "P2W", "PT36H", "P1DT12H30M".
+var isoDurationPattern = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// parseISOInterval parses an ISO 8601 interval written as start/end,
+// start/duration or duration/end, computing the missing endpoint from the
+// duration. Endpoints must be ISO dates so that numeric dates such as
+// "12/31/2024" are not taken for intervals.
+func parseISOInterval(ctx *parserContext, matches []string) (*DateRange, error) {
+	left, right := matches[1], matches[2]
+	leftDuration := isoDurationPattern.FindStringSubmatch(left)
+	rightDuration := isoDurationPattern.FindStringSubmatch(right)
+
+	var start, end time.Time
+	var err error
+	switch {
+	case isoIntervalDatePattern.MatchString(left) && isoIntervalDatePattern.MatchString(right):
+		if start, err = ParseDate(left, ctx.settings); err != nil {
+			return nil, fmt.Errorf("failed to parse start date '%s': %w", left, err)
+		}
+		if end, err = ParseDate(right, ctx.settings); err != nil {
+			return nil, fmt.Errorf("failed to parse end date '%s': %w", right, err)
+		}
+	case isoIntervalDatePattern.MatchString(left) && rightDuration != nil && right != "P" && right != "PT":
+		if start, err = ParseDate(left, ctx.settings); err != nil {
+			return nil, fmt.Errorf("failed to parse start date '%s': %w", left, err)
+		}
+		end = addISODuration(start, rightDuration, 1)
+	case leftDuration != nil && left != "P" && left != "PT" && isoIntervalDatePattern.MatchString(right):
+		if end, err = ParseDate(right, ctx.settings); err != nil {
+			return nil, fmt.Errorf("failed to parse end date '%s': %w", right, err)
+		}
+		start = addISODuration(end, leftDuration, -1)
+	default:
+		return nil, fmt.Errorf("not an ISO 8601 interval")
+	}
 
-			return &DateRange{
-				Start:       start,
-				End:         end,
-				MatchedText: ctx.input,
-			}, nil
-		},
-	},
+	// Ensure start is before end
+	if start.After(end) {
+		return nil, &ErrInvalidDate{
+			Reason: fmt.Sprintf("start date %v is after end date %v", start, end),
+		}
+	}
+
+	return &DateRange{
+		Start:       start,
+		End:         end,
+		MatchedText: ctx.input,
+	}, nil
+}
+
+// addISODuration adds the ISO 8601 duration captured by isoDurationPattern
+// to t, or subtracts it when sign is -1. Years, months, weeks and days
+// follow the calendar, with the day clamped to the end of a shorter month
+// (December 31 minus P1M is November 30); hours, minutes and seconds are exact.
+func addISODuration(t time.Time, parts []string, sign int) time.Time {
+	field := func(i int) int {
+		n, _ := strconv.Atoi(parts[i])
+		return sign * n
+	}
+
+	first := time.Date(t.Year()+field(1), t.Month()+time.Month(field(2)), 1, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	lastDay := first.AddDate(0, 1, -1).Day()
+	day := t.Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	t = first.AddDate(0, 0, day-1+field(3)*7+field(4))
+	return t.Add(time.Duration(field(5))*time.Hour + time.Duration(field(6))*time.Minute + time.Duration(field(7))*time.Second)
 }
 
 // ParseDateRange parses a date range string and returns a DateRange
 func ParseDateRange(input string, opts *Settings) (*DateRange, error) {
-	if input == "" {
+	if isBlank(input) {
 		return nil, &ErrEmptyInput{}
 	}
 
@@ -206,9 +235,23 @@ func ParseDateRange(input string, opts *Settings) (*DateRange, error) {
 
 	settings := normalizeSettings(opts)
 
+	if err := checkInputLength(input, settings); err != nil {
+		return nil, err
+	}
+	input = preprocess(input, opts.Preprocessors)
+	if isBlank(input) {
+		return nil, &ErrEmptyInput{}
+	}
+
+	if err := checkInputLength(input, settings); err != nil {
+		return nil, err
+	}
+
+	input = normalizeCharacters(input, settings)
 	ctx := &parserContext{
-		input:    input,
-		settings: settings,
+		input:     input,
+		settings:  settings,
+		languages: translations.GlobalRegistry.GetMultiple(settings.Languages),
 	}
 
 	// Try each range pattern
@@ -226,6 +269,28 @@ func ParseDateRange(input string, opts *Settings) (*DateRange, error) {
 		}
 	}
 
+	// Windows of N units from now: "next 7 days", "over the last 30 days"
+	if result, ok := parseRelativeWindow(ctx); ok {
+		return result, nil
+	}
+
+	// "this weekend", "next weekend": from the first to the last weekend day
+	if matches := weekendPattern.FindStringSubmatch(strings.TrimSpace(input)); matches != nil {
+		if start, end, ok := weekendSpan(ctx, matches); ok {
+			return &DateRange{Start: start, End: end, MatchedText: ctx.input}, nil
+		}
+	}
+
+	// Fiscal periods: "FY2024", "Q1 FY2024", "fiscal year to date"
+	if period, ok := parseFiscal(ctx); ok {
+		return &DateRange{Start: period.start, End: period.end(settings.RelativeBase), MatchedText: ctx.input}, nil
+	}
+
+	// Single-bounded ranges: "since 2020", "until next Friday"
+	if result, ok := parseOpenRange(ctx); ok {
+		return result, nil
+	}
+
 	// If we had a parse error, return it with context
 	if lastErr != nil {
 		return nil, fmt.Errorf("range parsing failed: %w", lastErr)
@@ -233,8 +298,123 @@ func ParseDateRange(input string, opts *Settings) (*DateRange, error) {
 
 	return nil, &ErrInvalidFormat{
 		Input:      input,
-		Suggestion: "supported range formats: 'from X to Y', 'between X and Y', 'X - Y', 'next N days', 'last N weeks'",
+		Suggestion: "supported range formats: 'from X to Y', 'between X and Y', 'X - Y', 'next N days', 'last N weeks', 'FY2024', 'Q1 FY2024', 'since X', 'until X', 'start/end' (ISO 8601)",
+	}
+}
+
+// parseRelativeWindow parses a window of N units starting or ending at
+// RelativeBase, such as "next 7 days", "in the next 2 weeks", "over the past
+// 30 days" or "les 7 derniers jours", using the localized Next/Last and
+// Window terms. "next" windows run from RelativeBase to RelativeBase+N and
+// "last" windows from RelativeBase-N to RelativeBase. Both bounds are
+// inclusive and are exact instants, not snapped to the start or end of a day.
+func parseRelativeWindow(ctx *parserContext) (*DateRange, bool) {
+	input := strings.ToLower(strings.TrimSpace(ctx.input))
+
+	for _, lang := range ctx.languages {
+		terms := lang.RelativeTerms
+		if terms == nil {
+			continue
+		}
+		units := buildTimeUnitPattern(lang)
+		if units == "" {
+			continue
+		}
+
+		next := append(append([]string(nil), terms.Next...), terms.WindowNext...)
+		last := append(append([]string(nil), terms.Last...), terms.WindowLast...)
+		modifiers := termAlternation(append(append([]string(nil), next...), last...))
+		lead := ""
+		if len(terms.WindowLead) > 0 {
+			lead = `(?:(?:` + termAlternation(terms.WindowLead) + `)\s+)?`
+		}
+
+		// The modifier comes before the amount ("the next 7 days") or after it ("les 7 prochains jours")
+		pattern := fmt.Sprintf(`^%s(?:(%s)\s+(%s)|(%s)\s+(%s))\s+(%s)$`, lead, modifiers, amountPattern, amountPattern, modifiers, units)
+		matches := ctx.compile(pattern).FindStringSubmatch(input)
+		if matches == nil {
+			continue
+		}
+
+		modifier, amountText := matches[1], matches[2]
+		if modifier == "" {
+			amountText, modifier = matches[3], matches[4]
+		}
+		amount, err := parseRelativeAmount(ctx, amountText)
+		if err != nil {
+			continue
+		}
+		if !translations.MatchesRelativeTerm(modifier, next) {
+			amount = -amount
+		}
+
+		bound, err := addRelativeAmount(ctx, amount, normalizeTimeUnit(matches[5], lang))
+		if err != nil {
+			continue
+		}
+		base := ctx.settings.RelativeBase
+		if amount < 0 {
+			return &DateRange{Start: bound, End: base, MatchedText: ctx.input}, true
+		}
+		return &DateRange{Start: base, End: bound, MatchedText: ctx.input}, true
+	}
+
+	return nil, false
+}
+
+// parseOpenRange parses a range bounded on one side only, using the localized
+// Since/Until keywords written before ("since 2020") or after ("2020年以来") the date.
+// The bounded side is parsed through the normal ParseDate chain.
+func parseOpenRange(ctx *parserContext) (*DateRange, bool) {
+	input := strings.TrimSpace(ctx.input)
+	lower := strings.ToLower(input)
+
+	for _, lang := range ctx.languages {
+		if lang.RelativeTerms == nil {
+			continue
+		}
+
+		for _, term := range lang.RelativeTerms.Since {
+			if date, ok := parseBoundedSide(ctx, input, lower, term); ok {
+				return &DateRange{Start: date, OpenEnd: true, MatchedText: ctx.input}, true
+			}
+		}
+
+		for _, term := range lang.RelativeTerms.Until {
+			if date, ok := parseBoundedSide(ctx, input, lower, term); ok {
+				return &DateRange{End: date, OpenStart: true, MatchedText: ctx.input}, true
+			}
+		}
 	}
+
+	return nil, false
+}
+
+// parseBoundedSide strips keyword from the start (followed by whitespace) or
+// the end of input and parses what remains as a date.
+func parseBoundedSide(ctx *parserContext, input, lower, keyword string) (time.Time, bool) {
+	keyword = strings.ToLower(keyword)
+
+	var rest string
+	switch {
+	case strings.HasPrefix(lower, keyword+" "):
+		rest = input[len(keyword):]
+	case strings.HasSuffix(lower, keyword) && len(lower) > len(keyword):
+		rest = input[:len(input)-len(keyword)]
+	default:
+		return time.Time{}, false
+	}
+
+	rest = strings.TrimSpace(rest)
+	if rest == "" {
+		return time.Time{}, false
+	}
+
+	date, err := ParseDate(rest, ctx.settings)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return date, true
 }
 
 // GetDatesInRange returns all dates between start and end (inclusive) with the given step
@@ -349,3 +529,111 @@ func splitRangeOnKeyword(input, startKeyword, separatorKeyword string, settings
 
 	return "", "", fmt.Errorf("could not find valid date split")
 }
+
+// rangeTimePattern matches one side of a time range: "9", "9:30", "9am",
+// "9:30 PM", "14:00", "noon".
+const rangeTimePattern = `(\d{1,2}(?::\d{2}(?::\d{2})?)?)\s*(am|pm)?|(noon|midnight)`
+
+// timeRangePattern matches a whole time range: "9am-5pm", "from 2 to 4 PM",
+// "14:00–16:30", "between 9 and 11am".
+var timeRangePattern = regexp.MustCompile(`(?i)^(?:from\s+|between\s+)?(?:` + rangeTimePattern + `)(?:\s*[-–—]\s*|\s+(?:to|until|till|through|and)\s+)(?:` + rangeTimePattern + `)$`)
+
+// ParseTimeRange parses a range of times of day such as "9am-5pm",
+// "from 2 to 4 PM" or "14:00–16:30". Both times fall on the day of
+// Settings.RelativeBase (today if zero). A side written without AM/PM takes
+// the other side's ("9-5pm" is 9am to 5pm, "2 to 4 PM" is 2pm to 4pm), or
+// the opposite one if that would put the start after the end. A range whose
+// end is earlier than its start crosses midnight: "11pm-2am" ends at 2am the
+// next day.
+func ParseTimeRange(input string, opts *Settings) (start, end time.Time, err error) {
+	if isBlank(input) {
+		return time.Time{}, time.Time{}, &ErrEmptyInput{}
+	}
+
+	if opts == nil {
+		opts = DefaultSettings()
+	}
+
+	settings := normalizeSettings(opts)
+
+	if err := checkInputLength(input, settings); err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	matches := timeRangePattern.FindStringSubmatch(strings.TrimSpace(normalizeCharacters(input, settings)))
+	if matches == nil {
+		return time.Time{}, time.Time{}, &ErrInvalidFormat{
+			Input:      input,
+			Suggestion: "supported time range formats: '9am-5pm', '9-5pm', 'from 2 to 4 PM', '14:00-16:30'",
+		}
+	}
+
+	ctx := &parserContext{
+		settings:  settings,
+		languages: translations.GlobalRegistry.GetMultiple(settings.Languages),
+	}
+
+	startClock, startMeridiem, startWord := matches[1], strings.ToLower(matches[2]), matches[3]
+	endClock, endMeridiem, endWord := matches[4], strings.ToLower(matches[5]), matches[6]
+
+	// Borrow the meridiem of the other side when one is missing
+	startGuess, endGuess := startMeridiem, endMeridiem
+	if startWord == "" && endWord == "" {
+		if startMeridiem == "" {
+			startGuess = endMeridiem
+		}
+		if endMeridiem == "" {
+			endGuess = startMeridiem
+		}
+	}
+
+	start, err = parseRangeTime(ctx, startClock+startGuess, startWord)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse start time '%s': %w", startClock+startMeridiem+startWord, err)
+	}
+	end, err = parseRangeTime(ctx, endClock+endGuess, endWord)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("failed to parse end time '%s': %w", endClock+endMeridiem+endWord, err)
+	}
+
+	// A borrowed meridiem that puts the start after the end was the wrong
+	// guess: "11-2pm" is 11am to 2pm, "9am-5" is 9am to 5pm
+	if start.After(end) {
+		switch {
+		case startMeridiem == "" && startGuess != "":
+			if flipped, err := parseRangeTime(ctx, startClock+oppositeMeridiem(startGuess), ""); err == nil && !flipped.After(end) {
+				start = flipped
+			}
+		case endMeridiem == "" && endGuess != "":
+			if flipped, err := parseRangeTime(ctx, endClock+oppositeMeridiem(endGuess), ""); err == nil && !start.After(flipped) {
+				end = flipped
+			}
+		}
+	}
+
+	// Ranges ending before they start run past midnight
+	if end.Before(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+
+	return start, end, nil
+}
+
+// parseRangeTime parses one side of a time range on the base date: word if
+// set ("noon"), otherwise clock with its meridiem ("9:30pm", "14:00").
+func parseRangeTime(ctx *parserContext, clock, word string) (time.Time, error) {
+	sub := *ctx
+	sub.input = clock
+	if word != "" {
+		sub.input = word
+	}
+	return tryParseTime(&sub)
+}
+
+// oppositeMeridiem returns "pm" for "am" and "am" for "pm".
+func oppositeMeridiem(meridiem string) string {
+	if meridiem == "am" {
+		return "pm"
+	}
+	return "am"
+}
@@ -238,6 +238,290 @@ func TestParseRange_LastDuration(t *testing.T) {
 	}
 }
 
+func TestParseRange_Window(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input     string
+		languages []string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"in the next 7 days", nil, base, time.Date(2024, 10, 22, 12, 0, 0, 0, time.UTC)},
+		{"over the next 2 weeks", nil, base, time.Date(2024, 10, 29, 12, 0, 0, 0, time.UTC)},
+		{"the coming 48 hours", nil, base, time.Date(2024, 10, 17, 12, 0, 0, 0, time.UTC)},
+		{"over the last 30 days", nil, time.Date(2024, 9, 15, 12, 0, 0, 0, time.UTC), base},
+		{"in the past 3 months", nil, time.Date(2024, 7, 15, 12, 0, 0, 0, time.UTC), base},
+		{"during the previous 2 years", nil, time.Date(2022, 10, 15, 12, 0, 0, 0, time.UTC), base},
+		{"en los próximos 7 días", []string{"es"}, base, time.Date(2024, 10, 22, 12, 0, 0, 0, time.UTC)},
+		{"los últimos 30 días", []string{"es"}, time.Date(2024, 9, 15, 12, 0, 0, 0, time.UTC), base},
+		{"les 7 derniers jours", []string{"fr"}, time.Date(2024, 10, 8, 12, 0, 0, 0, time.UTC), base},
+		{"in den nächsten 2 Wochen", []string{"de"}, base, time.Date(2024, 10, 29, 12, 0, 0, 0, time.UTC)},
+		{"за последние 7 дней", []string{"ru"}, time.Date(2024, 10, 8, 12, 0, 0, 0, time.UTC), base},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDateRange(tt.input, &Settings{RelativeBase: base, Languages: tt.languages})
+			if err != nil {
+				t.Fatalf("ParseDateRange(%q) error = %v", tt.input, err)
+			}
+			if !result.Start.Equal(tt.wantStart) || !result.End.Equal(tt.wantEnd) {
+				t.Errorf("ParseDateRange(%q) = [%v, %v], want [%v, %v]", tt.input, result.Start, result.End, tt.wantStart, tt.wantEnd)
+			}
+			if result.OpenStart || result.OpenEnd {
+				t.Errorf("ParseDateRange(%q) is open-ended, want both bounds set", tt.input)
+			}
+		})
+	}
+
+	t.Run("lead word alone is not a window", func(t *testing.T) {
+		if result, err := ParseDateRange("in the 7 days", &Settings{RelativeBase: base}); err == nil {
+			t.Errorf("ParseDateRange(\"in the 7 days\") = %+v, want error", result)
+		}
+	})
+}
+
+// ============================================================================
+// OPEN-ENDED RANGE TESTS
+// ============================================================================
+
+func TestParseRange_OpenEnded(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC) // Tuesday
+
+	tests := []struct {
+		input     string
+		lang      string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"since 2020", "en", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+		{"after 2024-03-01", "en", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+		{"until next Friday", "en", time.Time{}, time.Date(2024, 10, 18, 12, 0, 0, 0, time.UTC)},
+		{"before December 31, 2024", "en", time.Time{}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"desde 2020", "es", time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), time.Time{}},
+		{"hasta 2024-12-31", "es", time.Time{}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"bis 2024-12-31", "de", time.Time{}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"2024-12-31まで", "ja", time.Time{}, time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, Languages: []string{tt.lang}}
+			result, err := ParseDateRange(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDateRange(%q) error = %v", tt.input, err)
+			}
+
+			if result.OpenStart != tt.wantStart.IsZero() || result.OpenEnd != tt.wantEnd.IsZero() {
+				t.Errorf("ParseDateRange(%q) open = (%v, %v), want (%v, %v)",
+					tt.input, result.OpenStart, result.OpenEnd, tt.wantStart.IsZero(), tt.wantEnd.IsZero())
+			}
+			if !result.Start.Equal(tt.wantStart) {
+				t.Errorf("ParseDateRange(%q) start = %v, want %v", tt.input, result.Start, tt.wantStart)
+			}
+			if !result.End.Equal(tt.wantEnd) {
+				t.Errorf("ParseDateRange(%q) end = %v, want %v", tt.input, result.End, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestParseRange_PeriodToDate(t *testing.T) {
+	base := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input     string
+		base      time.Time
+		wantStart time.Time
+	}{
+		{"YTD", base, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"ytd", base, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"year to date", base, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"QTD", base, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"quarter-to-date", base, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"MTD", base, time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"month to date", base, time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		// On the first day of a quarter the range starts that same day
+		{"QTD", time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"QTD", time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input+" "+tt.base.Format("2006-01-02"), func(t *testing.T) {
+			result, err := ParseDateRange(tt.input, &Settings{RelativeBase: tt.base})
+			if err != nil {
+				t.Fatalf("ParseDateRange(%q) error = %v", tt.input, err)
+			}
+			if !result.Start.Equal(tt.wantStart) {
+				t.Errorf("ParseDateRange(%q) start = %v, want %v", tt.input, result.Start, tt.wantStart)
+			}
+			if !result.End.Equal(tt.base) {
+				t.Errorf("ParseDateRange(%q) end = %v, want %v", tt.input, result.End, tt.base)
+			}
+		})
+	}
+
+	t.Run("calendar regardless of fiscal year", func(t *testing.T) {
+		settings := &Settings{RelativeBase: base, FiscalCalendar: &FiscalCalendar{StartMonth: time.October}}
+		result, err := ParseDateRange("YTD", settings)
+		if err != nil {
+			t.Fatalf("ParseDateRange(\"YTD\") error = %v", err)
+		}
+		if want := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); !result.Start.Equal(want) {
+			t.Errorf("ParseDateRange(\"YTD\") start = %v, want %v", result.Start, want)
+		}
+	})
+}
+
+func TestParseRange_Fiscal(t *testing.T) {
+	base := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+	settings := &Settings{RelativeBase: base, FiscalCalendar: &FiscalCalendar{StartMonth: time.October}}
+
+	tests := []struct {
+		input     string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"FY2024", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 9, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"Q1 FY2024", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{"Q4 FY2024", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 9, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"H1 FY2025", time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2025, 3, 31, 23, 59, 59, 999999999, time.UTC)},
+		{"last fiscal quarter", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 9, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"fiscal year to date", time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC), base},
+		{"from Q1 FY2024 to Q3 FY2024", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDateRange(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDateRange(%q) error = %v", tt.input, err)
+			}
+			if !result.Start.Equal(tt.wantStart) {
+				t.Errorf("ParseDateRange(%q) start = %v, want %v", tt.input, result.Start, tt.wantStart)
+			}
+			if !result.End.Equal(tt.wantEnd) {
+				t.Errorf("ParseDateRange(%q) end = %v, want %v", tt.input, result.End, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestParseRange_OpenEndedMatchesParseDate(t *testing.T) {
+	settings := &Settings{RelativeBase: time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)}
+
+	for _, side := range []string{"March", "tomorrow", "3 days ago"} {
+		want, err := ParseDate(side, settings)
+		if err != nil {
+			t.Fatalf("ParseDate(%q) error = %v", side, err)
+		}
+		result, err := ParseDateRange("since "+side, settings)
+		if err != nil {
+			t.Fatalf("ParseDateRange(%q) error = %v", "since "+side, err)
+		}
+		if !result.Start.Equal(want) || !result.OpenEnd {
+			t.Errorf("ParseDateRange(%q) = %+v, want open-ended from %v", "since "+side, result, want)
+		}
+	}
+}
+
+func TestParseRange_ISOInterval(t *testing.T) {
+	settings := &Settings{RelativeBase: time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		input     string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		// start/end
+		{"2024-01-01/2024-12-31", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"2024-01-01T09:00:00Z/2024-01-01T17:30:00Z", time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 17, 30, 0, 0, time.UTC)},
+		// start/duration
+		{"2024-01-01/P1M", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"2024-01-31/P1M", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"2024-01-01/P2W", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"2024-01-01T10:00:00Z/PT2H30M", time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 12, 30, 0, 0, time.UTC)},
+		// duration/end
+		{"P1M/2024-12-31", time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+		{"P1Y2M10DT2H30M/2024-12-31", time.Date(2023, 10, 20, 21, 30, 0, 0, time.UTC), time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDateRange(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDateRange(%q) error = %v", tt.input, err)
+			}
+			if !result.Start.Equal(tt.wantStart) {
+				t.Errorf("ParseDateRange(%q) start = %v, want %v", tt.input, result.Start, tt.wantStart)
+			}
+			if !result.End.Equal(tt.wantEnd) {
+				t.Errorf("ParseDateRange(%q) end = %v, want %v", tt.input, result.End, tt.wantEnd)
+			}
+		})
+	}
+
+	for _, input := range []string{"2024-12-31/2024-01-01", "P1M/P2M", "2024-01-01/P", "2024-01-01/2024-02-30"} {
+		t.Run("invalid/"+input, func(t *testing.T) {
+			if _, err := ParseDateRange(input, settings); err == nil {
+				t.Errorf("ParseDateRange(%q) expected error, got nil", input)
+			}
+		})
+	}
+}
+
+func TestParseTimeRange(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 10, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		input     string
+		wantStart time.Time
+		wantEnd   time.Time
+	}{
+		{"9am-5pm", at(15, 9, 0), at(15, 17, 0)},
+		{"9am to 5pm", at(15, 9, 0), at(15, 17, 0)},
+		{"14:00–16:30", at(15, 14, 0), at(15, 16, 30)},
+		{"10:30 pm - 1:15 am", at(15, 22, 30), at(16, 1, 15)},
+		{"noon-2pm", at(15, 12, 0), at(15, 14, 0)},
+		// Meridiem inferred from the other side
+		{"9-5pm", at(15, 9, 0), at(15, 17, 0)},
+		{"from 2 to 4 PM", at(15, 14, 0), at(15, 16, 0)},
+		{"between 9 and 11am", at(15, 9, 0), at(15, 11, 0)},
+		{"11-2pm", at(15, 11, 0), at(15, 14, 0)},
+		{"9am-5", at(15, 9, 0), at(15, 17, 0)},
+		// Crossing midnight
+		{"11pm-2am", at(15, 23, 0), at(16, 2, 0)},
+		{"22:00-06:00", at(15, 22, 0), at(16, 6, 0)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			start, end, err := ParseTimeRange(tt.input, &Settings{RelativeBase: base})
+			if err != nil {
+				t.Fatalf("ParseTimeRange(%q) error = %v", tt.input, err)
+			}
+			if !start.Equal(tt.wantStart) {
+				t.Errorf("ParseTimeRange(%q) start = %v, want %v", tt.input, start, tt.wantStart)
+			}
+			if !end.Equal(tt.wantEnd) {
+				t.Errorf("ParseTimeRange(%q) end = %v, want %v", tt.input, end, tt.wantEnd)
+			}
+		})
+	}
+
+	for _, input := range []string{"9-5", "25:00-26:00", "next week", ""} {
+		t.Run("invalid/"+input, func(t *testing.T) {
+			if _, _, err := ParseTimeRange(input, &Settings{RelativeBase: base}); err == nil {
+				t.Errorf("ParseTimeRange(%q) expected error, got nil", input)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // HELPER FUNCTION TESTS
 // ============================================================================
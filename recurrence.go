@@ -0,0 +1,549 @@
+package godateparser
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/coredds/godateparser/translations"
+)
+
+// Recurrence patterns (English; weekly schedules are also localized)
+// Examples: "every Monday", "every 2 weeks", "daily at 9am", "first of each month"
+
+var (
+	// "... at 9am", "... at 14:30": the time of day of each occurrence
+	recurrenceTimePattern = regexp.MustCompile(`(?i)^(.+?)\s+at\s+(.+)$`)
+
+	// "daily", "weekly", "every day", "each week"
+	recurrenceAdverbPattern = regexp.MustCompile(`(?i)^(hourly|daily|weekly|biweekly|bi-weekly|fortnightly|monthly|quarterly|yearly|annually)$`)
+
+	// "every 2 weeks", "every other day", "every three months"
+	recurrenceIntervalPattern = regexp.MustCompile(`(?i)^(?:every|each)\s+(?:(other)\s+|(\d+|[a-z]+(?:-[a-z]+)?)\s+)?(minute|hour|day|week|fortnight|month|quarter|year|decade)s?$`)
+
+	// "every business day", "each working day"
+	recurrenceBusinessDayPattern = regexp.MustCompile(`(?i)^(?:every|each)\s+(?:business|working)\s+day$`)
+
+	// "every Monday", "every other Friday", "every third Friday", "on
+	// Mondays and Thursdays", "every Mon, Wed and Fri", "every weekday",
+	// "biweekly on Tuesdays"
+	recurrenceWeekdayPattern = regexp.MustCompile(`(?i)^(?:(?:every|each|on)\s+(?:(other|alternate)\s+|(\d+(?:st|nd|rd|th)|second|third|fourth|fifth|sixth|seventh|eighth|ninth|tenth)\s+)?|(biweekly|bi-weekly|fortnightly)\s+on\s+)([a-z]+(?:\s*(?:,|and|&)\s*[a-z]+)*)$`)
+
+	// Separators of a list of weekdays: "mon, wed and fri"
+	recurrenceListPattern = regexp.MustCompile(`\s*(?:,|\band\b|&)\s*`)
+
+	// "first of each month", "the 15th of every month", "last day of the month",
+	// "every month on the 1st"
+	recurrenceMonthDayPattern = regexp.MustCompile(`(?i)^(?:on\s+)?(?:the\s+)?(first|last|\d{1,2}(?:st|nd|rd|th)?)(?:\s+day)?\s+of\s+(?:each|every|the)\s+month$`)
+	recurrenceMonthOnPattern  = regexp.MustCompile(`(?i)^(?:every|each)\s+month\s+on\s+the\s+(first|last|\d{1,2}(?:st|nd|rd|th)?)(?:\s+day)?$`)
+)
+
+// recurrenceFrequencies maps the adverbs and units of recurrence expressions
+// to a frequency and the number of its periods they stand for.
+var recurrenceFrequencies = map[string]struct {
+	frequency string
+	periods   int
+}{
+	"minute":      {"minutely", 1},
+	"hour":        {"hourly", 1},
+	"hourly":      {"hourly", 1},
+	"day":         {"daily", 1},
+	"daily":       {"daily", 1},
+	"week":        {"weekly", 1},
+	"weekly":      {"weekly", 1},
+	"biweekly":    {"weekly", 2},
+	"bi-weekly":   {"weekly", 2},
+	"fortnight":   {"weekly", 2},
+	"fortnightly": {"weekly", 2},
+	"month":       {"monthly", 1},
+	"monthly":     {"monthly", 1},
+	"quarter":     {"monthly", 3},
+	"quarterly":   {"monthly", 3},
+	"year":        {"yearly", 1},
+	"yearly":      {"yearly", 1},
+	"annually":    {"yearly", 1},
+	"decade":      {"yearly", 10},
+}
+
+// recurrenceOrdinals maps the ordinals of "every third Friday" to the
+// number of weeks between occurrences.
+var recurrenceOrdinals = map[string]int{
+	"second": 2, "third": 3, "fourth": 4, "fifth": 5,
+	"sixth": 6, "seventh": 7, "eighth": 8, "ninth": 9, "tenth": 10,
+}
+
+// maxRecurrencePeriods bounds the periods Occurrences scans without finding
+// an occurrence, for rules such as the 31st of every 12th month starting in
+// February, which never occur.
+const maxRecurrencePeriods = 10000
+
+// Recurrence is a repeating schedule parsed from an expression such as
+// "every Monday at 9am", modeled on an iCalendar RRULE.
+type Recurrence struct {
+	// Frequency is "minutely", "hourly", "daily", "weekly", "monthly" or
+	// "yearly", and the schedule repeats every Interval such periods.
+	Frequency string
+	Interval  int
+
+	// ByDay lists the weekdays of a weekly schedule, in week order from
+	// Monday. If empty, a weekly schedule repeats on Start's weekday.
+	ByDay []time.Weekday
+
+	// ByMonthDay is the day of the month of a monthly schedule, or -1 for
+	// the last day. If zero, a monthly schedule repeats on Start's day.
+	// Months without that day are skipped.
+	ByMonthDay int
+
+	// TimeOfDay is the time of each occurrence, as an offset from
+	// midnight, when HasTime is set ("daily at 9am"). Daily and longer
+	// schedules without a time occur at midnight.
+	TimeOfDay time.Duration
+	HasTime   bool
+
+	// Start anchors the schedule: no occurrence precedes it, and Interval
+	// counts periods from the first occurrence at or after it: from a
+	// Wednesday, "every other Tuesday" first occurs the next Tuesday. It is
+	// RelativeBase.
+	Start time.Time
+}
+
+// ParseRecurrence parses a recurrence expression such as "every Monday",
+// "every other Tuesday", "every 2 weeks", "daily at 9am" or "first of each
+// month". Expressions are English, except that weekly schedules ("every
+// other Tuesday", "cada dos martes", "biweekly", "隔週火曜日") are also
+// recognized in the languages of Settings.Languages.
+// If opts is nil, DefaultSettings() is used.
+func ParseRecurrence(input string, opts *Settings) (Recurrence, error) {
+	if isBlank(input) {
+		return Recurrence{}, &ErrEmptyInput{}
+	}
+
+	if opts == nil {
+		opts = DefaultSettings()
+	}
+
+	settings := normalizeSettings(opts)
+
+	if err := checkInputLength(input, settings); err != nil {
+		return Recurrence{}, err
+	}
+
+	// English schedules are always recognized
+	languages := translations.GlobalRegistry.GetMultiple(settings.Languages)
+	if !slices.Contains(settings.Languages, "en") {
+		languages = append(languages, translations.GetLanguage("en"))
+	}
+	ctx := &parserContext{
+		input:     input,
+		settings:  settings,
+		languages: languages,
+	}
+	rule, err := parseRecurrence(ctx, strings.ToLower(strings.TrimSpace(normalizeCharacters(input, settings))))
+	if err != nil {
+		return Recurrence{}, withErrorInput(err, input)
+	}
+	return rule, nil
+}
+
+// parseRecurrence parses the schedule in input, with an optional trailing
+// time of day.
+func parseRecurrence(ctx *parserContext, input string) (Recurrence, error) {
+	base := ctx.settings.RelativeBase
+	rule := Recurrence{Interval: 1}
+
+	if matches := recurrenceTimePattern.FindStringSubmatch(input); matches != nil {
+		timeCtx := &parserContext{input: matches[2], settings: ctx.settings, languages: ctx.languages}
+		at, err := tryParseTime(timeCtx)
+		if err != nil {
+			return Recurrence{}, &ErrInvalidFormat{Input: input, Suggestion: "write the time of day as in 'every day at 9am'"}
+		}
+		rule.TimeOfDay = time.Duration(at.Hour())*time.Hour + time.Duration(at.Minute())*time.Minute + time.Duration(at.Second())*time.Second
+		rule.HasTime = true
+		input = matches[1]
+	}
+
+	if !parseRecurrenceSchedule(ctx, input, &rule) {
+		return Recurrence{}, &ErrInvalidFormat{
+			Input:      input,
+			Suggestion: "supported recurrences: 'every Monday', 'every 2 weeks', 'daily at 9am', 'first of each month'",
+		}
+	}
+
+	if rule.HasTime && (rule.Frequency == "minutely" || rule.Frequency == "hourly") {
+		return Recurrence{}, &ErrInvalidFormat{Input: input, Suggestion: "a time of day applies to daily and longer recurrences"}
+	}
+	rule.Start = base
+	return rule, nil
+}
+
+// parseRecurrenceSchedule fills in the frequency, interval and days of rule
+// from input, reporting whether input is a recurrence.
+func parseRecurrenceSchedule(ctx *parserContext, input string, rule *Recurrence) bool {
+	if matches := recurrenceAdverbPattern.FindStringSubmatch(input); matches != nil {
+		freq := recurrenceFrequencies[matches[1]]
+		rule.Frequency, rule.Interval = freq.frequency, freq.periods
+		return true
+	}
+
+	if recurrenceBusinessDayPattern.MatchString(input) {
+		rule.Frequency = "weekly"
+		rule.ByDay = []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}
+		return true
+	}
+
+	if matches := recurrenceIntervalPattern.FindStringSubmatch(input); matches != nil {
+		interval := 1
+		switch {
+		case matches[1] != "":
+			interval = 2
+		case matches[2] != "":
+			n, ok := parseRecurrenceInterval(ctx, matches[2])
+			if !ok {
+				return false
+			}
+			interval = n
+		}
+
+		freq := recurrenceFrequencies[matches[3]]
+		rule.Frequency, rule.Interval = freq.frequency, interval*freq.periods
+		return true
+	}
+
+	if matches := recurrenceWeekdayPattern.FindStringSubmatch(input); matches != nil {
+		days, ok := parseRecurrenceWeekdays(ctx, matches[4])
+		if !ok {
+			return false
+		}
+		rule.Frequency, rule.ByDay = "weekly", days
+		switch {
+		case matches[1] != "", matches[3] != "":
+			rule.Interval = 2
+		case matches[2] != "":
+			interval, ok := recurrenceOrdinals[matches[2]]
+			if !ok {
+				interval, _ = strconv.Atoi(strings.TrimRight(matches[2], "stndrh"))
+			}
+			if interval <= 0 {
+				return false
+			}
+			rule.Interval = interval
+		}
+		return true
+	}
+
+	for _, pattern := range []*regexp.Regexp{recurrenceMonthDayPattern, recurrenceMonthOnPattern} {
+		if matches := pattern.FindStringSubmatch(input); matches != nil {
+			day, ok := parseRecurrenceMonthDay(matches[1])
+			if !ok {
+				return false
+			}
+			rule.Frequency, rule.ByMonthDay = "monthly", day
+			return true
+		}
+	}
+
+	return parseLocalizedWeeklySchedule(ctx, input, rule)
+}
+
+// parseLocalizedWeeklySchedule parses a weekly schedule written with the
+// Every, Alternate and Biweekly terms of the active languages: "cada martes",
+// "jeden zweiten Dienstag", "quincenal", "隔週火曜日".
+func parseLocalizedWeeklySchedule(ctx *parserContext, input string, rule *Recurrence) bool {
+	for _, lang := range ctx.languages {
+		terms := lang.RelativeTerms
+		if terms == nil {
+			continue
+		}
+		langCtx := &parserContext{settings: ctx.settings, languages: []*translations.Language{lang}}
+
+		if translations.MatchesRelativeTerm(input, terms.Biweekly) {
+			rule.Frequency, rule.Interval = "weekly", 2
+			return true
+		}
+		if rest, ok := cutRecurrenceTerm(input, terms.Biweekly); ok {
+			if days, ok := parseRecurrenceWeekdays(langCtx, rest); ok {
+				rule.Frequency, rule.Interval, rule.ByDay = "weekly", 2, days
+				return true
+			}
+		}
+
+		rest, ok := cutRecurrenceTerm(input, terms.Every)
+		if !ok {
+			continue
+		}
+		interval := 1
+		if after, ok := cutRecurrenceTerm(rest, terms.Alternate); ok {
+			rest, interval = after, 2
+		}
+		if days, ok := parseRecurrenceWeekdays(langCtx, rest); ok {
+			rule.Frequency, rule.Interval, rule.ByDay = "weekly", interval, days
+			return true
+		}
+	}
+	return false
+}
+
+// cutRecurrenceTerm removes the longest of terms that leads input, as a
+// whole word unless the term is written in a script without spaces, and
+// returns the rest.
+func cutRecurrenceTerm(input string, terms []string) (string, bool) {
+	best := ""
+	for _, term := range terms {
+		term = strings.ToLower(term)
+		rest, found := strings.CutPrefix(input, term)
+		if !found || rest == "" || len(term) <= len(best) {
+			continue
+		}
+		last, _ := utf8.DecodeLastRuneInString(term)
+		next, _ := utf8.DecodeRuneInString(rest)
+		if isAlphabetic(last) && !unicode.IsSpace(next) {
+			continue
+		}
+		best = term
+	}
+	if best == "" {
+		return input, false
+	}
+	return strings.TrimSpace(input[len(best):]), true
+}
+
+// parseRecurrenceInterval parses the number of periods in "every 2 weeks"
+// or "every three months".
+func parseRecurrenceInterval(ctx *parserContext, s string) (int, bool) {
+	if n, err := strconv.Atoi(s); err == nil {
+		return n, n > 0
+	}
+	for _, lang := range ctx.languages {
+		if n, ok := translations.ParseNumberWord(s, lang); ok && n > 0 {
+			return n, true
+		}
+	}
+	return 0, false
+}
+
+// parseRecurrenceWeekdays parses a list of weekday names ("monday",
+// "mon, wed and fri", "mondays"), or "weekday" or "weekend", and returns
+// the days in week order from Monday.
+func parseRecurrenceWeekdays(ctx *parserContext, list string) ([]time.Weekday, bool) {
+	switch list {
+	case "weekday", "weekdays":
+		return []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}, true
+	case "weekend", "weekends":
+		return []time.Weekday{time.Saturday, time.Sunday}, true
+	}
+
+	seen := make(map[time.Weekday]bool)
+	var days []time.Weekday
+	for _, name := range recurrenceListPattern.Split(list, -1) {
+		day, ok := translations.ParseWeekday(name, ctx.languages...)
+		if !ok {
+			// Plurals: "mondays"
+			day, ok = translations.ParseWeekday(strings.TrimSuffix(name, "s"), ctx.languages...)
+		}
+		if !ok {
+			return nil, false
+		}
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		return weekdayOffset(days[i]) < weekdayOffset(days[j])
+	})
+	return days, true
+}
+
+// parseRecurrenceMonthDay parses "first", "last" or an ordinal such as
+// "15th" as a day of the month, -1 standing for the last day.
+func parseRecurrenceMonthDay(s string) (int, bool) {
+	switch s {
+	case "first":
+		return 1, true
+	case "last":
+		return -1, true
+	}
+	day, err := strconv.Atoi(strings.TrimRight(s, "stndrh"))
+	return day, err == nil && day >= 1 && day <= 31
+}
+
+// weekdayOffset returns the number of days from Monday to day.
+func weekdayOffset(day time.Weekday) int {
+	return (int(day) + 6) % 7
+}
+
+// Occurrences returns the first n occurrences of the schedule strictly
+// after after, and never before Start. It returns fewer than n if the
+// schedule runs out of representable dates or does not occur at all.
+func (r Recurrence) Occurrences(after time.Time, n int) []time.Time {
+	if n <= 0 || r.Interval <= 0 {
+		return nil
+	}
+
+	var occurrences []time.Time
+	add := func(t time.Time) bool {
+		if t.After(after) && !t.Before(r.Start) {
+			occurrences = append(occurrences, t)
+		}
+		return len(occurrences) >= n
+	}
+
+	switch r.Frequency {
+	case "minutely", "hourly":
+		step := time.Duration(r.Interval) * time.Minute
+		if r.Frequency == "hourly" {
+			step = time.Duration(r.Interval) * time.Hour
+		}
+		t := r.Start
+		if after.After(t) {
+			t = t.Add(after.Sub(t) / step * step)
+		}
+		for !add(t) {
+			t = t.Add(step)
+		}
+		return occurrences
+	}
+
+	// Skip the periods that end before after, keeping the interval's phase
+	first := r.firstPeriod()
+	if gap := r.periodsBetween(after); gap-first > 1 {
+		first += (gap - 1 - first) / r.Interval * r.Interval
+	}
+
+	misses := 0
+	for period := first; misses < maxRecurrencePeriods; period += r.Interval {
+		found := len(occurrences)
+		for _, t := range r.periodOccurrences(period) {
+			if add(t) {
+				return occurrences
+			}
+		}
+		if len(occurrences) == found {
+			misses++
+		} else {
+			misses = 0
+		}
+	}
+	return occurrences
+}
+
+// firstPeriod returns the period the interval counts from: Start's own
+// period, or the next one when every occurrence in Start's period falls
+// before Start.
+func (r Recurrence) firstPeriod() int {
+	times := r.periodOccurrences(0)
+	for _, t := range times {
+		if !t.Before(r.Start) {
+			return 0
+		}
+	}
+	if len(times) == 0 {
+		return 0
+	}
+	return 1
+}
+
+// periodsBetween returns the number of whole periods of the frequency from
+// Start to t, or 0 if t is before Start.
+func (r Recurrence) periodsBetween(t time.Time) int {
+	if !t.After(r.Start) {
+		return 0
+	}
+	t = t.In(r.Start.Location())
+	switch r.Frequency {
+	case "daily":
+		return int(t.Sub(r.Start).Hours() / 24)
+	case "weekly":
+		return int(t.Sub(r.Start).Hours() / (24 * 7))
+	case "monthly":
+		return (t.Year()-r.Start.Year())*12 + int(t.Month()) - int(r.Start.Month())
+	case "yearly":
+		return t.Year() - r.Start.Year()
+	}
+	return 0
+}
+
+// periodOccurrences returns the occurrences in the given period after
+// Start, in order.
+func (r Recurrence) periodOccurrences(period int) []time.Time {
+	start := r.Start
+	hour, minute, second := int(r.TimeOfDay/time.Hour), int(r.TimeOfDay/time.Minute)%60, int(r.TimeOfDay/time.Second)%60
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, hour, minute, second, 0, start.Location())
+	}
+
+	switch r.Frequency {
+	case "daily":
+		day := start.AddDate(0, 0, period)
+		return []time.Time{at(day.Year(), day.Month(), day.Day())}
+
+	case "weekly":
+		days := r.ByDay
+		if len(days) == 0 {
+			days = []time.Weekday{start.Weekday()}
+		}
+		monday := start.AddDate(0, 0, period*7-weekdayOffset(start.Weekday()))
+		var times []time.Time
+		for _, weekday := range days {
+			day := monday.AddDate(0, 0, weekdayOffset(weekday))
+			times = append(times, at(day.Year(), day.Month(), day.Day()))
+		}
+		return times
+
+	case "monthly":
+		month := time.Date(start.Year(), start.Month()+time.Month(period), 1, 0, 0, 0, 0, start.Location())
+		last := daysIn(month.Month(), month.Year())
+		day := r.ByMonthDay
+		switch {
+		case day == 0:
+			day = start.Day()
+		case day < 0:
+			day = last
+		}
+		if day > last {
+			return nil
+		}
+		return []time.Time{at(month.Year(), month.Month(), day)}
+
+	case "yearly":
+		year := start.Year() + period
+		if start.Day() > daysIn(start.Month(), year) {
+			return nil
+		}
+		return []time.Time{at(year, start.Month(), start.Day())}
+	}
+
+	return nil
+}
+
+// daysIn returns the number of days in month of year.
+func daysIn(month time.Month, year int) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// String describes the schedule in an RRULE-like form, such as
+// "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE;AT=09:00".
+func (r Recurrence) String() string {
+	parts := []string{"FREQ=" + strings.ToUpper(r.Frequency), fmt.Sprintf("INTERVAL=%d", r.Interval)}
+	if len(r.ByDay) > 0 {
+		days := make([]string, len(r.ByDay))
+		for i, day := range r.ByDay {
+			days[i] = strings.ToUpper(day.String()[:2])
+		}
+		parts = append(parts, "BYDAY="+strings.Join(days, ","))
+	}
+	if r.ByMonthDay != 0 {
+		parts = append(parts, fmt.Sprintf("BYMONTHDAY=%d", r.ByMonthDay))
+	}
+	if r.HasTime {
+		parts = append(parts, fmt.Sprintf("AT=%02d:%02d", int(r.TimeOfDay.Hours()), int(r.TimeOfDay.Minutes())%60))
+	}
+	return strings.Join(parts, ";")
+}
@@ -0,0 +1,120 @@
+package godateparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// referencePenalty is subtracted from the anchor's confidence for a date
+// resolved through a reference ("3 days before the meeting").
+const referencePenalty = 0.15
+
+// referenceOffsetPattern matches a duration offset from a named event, such
+// as "3 days before the meeting" or "a week after the launch".
+var referenceOffsetPattern = regexp.MustCompile(`(?i)\b(a|an|` + amountPattern + `)\s+(second|minute|hour|day|week|fortnight|month|quarter|year|decade)s?\s+(before|after)\s+the\s+(\p{L}+)\b`)
+
+// sentenceBreakPattern matches the end of a sentence: terminal punctuation
+// followed by a capitalized word, or a line break. A period alone is not
+// enough, since abbreviations such as "Dec." end in one.
+var sentenceBreakPattern = regexp.MustCompile(`[.!?;]\s+\p{Lu}|\n`)
+
+// resolveReferences adds the dates of offsets from named events, such as
+// "3 days before the meeting", when the event is mentioned in the same
+// sentence as one of dates ("the meeting is on March 3, 2025"), before or
+// after the offset. The mention nearest to the offset wins. Offsets that
+// overlap a date already found are left alone.
+func resolveReferences(ctx *parserContext, dates []ParsedDate) []ParsedDate {
+	text := ctx.input
+	resolved := dates
+
+	for _, m := range referenceOffsetPattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := m[0], m[1]
+		if overlapsResult(dates, start, end) {
+			continue
+		}
+
+		anchor, ok := findReferenceAnchor(ctx, dates, text[m[8]:m[9]], start, end)
+		if !ok {
+			continue
+		}
+
+		amount, err := parseRelativeAmount(ctx, text[m[2]:m[3]])
+		if err != nil {
+			continue
+		}
+		if strings.EqualFold(text[m[6]:m[7]], "before") {
+			amount = -amount
+		}
+
+		// Offset from the anchor rather than from RelativeBase
+		anchored := *ctx.settings
+		anchored.RelativeBase = anchor.Date
+		unit := strings.ToLower(text[m[4]:m[5]])
+		date, err := addRelativeAmount(&parserContext{settings: &anchored}, amount, unit)
+		if err != nil {
+			continue
+		}
+
+		if tooShort(ctx, text[start:end]) {
+			continue
+		}
+
+		granularity := "day"
+		if anchor.Granularity == "time" || unit == "second" || unit == "minute" || unit == "hour" {
+			granularity = "time"
+		}
+		resolved = append(resolved, ParsedDate{
+			Date:        date,
+			Position:    start,
+			Length:      end - start,
+			MatchedText: text[start:end],
+			Context:     matchContext(ctx, start, end),
+			Confidence:  anchor.Confidence - referencePenalty,
+			Granularity: granularity,
+		})
+	}
+
+	return resolved
+}
+
+// findReferenceAnchor returns the date the event named noun is mentioned
+// with: of the mentions of noun outside text[start:end] that share a
+// sentence with one of dates, the one nearest to the offset, paired with
+// the nearest date in its sentence.
+func findReferenceAnchor(ctx *parserContext, dates []ParsedDate, noun string, start, end int) (ParsedDate, bool) {
+	text := ctx.input
+	mentionPattern := ctx.compile(`(?i)\b` + regexp.QuoteMeta(noun) + `\b`)
+
+	var anchor ParsedDate
+	bestDistance := -1
+	for _, mention := range mentionPattern.FindAllStringIndex(text, -1) {
+		if mention[0] >= start && mention[1] <= end {
+			continue
+		}
+		distance := start - mention[1]
+		if mention[0] >= end {
+			distance = mention[0] - end
+		}
+		if bestDistance >= 0 && distance >= bestDistance {
+			continue
+		}
+
+		dateDistance := -1
+		for _, date := range dates {
+			// The mention and the date must share a sentence
+			gapStart, gapEnd := mention[1], date.Position
+			if date.Position < mention[0] {
+				gapStart, gapEnd = date.Position+date.Length, mention[0]
+			}
+			if gapStart > gapEnd || sentenceBreakPattern.MatchString(text[gapStart:gapEnd]) {
+				continue
+			}
+			if dateDistance < 0 || gapEnd-gapStart < dateDistance {
+				anchor, dateDistance = date, gapEnd-gapStart
+				bestDistance = distance
+			}
+		}
+	}
+
+	return anchor, bestDistance >= 0
+}
@@ -1,6 +1,8 @@
 package godateparser
 
 import (
+	"errors"
+	"fmt"
 	"testing"
 	"time"
 )
@@ -70,8 +72,8 @@ func TestParseRelative_In(t *testing.T) {
 	}{
 		{"in 1 day", 16},
 		{"in 2 days", 17},
-		{"in 1 week", 22},
-		{"in 2 weeks", 29},
+		{"in 1 week", 21}, // Monday of that week
+		{"in 2 weeks", 28},
 	}
 
 	for _, tt := range tests {
@@ -96,10 +98,10 @@ func TestParseRelative_LastNext(t *testing.T) {
 		wantMonth time.Month
 		wantDay   int
 	}{
-		{"last week", time.October, 8},
-		{"next week", time.October, 22},
-		{"last month", time.September, 15},
-		{"next month", time.November, 15},
+		{"last week", time.October, 7}, // Monday of that week
+		{"next week", time.October, 21},
+		{"last month", time.September, 1}, // First day of that month
+		{"next month", time.November, 1},
 	}
 
 	for _, tt := range tests {
@@ -118,6 +120,36 @@ func TestParseRelative_LastNext(t *testing.T) {
 	}
 }
 
+func TestParseRelative_LastNextMidMonth(t *testing.T) {
+	// Bare next/last periods all snap to the start of the period, whatever
+	// the time of day of the base.
+	base := time.Date(2024, 10, 16, 10, 0, 0, 0, time.UTC) // Wednesday
+	settings := &Settings{RelativeBase: base}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"next week", time.Date(2024, 10, 21, 0, 0, 0, 0, time.UTC)},
+		{"last week", time.Date(2024, 10, 7, 0, 0, 0, 0, time.UTC)},
+		{"next month", time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"last month", time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{"in 1 month", time.Date(2024, 11, 16, 10, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate() error = %v", err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
 func TestParseRelative_Weekdays(t *testing.T) {
 	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC) // Tuesday
 	settings := &Settings{RelativeBase: base}
@@ -231,6 +263,115 @@ func TestParseRelative_PeriodBoundaries(t *testing.T) {
 	}
 }
 
+func TestParseRelative_NextLastPeriodBoundaries(t *testing.T) {
+	base := time.Date(2024, 10, 16, 14, 30, 0, 0, time.UTC) // Wednesday, mid-month, Q4
+
+	tests := []struct {
+		input        string
+		weekStartsOn string
+		lang         string
+		want         time.Time
+	}{
+		{"beginning of next month", "", "en", time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"start of the next month", "", "en", time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"end of last month", "", "en", time.Date(2024, 9, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"end of next year", "", "en", time.Date(2025, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{"beginning of last year", "", "en", time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"start of next quarter", "", "en", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"end of last quarter", "", "en", time.Date(2024, 9, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"end of quarter", "", "en", time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{"start of next week", "", "en", time.Date(2024, 10, 21, 0, 0, 0, 0, time.UTC)},
+		{"end of last week", "", "en", time.Date(2024, 10, 13, 23, 59, 59, 999999999, time.UTC)},
+		{"start of next week", "sunday", "en", time.Date(2024, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"end of last week", "sunday", "en", time.Date(2024, 10, 12, 23, 59, 59, 999999999, time.UTC)},
+		{"start of week", "Sunday", "en", time.Date(2024, 10, 13, 0, 0, 0, 0, time.UTC)},
+		{"fin de la próxima semana", "sunday", "es", time.Date(2024, 10, 26, 23, 59, 59, 999999999, time.UTC)},
+		{"début du prochain mois", "", "fr", time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input+"/"+tt.weekStartsOn, func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, WeekStartsOn: tt.weekStartsOn, Languages: []string{tt.lang}}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	// From the last day of a month, moving by a month must not overflow
+	// into the month after
+	jan31 := time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC)
+	mar31 := time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC)
+	aug31 := time.Date(2024, 8, 31, 9, 0, 0, 0, time.UTC)
+	monthEnd := []struct {
+		input string
+		base  time.Time
+		lang  string
+		want  time.Time
+	}{
+		{"start of next month", jan31, "en", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"end of next month", jan31, "en", time.Date(2024, 2, 29, 23, 59, 59, 999999999, time.UTC)},
+		{"end of last month", mar31, "en", time.Date(2024, 2, 29, 23, 59, 59, 999999999, time.UTC)},
+		{"start of next quarter", mar31, "en", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"end of next quarter", mar31, "en", time.Date(2024, 6, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"end of last quarter", mar31, "en", time.Date(2023, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{"start of next month", aug31, "en", time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{"end of next month", aug31, "en", time.Date(2024, 9, 30, 23, 59, 59, 999999999, time.UTC)},
+		{"start of last month", mar31, "en", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"fin del próximo mes", jan31, "es", time.Date(2024, 2, 29, 23, 59, 59, 999999999, time.UTC)},
+		{"fin du dernier mois", mar31, "fr", time.Date(2024, 2, 29, 23, 59, 59, 999999999, time.UTC)},
+	}
+	for _, tt := range monthEnd {
+		t.Run(tt.input+"/"+tt.base.Format("Jan 2"), func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: tt.base, Languages: []string{tt.lang}})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) from %v = %v, want %v", tt.input, tt.base, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRelative_DayWithTime(t *testing.T) {
+	base := time.Date(2024, 10, 15, 9, 45, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		lang  string
+		want  time.Time
+	}{
+		{"tomorrow at 3pm", "en", time.Date(2024, 10, 16, 15, 0, 0, 0, time.UTC)},
+		{"Tomorrow 3:30 PM", "en", time.Date(2024, 10, 16, 15, 30, 0, 0, time.UTC)},
+		{"yesterday 15:00", "en", time.Date(2024, 10, 14, 15, 0, 0, 0, time.UTC)},
+		{"yesterday at midnight", "en", time.Date(2024, 10, 14, 0, 0, 0, 0, time.UTC)},
+		{"today noon", "en", time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)},
+		{"today @ 18:15", "en", time.Date(2024, 10, 15, 18, 15, 0, 0, time.UTC)},
+		{"mañana a las 15:00", "es", time.Date(2024, 10, 16, 15, 0, 0, 0, time.UTC)},
+		{"ayer a medianoche", "es", time.Date(2024, 10, 14, 0, 0, 0, 0, time.UTC)},
+		{"demain à 15:00", "fr", time.Date(2024, 10, 16, 15, 0, 0, 0, time.UTC)},
+		{"morgen um 15:00", "de", time.Date(2024, 10, 16, 15, 0, 0, 0, time.UTC)},
+		{"明日 15:00", "ja", time.Date(2024, 10, 16, 15, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, Languages: []string{tt.lang}})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
 func TestParseRelative_ComplexExpressions(t *testing.T) {
 	base := time.Date(2024, 10, 15, 14, 30, 0, 0, time.UTC) // Tuesday
 	settings := &Settings{RelativeBase: base}
@@ -268,11 +409,15 @@ func TestParseRelative_Quarters(t *testing.T) {
 		wantMonth time.Month
 		wantYear  int
 	}{
-		{"Q1", "Q1", time.January, 2024},
-		{"Q2", "Q2", time.April, 2024},
-		{"Q3", "Q3", time.July, 2024},
+		{"Q1", "Q1", time.January, 2025}, // already over: next year (PreferDatesFrom future)
+		{"Q2", "Q2", time.April, 2025},
+		{"Q3", "Q3", time.July, 2025},
 		{"Q4", "Q4", time.October, 2024},
 		{"Q4 2025", "Q4 2025", time.October, 2025},
+		{"H1", "H1", time.January, 2025},
+		{"H2", "H2", time.July, 2024},
+		{"H1 2024", "H1 2024", time.January, 2024},
+		{"H2 2025", "H2 2025", time.July, 2025},
 		{"this quarter", "this quarter", time.October, 2024},
 		{"next quarter", "next quarter", time.January, 2025},
 		{"last quarter", "last quarter", time.July, 2024},
@@ -294,6 +439,46 @@ func TestParseRelative_Quarters(t *testing.T) {
 	}
 }
 
+func TestParseRelative_QuarterRelativeToBase(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		base   time.Time
+		lang   string
+		prefer string
+		want   time.Time
+	}{
+		{"next quarter in January", "next quarter", time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC), "en", "", time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"next quarter in June", "next quarter", time.Date(2024, 6, 30, 9, 0, 0, 0, time.UTC), "en", "", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"next quarter in December", "next quarter", time.Date(2024, 12, 15, 9, 0, 0, 0, time.UTC), "en", "", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"last quarter in February", "last quarter", time.Date(2024, 2, 10, 9, 0, 0, 0, time.UTC), "en", "", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"this quarter in August", "this quarter", time.Date(2024, 8, 10, 9, 0, 0, 0, time.UTC), "en", "", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"bare Q3 future", "Q3", time.Date(2024, 8, 10, 9, 0, 0, 0, time.UTC), "en", "future", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"bare Q2 future", "Q2", time.Date(2024, 8, 10, 9, 0, 0, 0, time.UTC), "en", "future", time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"bare Q4 past", "Q4", time.Date(2024, 8, 10, 9, 0, 0, 0, time.UTC), "en", "past", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"bare Q1 past", "Q1", time.Date(2024, 8, 10, 9, 0, 0, 0, time.UTC), "en", "past", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"bare H1 future", "H1", time.Date(2024, 8, 10, 9, 0, 0, 0, time.UTC), "en", "future", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"bare H2 past", "H2", time.Date(2024, 3, 10, 9, 0, 0, 0, time.UTC), "en", "past", time.Date(2023, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"spanish next quarter", "próximo trimestre", time.Date(2024, 12, 15, 9, 0, 0, 0, time.UTC), "es", "", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"french postposed", "trimestre prochain", time.Date(2024, 5, 15, 9, 0, 0, 0, time.UTC), "fr", "", time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"german last quarter", "letztes Quartal", time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC), "de", "", time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"chinese next quarter", "下季度", time.Date(2024, 12, 15, 9, 0, 0, 0, time.UTC), "zh", "", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &Settings{RelativeBase: tt.base, Languages: []string{tt.lang}, PreferDatesFrom: tt.prefer}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
 func TestParseRelative_ZeroQuantity(t *testing.T) {
 	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
 	settings := &Settings{RelativeBase: base}
@@ -317,6 +502,231 @@ func TestParseRelative_ZeroQuantity(t *testing.T) {
 	}
 }
 
+func TestParseRelative_BusinessDays(t *testing.T) {
+	friday := time.Date(2024, 10, 18, 9, 0, 0, 0, time.UTC)
+	monday := time.Date(2024, 10, 21, 9, 0, 0, 0, time.UTC)
+	thursday := time.Date(2024, 10, 17, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		input    string
+		settings *Settings
+		want     time.Time
+	}{
+		{"crossing a weekend", "in 3 business days", &Settings{RelativeBase: friday}, time.Date(2024, 10, 23, 9, 0, 0, 0, time.UTC)},
+		{"ago crossing a weekend", "2 working days ago", &Settings{RelativeBase: monday}, thursday},
+		{"next business day", "next business day", &Settings{RelativeBase: friday}, monday},
+		{"crossing a holiday", "in 3 business days", &Settings{
+			RelativeBase: friday,
+			Holidays:     []time.Time{time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC)},
+		}, time.Date(2024, 10, 24, 9, 0, 0, 0, time.UTC)},
+		{"Friday/Saturday weekend", "in 1 business day", &Settings{
+			RelativeBase: thursday,
+			Weekend:      []time.Weekday{time.Friday, time.Saturday},
+		}, time.Date(2024, 10, 20, 9, 0, 0, 0, time.UTC)},
+		{"German", "in 3 Werktagen", &Settings{RelativeBase: friday, Languages: []string{"de"}}, time.Date(2024, 10, 23, 9, 0, 0, 0, time.UTC)},
+		{"Spanish", "hace 2 días hábiles", &Settings{RelativeBase: monday, Languages: []string{"es"}}, thursday},
+		{"Chinese", "3个工作日后", &Settings{RelativeBase: friday, Languages: []string{"zh"}}, time.Date(2024, 10, 23, 9, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, tt.settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRelative_BusinessDayLimits(t *testing.T) {
+	friday := time.Date(2024, 10, 18, 9, 0, 0, 0, time.UTC)
+
+	t.Run("large amounts jump whole weeks", func(t *testing.T) {
+		done := make(chan struct{})
+		var result time.Time
+		var err error
+		go func() {
+			defer close(done)
+			result, err = ParseDate("in 300000000 business days", &Settings{RelativeBase: friday})
+		}()
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("ParseDate() did not return")
+		}
+		// 300000000 business days are 60000000 weeks of five, ending on a Friday
+		want := friday.AddDate(0, 0, 7*60000000)
+		if err != nil || !result.Equal(want) {
+			t.Errorf("ParseDate() = %v, %v, want %v", result, err, want)
+		}
+	})
+
+	t.Run("weeks with holidays", func(t *testing.T) {
+		holidays := []time.Time{
+			time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 10, 22, 12, 0, 0, 0, time.UTC), // same day twice
+			time.Date(2024, 10, 26, 0, 0, 0, 0, time.UTC),  // a Saturday
+			time.Date(2024, 11, 5, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 10, 11, 0, 0, 0, 0, time.UTC),
+		}
+		settings := &Settings{RelativeBase: friday, Holidays: holidays}
+		for _, tt := range []struct {
+			input string
+			want  time.Time
+		}{
+			{"in 12 business days", time.Date(2024, 11, 7, 9, 0, 0, 0, time.UTC)},
+			{"in 10 business days", time.Date(2024, 11, 4, 9, 0, 0, 0, time.UTC)},
+			{"11 business days ago", time.Date(2024, 10, 2, 9, 0, 0, 0, time.UTC)},
+		} {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil || !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, %v, want %v", tt.input, result, err, tt.want)
+			}
+		}
+	})
+
+	t.Run("no business days", func(t *testing.T) {
+		weekend := []time.Weekday{time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday}
+		done := make(chan error, 1)
+		go func() {
+			_, err := ParseDate("in 1 business day", &Settings{RelativeBase: friday, Weekend: weekend})
+			done <- err
+		}()
+		select {
+		case err := <-done:
+			if err == nil {
+				t.Error("ParseDate() should fail when every day is a weekend day")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("ParseDate() did not return")
+		}
+	})
+}
+
+func TestParseRelative_TwoDaysAway(t *testing.T) {
+	base := time.Date(2024, 10, 15, 9, 30, 0, 0, time.UTC)
+	after := base.AddDate(0, 0, 2)
+	before := base.AddDate(0, 0, -2)
+
+	tests := []struct {
+		input     string
+		languages []string
+		want      time.Time
+	}{
+		{"the day after tomorrow", nil, after},
+		{"day after tomorrow", nil, after},
+		{"The Day Before Yesterday", nil, before},
+		{"day before yesterday", nil, before},
+		{"the day after tomorrow at 3pm", nil, time.Date(2024, 10, 17, 15, 0, 0, 0, time.UTC)},
+		{"übermorgen", []string{"de"}, after},
+		{"vorgestern", []string{"de"}, before},
+		{"après-demain", []string{"fr"}, after},
+		{"avant-hier", []string{"fr"}, before},
+		{"pasado mañana", []string{"es"}, after},
+		{"anteayer", []string{"es"}, before},
+		{"后天", []string{"zh"}, after},
+		{"一昨日", []string{"ja"}, before},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, Languages: tt.languages})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRelative_BusinessShorthands(t *testing.T) {
+	base := time.Date(2024, 10, 16, 9, 30, 0, 0, time.UTC) // Wednesday
+
+	tests := []struct {
+		input    string
+		endOfDay time.Duration
+		want     time.Time
+	}{
+		{"EOD", 0, time.Date(2024, 10, 16, 17, 0, 0, 0, time.UTC)},
+		{"by COB", 0, time.Date(2024, 10, 16, 17, 0, 0, 0, time.UTC)},
+		{"close of business", 0, time.Date(2024, 10, 16, 17, 0, 0, 0, time.UTC)},
+		{"EOD tomorrow", 0, time.Date(2024, 10, 17, 17, 0, 0, 0, time.UTC)},
+		{"COB Friday", 0, time.Date(2024, 10, 18, 17, 0, 0, 0, time.UTC)},
+		{"COB Wednesday", 0, time.Date(2024, 10, 16, 17, 0, 0, 0, time.UTC)},
+		{"eod", 23*time.Hour + 59*time.Minute, time.Date(2024, 10, 16, 23, 59, 0, 0, time.UTC)},
+		{"EOM", 0, time.Date(2024, 10, 31, 23, 59, 59, 999999999, time.UTC)},
+		{"EOQ", 0, time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+		{"by EOY", 0, time.Date(2024, 12, 31, 23, 59, 59, 999999999, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, EndOfDay: tt.endOfDay})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRelative_OffsetFromAnchor(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		languages []string
+		want      time.Time
+	}{
+		{"minutes after time", "45 minutes after 3pm", nil, time.Date(2024, 10, 15, 15, 45, 0, 0, time.UTC)},
+		{"hours before time", "2 hours before noon", nil, time.Date(2024, 10, 15, 10, 0, 0, 0, time.UTC)},
+		{"after time crosses midnight", "30 minutes after 11:45pm", nil, time.Date(2024, 10, 16, 0, 15, 0, 0, time.UTC)},
+		{"before midnight crosses back", "2 hours before midnight", nil, time.Date(2024, 10, 14, 22, 0, 0, 0, time.UTC)},
+		{"days before date", "2 days before December 31", nil, time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{"weeks after date", "2 weeks after January 10, 2025", nil, time.Date(2025, 1, 24, 0, 0, 0, 0, time.UTC)},
+		{"business days after date", "3 business days after December 20, 2024", nil, time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)},
+		{"fractional amount", "1.5 hours after noon", nil, time.Date(2024, 10, 15, 13, 30, 0, 0, time.UTC)},
+		{"Spanish", "2 días antes de 31 de diciembre de 2024", []string{"es"}, time.Date(2024, 12, 29, 0, 0, 0, 0, time.UTC)},
+		{"German", "3 Tage vor 24. Dezember 2024", []string{"de"}, time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC)},
+		{"French", "2 heures après midi", []string{"fr"}, time.Date(2024, 10, 15, 14, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, Languages: tt.languages})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("invalid anchor", func(t *testing.T) {
+		_, err := ParseDate("2 days before 2024-02-30", &Settings{RelativeBase: base})
+		var invalid *ErrInvalidDate
+		if !errors.As(err, &invalid) {
+			t.Fatalf("expected ErrInvalidDate, got %v", err)
+		}
+	})
+
+	t.Run("unparseable anchor", func(t *testing.T) {
+		if _, err := ParseDate("5 days after banana", &Settings{RelativeBase: base}); err == nil {
+			t.Error("expected error for unparseable anchor")
+		}
+	})
+}
+
 func BenchmarkParseRelative_Simple(b *testing.B) {
 	settings := DefaultSettings()
 	for i := 0; i < b.N; i++ {
@@ -337,3 +747,434 @@ func BenchmarkParseRelative_Complex(b *testing.B) {
 		_, _ = ParseDate("a week from Tuesday", settings)
 	}
 }
+
+func TestParseRelative_VagueAmounts(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input     string
+		languages []string
+		fewAmount int
+		want      time.Time
+	}{
+		{"a couple of days ago", nil, 0, base.AddDate(0, 0, -2)},
+		{"a couple days from now", nil, 0, base.AddDate(0, 0, 2)},
+		{"in a few weeks", nil, 0, base.AddDate(0, 0, 21)},
+		{"several hours ago", nil, 0, base.Add(-3 * time.Hour)},
+		{"A Few Days Ago", nil, 0, base.AddDate(0, 0, -3)},
+		{"in a few weeks", nil, 4, base.AddDate(0, 0, 28)},
+		{"a couple of days ago", nil, 4, base.AddDate(0, 0, -2)},
+		{"hace un par de días", []string{"es"}, 0, base.AddDate(0, 0, -2)},
+		{"en unas semanas", []string{"es"}, 0, base.AddDate(0, 0, 21)},
+		{"il y a quelques jours", []string{"fr"}, 0, base.AddDate(0, 0, -3)},
+		{"vor ein paar Tagen", []string{"de"}, 0, base.AddDate(0, 0, -3)},
+		{"через пару недель", []string{"ru"}, 0, base.AddDate(0, 0, 14)},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%s/few=%d", tt.input, tt.fewAmount), func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, Languages: tt.languages, FewAmount: tt.fewAmount}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("extracted", func(t *testing.T) {
+		results, err := ExtractDates("We met a couple of days ago and meet again in a few weeks.", &Settings{RelativeBase: base})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) != 2 || !results[0].Date.Equal(base.AddDate(0, 0, -2)) || !results[1].Date.Equal(base.AddDate(0, 0, 21)) {
+			t.Errorf("ExtractDates() = %+v, want 2 days ago and in 3 weeks", results)
+		}
+	})
+}
+
+func TestParseRelative_NamedMonth(t *testing.T) {
+	beforeMarch := time.Date(2024, 1, 20, 12, 0, 0, 0, time.UTC)
+	afterMarch := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	inMarch := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		input     string
+		base      time.Time
+		languages []string
+		want      time.Time
+	}{
+		{"next before March", "next March", beforeMarch, nil, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"next after March", "next March", afterMarch, nil, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"next in March", "next March", inMarch, nil, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"last before December", "last December", beforeMarch, nil, time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{"last after March", "last March", afterMarch, nil, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"last in March", "last March", inMarch, nil, time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"this month of the year", "this January", afterMarch, nil, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"lowercase", "next march", afterMarch, nil, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"Spanish modifier first", "próximo marzo", afterMarch, []string{"es"}, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"Spanish modifier last", "marzo pasado", afterMarch, []string{"es"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"French", "mars prochain", beforeMarch, []string{"fr"}, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"German", "nächster März", afterMarch, []string{"de"}, time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &Settings{RelativeBase: tt.base, Languages: tt.languages}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRelative_Fiscal(t *testing.T) {
+	base := time.Date(2024, 11, 20, 12, 0, 0, 0, time.UTC)
+	october := &FiscalCalendar{StartMonth: time.October}
+	april := &FiscalCalendar{StartMonth: time.April, NamedByStartYear: true}
+
+	tests := []struct {
+		name   string
+		input  string
+		fiscal *FiscalCalendar
+		want   time.Time
+	}{
+		{"fiscal year named by end year", "FY2024", october, time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"fiscal year with space", "FY 2025", october, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"fiscal year spelled out", "fiscal year 2024", october, time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"fiscal year named by start year", "FY2024", april, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"calendar fiscal year", "FY2024", nil, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"first fiscal quarter", "Q1 FY2024", october, time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"second fiscal quarter", "Q2 FY2024", october, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"last fiscal quarter", "Q4 FY2024", october, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+		{"quarter after year", "FY2025-Q3", april, time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"fiscal half", "H2 FY2024", october, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"this fiscal year", "this fiscal year", october, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"last fiscal year", "last fiscal year", october, time.Date(2023, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"next fiscal year before start", "next fiscal year", april, time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"this fiscal quarter", "this fiscal quarter", april, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+		{"next fiscal quarter", "next fiscal quarter", october, time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"fiscal year to date", "fiscal year to date", april, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)},
+		{"FYTD", "FYTD", october, time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &Settings{RelativeBase: base, FiscalCalendar: tt.fiscal}
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("no fifth quarter or third half", func(t *testing.T) {
+		for _, input := range []string{"Q5 FY2024", "H3 FY2024"} {
+			if result, err := ParseDate(input, &Settings{RelativeBase: base, FiscalCalendar: october}); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		}
+	})
+}
+
+func TestParseRelative_Countdown(t *testing.T) {
+	base := time.Date(2024, 12, 15, 12, 0, 0, 0, time.UTC)
+	christmas := time.Date(2024, 12, 25, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name           string
+		input          string
+		languages      []string
+		want           time.Time
+		wantConsistent bool
+	}{
+		{"days until", "10 days until December 25", nil, christmas, true},
+		{"days till", "10 days till Dec 25", nil, christmas, true},
+		{"stated offset disagrees", "3 days until December 25", nil, christmas, false},
+		{"weeks till weekday", "1 week till Sunday", nil, time.Date(2024, 12, 22, 12, 0, 0, 0, time.UTC), true},
+		{"hours until time", "3 hours until 3pm", nil, time.Date(2024, 12, 15, 15, 0, 0, 0, time.UTC), true},
+		{"Spanish", "10 días para el 25 de diciembre", []string{"es"}, christmas, true},
+		{"German", "10 Tage bis 25. Dezember 2024", []string{"de"}, christmas, true},
+		{"Dutch", "10 dagen tot 25 december 2024", []string{"nl"}, christmas, true},
+		{"until holiday", "10 days until Christmas", nil, christmas, true},
+		{"holiday next year", "126 days until Easter", nil, time.Date(2025, 4, 20, 0, 0, 0, 0, time.UTC), true},
+		{"German holiday", "10 Tage bis Weihnachten", []string{"de"}, christmas, true},
+		{"Spanish holiday with article", "10 días para la Navidad", []string{"es"}, christmas, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			opts := &Settings{RelativeBase: base, Languages: tt.languages}
+			result, err := ParseDate(tt.input, opts)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+
+			countdown, err := ParseCountdown(tt.input, opts)
+			if err != nil {
+				t.Fatalf("ParseCountdown(%q) error = %v", tt.input, err)
+			}
+			if !countdown.Target.Equal(tt.want) {
+				t.Errorf("ParseCountdown(%q).Target = %v, want %v", tt.input, countdown.Target, tt.want)
+			}
+			if got := countdown.Consistent(); got != tt.wantConsistent {
+				t.Errorf("ParseCountdown(%q).Consistent() = %v (stated %v), want %v", tt.input, got, countdown.Stated, tt.wantConsistent)
+			}
+		})
+	}
+
+	t.Run("named dates", func(t *testing.T) {
+		deadline := time.Date(2025, 1, 5, 17, 0, 0, 0, time.UTC)
+		opts := &Settings{RelativeBase: base, NamedDates: map[string]time.Time{
+			"Deadline":  deadline,
+			"Christmas": time.Date(2024, 12, 24, 0, 0, 0, 0, time.UTC), // overrides the holiday
+		}}
+
+		countdown, err := ParseCountdown("3 weeks till the deadline", opts)
+		if err != nil {
+			t.Fatalf("ParseCountdown() error = %v", err)
+		}
+		if !countdown.Target.Equal(deadline) || !countdown.Consistent() {
+			t.Errorf("ParseCountdown() = %+v, want consistent target %v", countdown, deadline)
+		}
+		if result, err := ParseDate("9 days until Christmas", opts); err != nil || result.Day() != 24 {
+			t.Errorf("ParseDate() = %v, %v, want the NamedDates entry", result, err)
+		}
+		if _, err := ParseDate("3 weeks till the deadline", &Settings{RelativeBase: base}); err == nil {
+			t.Error("ParseDate() should fail for a name not in NamedDates")
+		}
+	})
+
+	t.Run("holidays", func(t *testing.T) {
+		for _, tt := range []struct {
+			input string
+			base  time.Time
+			want  time.Time
+		}{
+			{"1 day until Thanksgiving", time.Date(2025, 11, 26, 9, 0, 0, 0, time.UTC), time.Date(2025, 11, 27, 0, 0, 0, 0, time.UTC)},
+			{"1 day until Easter", time.Date(2024, 3, 30, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)},
+			{"0 days until Halloween", time.Date(2024, 10, 31, 9, 0, 0, 0, time.UTC), time.Date(2024, 10, 31, 0, 0, 0, 0, time.UTC)},
+			{"1 week until New Year's Day", time.Date(2024, 12, 25, 9, 0, 0, 0, time.UTC), time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)},
+		} {
+			countdown, err := ParseCountdown(tt.input, &Settings{RelativeBase: tt.base})
+			if err != nil {
+				t.Errorf("ParseCountdown(%q) error = %v", tt.input, err)
+				continue
+			}
+			if !countdown.Target.Equal(tt.want) || !countdown.Consistent() {
+				t.Errorf("ParseCountdown(%q) = %+v, want consistent target %v", tt.input, countdown, tt.want)
+			}
+		}
+	})
+
+	t.Run("not a countdown", func(t *testing.T) {
+		var formatErr *ErrInvalidFormat
+		if _, err := ParseCountdown("December 25", &Settings{RelativeBase: base}); !errors.As(err, &formatErr) {
+			t.Errorf("ParseCountdown() error = %v, want ErrInvalidFormat", err)
+		}
+	})
+
+	t.Run("invalid target", func(t *testing.T) {
+		var invalidErr *ErrInvalidDate
+		if _, err := ParseDate("10 days until February 30, 2025", &Settings{RelativeBase: base}); !errors.As(err, &invalidErr) {
+			t.Errorf("ParseDate() error = %v, want ErrInvalidDate", err)
+		}
+	})
+}
+
+func TestParseRelative_SignedOffsets(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input     string
+		languages []string
+		want      time.Time
+	}{
+		{"-3 days", nil, time.Date(2024, 10, 12, 12, 0, 0, 0, time.UTC)},
+		{"+2 weeks", nil, time.Date(2024, 10, 29, 12, 0, 0, 0, time.UTC)},
+		{"+1 day", nil, time.Date(2024, 10, 16, 12, 0, 0, 0, time.UTC)},
+		{"-1 year", nil, time.Date(2023, 10, 15, 12, 0, 0, 0, time.UTC)},
+		{"+3days", nil, time.Date(2024, 10, 18, 12, 0, 0, 0, time.UTC)},
+		{"- 2 hours", nil, time.Date(2024, 10, 15, 10, 0, 0, 0, time.UTC)},
+		{"+1.5 hours", nil, time.Date(2024, 10, 15, 13, 30, 0, 0, time.UTC)},
+		{"+1 month -2 days", nil, time.Date(2024, 11, 13, 12, 0, 0, 0, time.UTC)},
+		{"-1 week +1 hour", nil, time.Date(2024, 10, 8, 13, 0, 0, 0, time.UTC)},
+		{"-3 días", []string{"es"}, time.Date(2024, 10, 12, 12, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, Languages: tt.languages})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	// A sign needs a unit, and every offset of a compound needs a sign
+	for _, input := range []string{"-1700000000", "+3", "+2 weeks 3 days"} {
+		t.Run("invalid "+input, func(t *testing.T) {
+			if result, err := ParseDate(input, &Settings{RelativeBase: base}); err == nil {
+				t.Errorf("ParseDate(%q) = %v, want error", input, result)
+			}
+		})
+	}
+}
+
+func TestParseRelative_MonthWithDay(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"next month on the 15th", time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC)},
+		{"last month the 3rd", time.Date(2024, 9, 3, 0, 0, 0, 0, time.UTC)},
+		{"this month on the 20th", time.Date(2024, 10, 20, 0, 0, 0, 0, time.UTC)},
+		{"Next Month, on the 1st", time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC)},
+		{"next month 30", time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC)},
+		{"the 15th of next month", time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC)},
+		{"on the 30th of last month", time.Date(2024, 9, 30, 0, 0, 0, 0, time.UTC)},
+		{"2nd of next month", time.Date(2024, 11, 2, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	// From January 31, next month is February, not March
+	t.Run("from the end of a month", func(t *testing.T) {
+		jan31 := time.Date(2024, 1, 31, 12, 0, 0, 0, time.UTC)
+		result, err := ParseDate("next month on the 10th", &Settings{RelativeBase: jan31})
+		want := time.Date(2024, 2, 10, 0, 0, 0, 0, time.UTC)
+		if err != nil || !result.Equal(want) {
+			t.Errorf("ParseDate() = %v, %v, want %v", result, err, want)
+		}
+	})
+
+	// November has 30 days
+	for _, input := range []string{"the 31st of next month", "next month on the 31st", "the 32nd of next month"} {
+		t.Run("overflow "+input, func(t *testing.T) {
+			var invalidErr *ErrInvalidDate
+			if result, err := ParseDate(input, &Settings{RelativeBase: base}); !errors.As(err, &invalidErr) {
+				t.Errorf("ParseDate(%q) = %v, %v, want ErrInvalidDate", input, result, err)
+			}
+		})
+	}
+
+	t.Run("ClampDayOfMonth", func(t *testing.T) {
+		settings := &Settings{RelativeBase: base, ClampDayOfMonth: true}
+		result, err := ParseDate("the 31st of next month", settings)
+		want := time.Date(2024, 11, 30, 0, 0, 0, 0, time.UTC)
+		if err != nil || !result.Equal(want) {
+			t.Errorf("ParseDate() = %v, %v, want %v", result, err, want)
+		}
+
+		leap := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+		result, err = ParseDate("next month on the 30th", &Settings{RelativeBase: leap, ClampDayOfMonth: true})
+		want = time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)
+		if err != nil || !result.Equal(want) {
+			t.Errorf("ParseDate() = %v, %v, want %v", result, err, want)
+		}
+	})
+}
+
+func TestParseRelative_WeekendReferences(t *testing.T) {
+	friday := time.Date(2024, 10, 18, 12, 0, 0, 0, time.UTC)
+	sunday := time.Date(2024, 10, 20, 12, 0, 0, 0, time.UTC)
+	fridaySaturday := []time.Weekday{time.Friday, time.Saturday}
+	day := func(d int) time.Time { return time.Date(2024, 10, d, 0, 0, 0, 0, time.UTC) }
+
+	weekends := []struct {
+		name      string
+		input     string
+		base      time.Time
+		weekend   []time.Weekday
+		wantStart time.Time
+		wantLast  time.Time // last day of the weekend
+	}{
+		{"Friday this weekend", "this weekend", friday, nil, day(19), day(20)},
+		{"Friday the weekend", "the weekend", friday, nil, day(19), day(20)},
+		{"Friday next weekend", "next weekend", friday, nil, day(26), day(27)},
+		{"Friday last weekend", "last weekend", friday, nil, day(12), day(13)},
+		// On a Sunday the weekend under way is this weekend
+		{"Sunday this weekend", "This Weekend", sunday, nil, day(19), day(20)},
+		{"Sunday next weekend", "next weekend", sunday, nil, day(26), day(27)},
+		{"Sunday last weekend", "last weekend", sunday, nil, day(12), day(13)},
+		// Friday/Saturday weekend
+		{"Friday weekend on a Friday", "this weekend", friday, fridaySaturday, day(18), day(19)},
+		{"Friday weekend on a Sunday", "this weekend", sunday, fridaySaturday, day(25), day(26)},
+	}
+
+	for _, tt := range weekends {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := &Settings{RelativeBase: tt.base, Weekend: tt.weekend}
+
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.wantStart) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.wantStart)
+			}
+
+			r, err := ParseDateRange(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDateRange(%q) error = %v", tt.input, err)
+			}
+			wantEnd := tt.wantLast.Add(24*time.Hour - time.Nanosecond)
+			if !r.Start.Equal(tt.wantStart) || !r.End.Equal(wantEnd) {
+				t.Errorf("ParseDateRange(%q) = %v - %v, want %v - %v", tt.input, r.Start, r.End, tt.wantStart, wantEnd)
+			}
+		})
+	}
+
+	weekdays := []struct {
+		name    string
+		input   string
+		base    time.Time
+		weekend []time.Weekday
+		want    time.Time
+	}{
+		{"Friday next weekday", "next weekday", friday, nil, time.Date(2024, 10, 21, 12, 0, 0, 0, time.UTC)},
+		{"Sunday next weekday", "next weekday", sunday, nil, time.Date(2024, 10, 21, 12, 0, 0, 0, time.UTC)},
+		{"Sunday last weekday", "last weekday", sunday, nil, time.Date(2024, 10, 18, 12, 0, 0, 0, time.UTC)},
+		{"Friday weekend next weekday", "next weekday", time.Date(2024, 10, 17, 12, 0, 0, 0, time.UTC), fridaySaturday, sunday},
+	}
+
+	for _, tt := range weekdays {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: tt.base, Weekend: tt.weekend})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
@@ -29,6 +29,10 @@ var timezoneAbbreviations = map[string]string{
 	"AKST": "America/Anchorage",   // Alaska Standard Time
 	"AKDT": "America/Anchorage",   // Alaska Daylight Time
 	"HST":  "Pacific/Honolulu",    // Hawaii Standard Time
+	"ET":   "America/New_York",    // Eastern Time, standard or daylight
+	"CT":   "America/Chicago",     // Central Time
+	"MT":   "America/Denver",      // Mountain Time
+	"PT":   "America/Los_Angeles", // Pacific Time
 
 	// European timezones
 	"CET":  "Europe/Paris",  // Central European Time
@@ -60,6 +64,77 @@ var timezoneAbbreviations = map[string]string{
 	"NZDT": "Pacific/Auckland", // New Zealand Daylight Time
 }
 
+// defaultCityTimezones maps lowercase city names, and the names of the US
+// time zones, to IANA timezone names for inputs such as "3pm New York time",
+// "noon in London" or "7 PM Eastern".
+// Settings.CityTimezones entries are consulted before these.
+var defaultCityTimezones = map[string]string{
+	// North America
+	"new york":      "America/New_York",
+	"boston":        "America/New_York",
+	"washington":    "America/New_York",
+	"toronto":       "America/Toronto",
+	"chicago":       "America/Chicago",
+	"houston":       "America/Chicago",
+	"mexico city":   "America/Mexico_City",
+	"denver":        "America/Denver",
+	"phoenix":       "America/Phoenix",
+	"los angeles":   "America/Los_Angeles",
+	"san francisco": "America/Los_Angeles",
+	"seattle":       "America/Los_Angeles",
+	"vancouver":     "America/Vancouver",
+	"anchorage":     "America/Anchorage",
+	"honolulu":      "Pacific/Honolulu",
+
+	// US time zones by name: "7 PM Eastern", "noon Pacific time"
+	"eastern":  "America/New_York",
+	"central":  "America/Chicago",
+	"mountain": "America/Denver",
+	"pacific":  "America/Los_Angeles",
+
+	// South America
+	"sao paulo":    "America/Sao_Paulo",
+	"são paulo":    "America/Sao_Paulo",
+	"buenos aires": "America/Argentina/Buenos_Aires",
+
+	// Europe
+	"london":    "Europe/London",
+	"dublin":    "Europe/Dublin",
+	"lisbon":    "Europe/Lisbon",
+	"paris":     "Europe/Paris",
+	"madrid":    "Europe/Madrid",
+	"berlin":    "Europe/Berlin",
+	"amsterdam": "Europe/Amsterdam",
+	"rome":      "Europe/Rome",
+	"zurich":    "Europe/Zurich",
+	"athens":    "Europe/Athens",
+	"istanbul":  "Europe/Istanbul",
+	"moscow":    "Europe/Moscow",
+
+	// Africa and Middle East
+	"cairo":        "Africa/Cairo",
+	"johannesburg": "Africa/Johannesburg",
+	"dubai":        "Asia/Dubai",
+
+	// Asia and Oceania
+	"mumbai":    "Asia/Kolkata",
+	"delhi":     "Asia/Kolkata",
+	"new delhi": "Asia/Kolkata",
+	"singapore": "Asia/Singapore",
+	"hong kong": "Asia/Hong_Kong",
+	"shanghai":  "Asia/Shanghai",
+	"beijing":   "Asia/Shanghai",
+	"seoul":     "Asia/Seoul",
+	"tokyo":     "Asia/Tokyo",
+	"sydney":    "Australia/Sydney",
+	"melbourne": "Australia/Melbourne",
+	"perth":     "Australia/Perth",
+	"auckland":  "Pacific/Auckland",
+}
+
+// maxCityWords is the longest city name, in words, looked up by extractCityTimezone.
+const maxCityWords = 3
+
 // Timezone offset patterns
 var (
 	// Matches: +05:00, -08:00, +0530, -0800
@@ -234,10 +309,7 @@ func ExtractTimezone(input string) (dateStr string, tzInfo *TimezoneInfo, err er
 	}
 
 	// Try offset at the end (ISO format)
-	// Match +HH:MM or +HHMM but not more than 2 digits for hours
-	// This prevents matching dates like "01-15-2024" where "-2024" looks like an offset
-	offsetRegex := regexp.MustCompile(`([+-]\d{2}:?\d{2})$`)
-	if matches := offsetRegex.FindStringSubmatch(input); matches != nil {
+	if matches := trailingOffsetPattern.FindStringSubmatch(input); matches != nil {
 		tzStr := matches[1]
 		// Additional validation: make sure the hours part is valid (00-14)
 		// and that there's a clear separator (T or space) before the offset
@@ -262,12 +334,64 @@ func ExtractTimezone(input string) (dateStr string, tzInfo *TimezoneInfo, err er
 				return dateStr, tzInfo, nil
 			}
 		}
+		// IANA names such as "America/New_York"; the letters-only pattern
+		// keeps "12/31/2024" from being looked up
+		if ianaZonePattern.MatchString(lastPart) {
+			if tzInfo, err := ParseTimezone(lastPart); err == nil {
+				dateStr = strings.Join(parts[:len(parts)-1], " ")
+				return dateStr, tzInfo, nil
+			}
+		}
+	}
+
+	// Try an abbreviation attached to a time, as in log lines: "15:30:45PST", "3:30pmEST"
+	if dateStr, tzInfo, ok := splitCompactTimezone(input); ok {
+		return dateStr, tzInfo, nil
 	}
 
 	// No timezone found
 	return input, nil, nil
 }
 
+// trailingOffsetPattern matches a +HH:MM or +HHMM offset at the end of
+// input, with no more than 2 digits for hours. This prevents matching dates
+// like "01-15-2024" where "-2024" looks like an offset.
+var trailingOffsetPattern = regexp.MustCompile(`([+-]\d{2}:?\d{2})$`)
+
+// ianaZonePattern matches the shape of an IANA zone name: "Europe/Paris",
+// "America/Argentina/Buenos_Aires".
+var ianaZonePattern = regexp.MustCompile(`^[A-Za-z]+(?:/[A-Za-z_+-]+)+$`)
+
+// isOffset reports whether the zone was written as a UTC offset ("Z",
+// "+05:30", "UTC+5") rather than named by an abbreviation or IANA name.
+func (tz *TimezoneInfo) isOffset() bool {
+	return tz.Name == "Z" || offsetPattern.MatchString(tz.Name) ||
+		namedOffsetPattern.MatchString(strings.ToUpper(tz.Name))
+}
+
+// compactTimePattern matches the end of a time written right before a zone
+// abbreviation: a digit, optionally followed by am/pm ("15:30:45", "3:30pm").
+var compactTimePattern = regexp.MustCompile(`(?i)\d(?:\s*[ap]\.?m\.?)?$`)
+
+// splitCompactTimezone splits a trailing timezone abbreviation written with
+// no space after a time ("15:30:45PST", "3:30pmEST"). Only abbreviations in
+// the abbreviation map are recognized, so "3PM" is not read as a zone.
+func splitCompactTimezone(input string) (string, *TimezoneInfo, bool) {
+	for n := 5; n >= 2; n-- {
+		if len(input) <= n {
+			continue
+		}
+		abbr, rest := input[len(input)-n:], input[:len(input)-n]
+		if !isAllUpperOrZ(abbr) || !compactTimePattern.MatchString(rest) {
+			continue
+		}
+		if loc, normalized, ambiguous := lookupTimezoneAbbreviation(abbr); loc != nil {
+			return rest, &TimezoneInfo{Location: loc, Name: abbr, Normalized: normalized, Ambiguous: ambiguous}, true
+		}
+	}
+	return "", nil, false
+}
+
 // isAllUpperOrZ checks if a string is all uppercase letters or 'Z'
 func isAllUpperOrZ(s string) bool {
 	for _, c := range s {
@@ -298,3 +422,60 @@ func ApplyTimezone(t time.Time, tzInfo *TimezoneInfo) time.Time {
 	// Convert to target timezone
 	return t.In(tzInfo.Location)
 }
+
+// parseCityTimezone resolves "3pm New York time": the input without its
+// city is parsed and the result moved to the city's location. It reports
+// ok == false if input names no city or the rest cannot be parsed.
+func parseCityTimezone(input string, opts, settings *Settings, cache *regexCache) (time.Time, bool, error) {
+	rest, loc, ok := extractCityTimezone(input, settings.CityTimezones)
+	if !ok {
+		return time.Time{}, false, nil
+	}
+	result, err := parseDateInZone(rest, opts, cache)
+	if err != nil {
+		return time.Time{}, false, nil
+	}
+	return inLocation(result, loc), true, nil
+}
+
+// extractCityTimezone strips a trailing city name from input, as in
+// "3pm New York time", "noon in London" or "9:00 Tokyo", and returns the rest
+// of the input with the city's location. Names are matched case-insensitively
+// in cities first, then in the default city map.
+func extractCityTimezone(input string, cities map[string]string) (rest string, loc *time.Location, ok bool) {
+	fields := strings.Fields(input)
+	if len(fields) > 1 && strings.EqualFold(fields[len(fields)-1], "time") {
+		fields = fields[:len(fields)-1]
+	}
+
+	for n := min(maxCityWords, len(fields)-1); n >= 1; n-- {
+		name := strings.ToLower(strings.Join(fields[len(fields)-n:], " "))
+		tzName, found := lookupCity(name, cities)
+		if !found {
+			continue
+		}
+		location, err := time.LoadLocation(tzName)
+		if err != nil {
+			return "", nil, false
+		}
+
+		head := fields[:len(fields)-n]
+		if len(head) > 1 && strings.EqualFold(head[len(head)-1], "in") {
+			head = head[:len(head)-1]
+		}
+		return strings.Join(head, " "), location, true
+	}
+
+	return "", nil, false
+}
+
+// lookupCity returns the IANA timezone name of a lowercase city name.
+func lookupCity(name string, cities map[string]string) (string, bool) {
+	for city, tzName := range cities {
+		if strings.EqualFold(city, name) {
+			return tzName, true
+		}
+	}
+	tzName, ok := defaultCityTimezones[name]
+	return tzName, ok
+}
@@ -208,12 +208,30 @@ func TestExtractTimezone(t *testing.T) {
 			"December 31, 2024 3:00 PM",
 			true,
 		},
+		{
+			"Abbreviation attached to 24-hour time",
+			"15:30:45PST",
+			"15:30:45",
+			true,
+		},
+		{
+			"Abbreviation attached to 12-hour time",
+			"3:30pmEST",
+			"3:30pm",
+			true,
+		},
 		{
 			"No timezone",
 			"2024-12-31 10:30:00",
 			"2024-12-31 10:30:00",
 			false,
 		},
+		{
+			"Uppercase meridiem is not a zone",
+			"3:30PM",
+			"3:30PM",
+			false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -319,6 +337,119 @@ func TestParseDate_WithTimezones(t *testing.T) {
 	}
 }
 
+func TestParseDate_TimeWithTimezone(t *testing.T) {
+	base := time.Date(2024, 12, 15, 9, 0, 0, 0, time.UTC)
+	settings := &Settings{RelativeBase: base}
+
+	tests := []struct {
+		input        string
+		wantHour     int
+		wantMinute   int
+		wantSecond   int
+		wantTZOffset int
+	}{
+		{"15:30:45PST", 15, 30, 45, -8 * 3600},
+		{"15:30:45 PST", 15, 30, 45, -8 * 3600},
+		{"3:30pmEST", 15, 30, 0, -5 * 3600},
+		{"3:30 pm EST", 15, 30, 0, -5 * 3600},
+		{"3pmEST", 15, 0, 0, -5 * 3600},
+		{"09:15CET", 9, 15, 0, 3600},
+		{"2024-12-31 15:30:45PST", 15, 30, 45, -8 * 3600},
+		{"2024-12-31 10:30 America/New_York", 10, 30, 0, -5 * 3600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if result.Hour() != tt.wantHour || result.Minute() != tt.wantMinute || result.Second() != tt.wantSecond {
+				t.Errorf("ParseDate(%q) = %v, want %02d:%02d:%02d", tt.input, result, tt.wantHour, tt.wantMinute, tt.wantSecond)
+			}
+			if _, offset := result.Zone(); offset != tt.wantTZOffset {
+				t.Errorf("ParseDate(%q) timezone offset = %d seconds, want %d seconds", tt.input, offset, tt.wantTZOffset)
+			}
+		})
+	}
+}
+
+func TestParseDate_BareHourWithZone(t *testing.T) {
+	base := time.Date(2024, 12, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input        string
+		languages    []string
+		wantHour     int
+		wantTZOffset int
+	}{
+		{"7pm ET", nil, 19, -5 * 3600},
+		{"7 PM ET", nil, 19, -5 * 3600},
+		{"7 PM Eastern", nil, 19, -5 * 3600},
+		{"7pm Eastern time", nil, 19, -5 * 3600},
+		{"9am PT", nil, 9, -8 * 3600},
+		{"9 am Pacific", nil, 9, -8 * 3600},
+		{"noon Central", nil, 12, -6 * 3600},
+		{"19h Paris", []string{"fr"}, 19, 3600},
+		{"7pm Tokyo", nil, 19, 9 * 3600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, Languages: tt.languages})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if result.Hour() != tt.wantHour || result.Minute() != 0 {
+				t.Errorf("ParseDate(%q) = %v, want %02d:00", tt.input, result, tt.wantHour)
+			}
+			if _, offset := result.Zone(); offset != tt.wantTZOffset {
+				t.Errorf("ParseDate(%q) timezone offset = %d seconds, want %d seconds", tt.input, offset, tt.wantTZOffset)
+			}
+		})
+	}
+}
+
+func TestExtractDates_TimezoneFields(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		wantMatched string
+		wantName    string
+		wantOffset  int
+	}{
+		{"abbreviation", "Call at 2024-12-31 10:30 EST please", "2024-12-31 10:30 EST", "EST", -5 * 3600},
+		{"abbreviation after merged time", "December 31, 2024 at 3:30 PM PST", "December 31, 2024 at 3:30 PM PST", "PST", -8 * 3600},
+		{"IANA name", "Deploy 2024-12-31 10:30 America/New_York tonight", "2024-12-31 10:30 America/New_York", "America/New_York", -5 * 3600},
+		{"IANA name in summer", "Deploy 2024-07-04 10:30 America/New_York", "2024-07-04 10:30 America/New_York", "America/New_York", -4 * 3600},
+		{"numeric offset", "Logged 2024-12-31T10:30:00+05:30 by cron", "2024-12-31T10:30:00+05:30", "", 5*3600 + 30*60},
+		{"Z designator", "Logged 2024-12-31T10:30:00Z by cron", "2024-12-31T10:30:00Z", "", 0},
+		{"no zone", "Due 2024-12-31 at noon", "2024-12-31 at noon", "", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results, err := ExtractDates(tt.input, nil)
+			if err != nil {
+				t.Fatalf("ExtractDates(%q) error = %v", tt.input, err)
+			}
+			if len(results) != 1 {
+				t.Fatalf("ExtractDates(%q) found %d dates, want 1", tt.input, len(results))
+			}
+			r := results[0]
+			if r.MatchedText != tt.wantMatched {
+				t.Errorf("MatchedText = %q, want %q", r.MatchedText, tt.wantMatched)
+			}
+			if r.TimezoneName != tt.wantName {
+				t.Errorf("TimezoneName = %q, want %q", r.TimezoneName, tt.wantName)
+			}
+			if r.TimezoneOffset != tt.wantOffset {
+				t.Errorf("TimezoneOffset = %d, want %d", r.TimezoneOffset, tt.wantOffset)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // TIMEZONE CONVERSION TESTS
 // ============================================================================
@@ -344,6 +475,116 @@ func TestApplyTimezone(t *testing.T) {
 	}
 }
 
+func TestParseDate_CityTimezones(t *testing.T) {
+	summer := time.Date(2024, 7, 15, 9, 0, 0, 0, time.UTC)
+	winter := time.Date(2024, 1, 15, 9, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		input      string
+		base       time.Time
+		cities     map[string]string
+		wantZone   string
+		wantHour   int
+		wantOffset int // seconds east of UTC
+	}{
+		{"New York summer (EDT)", "3pm New York time", summer, nil, "America/New_York", 15, -4 * 3600},
+		{"New York winter (EST)", "3pm New York time", winter, nil, "America/New_York", 15, -5 * 3600},
+		{"in London summer (BST)", "noon in London", summer, nil, "Europe/London", 12, 1 * 3600},
+		{"bare city", "9:00 Tokyo", winter, nil, "Asia/Tokyo", 9, 9 * 3600},
+		{"case-insensitive", "3PM LOS ANGELES TIME", winter, nil, "America/Los_Angeles", 15, -8 * 3600},
+		{"date and time", "2024-03-15 10:00 in Sydney", winter, nil, "Australia/Sydney", 10, 11 * 3600},
+		{"custom city", "5pm Lagos time", winter, map[string]string{"Lagos": "Africa/Lagos"}, "Africa/Lagos", 17, 1 * 3600},
+		{"custom overrides default", "5pm Paris time", winter, map[string]string{"paris": "America/Chicago"}, "America/Chicago", 17, -6 * 3600},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			settings := DefaultSettings()
+			settings.RelativeBase = tt.base
+			settings.CityTimezones = tt.cities
+
+			result, err := ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if result.Location().String() != tt.wantZone {
+				t.Errorf("location = %v, want %v", result.Location(), tt.wantZone)
+			}
+			if result.Hour() != tt.wantHour {
+				t.Errorf("hour = %d, want %d", result.Hour(), tt.wantHour)
+			}
+			if _, offset := result.Zone(); offset != tt.wantOffset {
+				t.Errorf("offset = %d, want %d", offset, tt.wantOffset)
+			}
+		})
+	}
+
+	t.Run("timezone parser disabled", func(t *testing.T) {
+		settings := DefaultSettings()
+		settings.RelativeBase = winter
+		settings.EnableParsers = []string{"time"}
+		if _, err := ParseDate("3pm New York time", settings); err == nil {
+			t.Error("expected error when the timezone parser is disabled")
+		}
+	})
+}
+
+func TestParseDate_NormalizeToUTC(t *testing.T) {
+	base := time.Date(2024, 7, 15, 9, 0, 0, 0, time.UTC)
+	want := time.Date(2024, 12, 31, 5, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		input string
+		want  time.Time
+	}{
+		{"offset", "2024-12-31T10:30:00+05:30", want},
+		{"abbreviation", "2024-12-31 00:00:00 EST", want},
+		{"city", "3pm New York time", time.Date(2024, 7, 15, 19, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseDate(tt.input, &Settings{RelativeBase: base, NormalizeToUTC: true})
+			if err != nil {
+				t.Fatalf("ParseDate(%q) error = %v", tt.input, err)
+			}
+			if result.Location() != time.UTC {
+				t.Errorf("location = %v, want UTC", result.Location())
+			}
+			if !result.Equal(tt.want) || result.Hour() != tt.want.Hour() {
+				t.Errorf("ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+
+	t.Run("off keeps the parsed zone", func(t *testing.T) {
+		result, err := ParseDate("2024-12-31T10:30:00+05:30", nil)
+		if err != nil {
+			t.Fatalf("ParseDate() error = %v", err)
+		}
+		if _, offset := result.Zone(); offset != 5*3600+30*60 {
+			t.Errorf("offset = %d, want %d", offset, 5*3600+30*60)
+		}
+	})
+
+	t.Run("extracted dates", func(t *testing.T) {
+		results, err := ExtractDates("deployed 2024-12-31T10:30:00+05:30", &Settings{NormalizeToUTC: true})
+		if err != nil {
+			t.Fatalf("ExtractDates() error = %v", err)
+		}
+		if len(results) == 0 {
+			t.Fatal("ExtractDates() found no dates")
+		}
+		for _, r := range results {
+			if r.Date.Location() != time.UTC {
+				t.Errorf("ExtractDates() %q location = %v, want UTC", r.MatchedText, r.Date.Location())
+			}
+		}
+	})
+}
+
 // ============================================================================
 // BENCHMARKS
 // ============================================================================
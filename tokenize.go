@@ -0,0 +1,53 @@
+package godateparser
+
+// Token is a span of text returned by Tokenize: either a date or the
+// literal text between dates.
+type Token struct {
+	// Text is the span of the input covered by the token
+	Text string
+
+	// Position is the byte offset of Text in the input
+	Position int
+
+	// IsDate is true when the token is a date; Date then holds its details
+	IsDate bool
+	Date   ParsedDate
+}
+
+// Tokenize splits text into date tokens and the literal text around them,
+// in order, so that concatenating every Token.Text gives back text. This
+// lets annotation tools highlight or link dates in place. Dates are found
+// with ExtractDates; when two matches overlap, the earlier one is kept.
+// If dates cannot be extracted (for example, text exceeds MaxInputLength),
+// the whole text is returned as a single literal token. Tokenize returns nil
+// for empty text. If opts is nil, DefaultSettings() is used.
+func Tokenize(text string, opts *Settings) []Token {
+	if text == "" {
+		return nil
+	}
+
+	dates, err := ExtractDates(text, opts)
+	if err != nil {
+		return []Token{{Text: text}}
+	}
+
+	// ExtractDates returns dates in position order
+	var tokens []Token
+	pos := 0
+	for _, date := range dates {
+		if date.Position < pos {
+			continue // overlaps the previous date
+		}
+		if date.Position > pos {
+			tokens = append(tokens, Token{Text: text[pos:date.Position], Position: pos})
+		}
+		end := date.Position + date.Length
+		tokens = append(tokens, Token{Text: text[date.Position:end], Position: date.Position, IsDate: true, Date: date})
+		pos = end
+	}
+	if pos < len(text) {
+		tokens = append(tokens, Token{Text: text[pos:], Position: pos})
+	}
+
+	return tokens
+}
@@ -0,0 +1,121 @@
+package godateparser
+
+import "time"
+
+// TraceEntry records the outcome of one step tried by Trace.
+type TraceEntry struct {
+	// Parser is the step name: a parser name as used in
+	// Settings.EnableParsers, one of the steps ParseDate runs before them
+	// ("city_timezone", "week_of", "offset_from", "countdown", "qualified",
+	// "approximate_time", "exact_time", "select_best"), or "range" for the
+	// RejectFuture, RejectPast, MinDate and MaxDate checks
+	Parser string
+
+	// Matched is true when the step accepted the input
+	Matched bool
+
+	// Result is the date the step produced when Matched is true
+	Result time.Time
+
+	// Err is the step's error when Matched is false
+	Err error
+
+	// Selected is true for the entry whose outcome ParseDate returns:
+	// the first match, the first invalid or ambiguous date error, or the
+	// error of a step run before the parsers or of the range check
+	Selected bool
+}
+
+// Trace runs every step ParseDate takes on input, in the same order and
+// after the same preprocessing, and reports what each one did. Unlike
+// ParseDate it does not stop at the first match, so it shows why an input
+// was parsed the way it was. Every enabled parser gets an entry; the steps
+// run before them, such as "week_of" for "week of March 3", and the "range"
+// check get one only when they handle the input or change the outcome.
+// Trace is a debugging aid and is slower than ParseDate. It returns nil
+// for empty or over-long input. If opts is nil, DefaultSettings() is used.
+func Trace(input string, opts *Settings) []TraceEntry {
+	if opts == nil {
+		opts = DefaultSettings()
+	}
+
+	input, err := preprocessInput(input, opts)
+	if err != nil || isBlank(input) {
+		return nil
+	}
+	rangeInput := input
+	if hasRangeChecks(opts) {
+		opts = pinRelativeBase(opts)
+	}
+
+	input, settings, err := prepareInput(input, opts)
+	if err != nil {
+		return nil
+	}
+
+	t := &tracer{selected: -1}
+	for _, step := range preParseSteps() {
+		if !step.enabled(settings) {
+			continue
+		}
+		result, ok, err := step.parse(input, opts, settings, nil)
+		if !ok && err == nil {
+			continue
+		}
+		t.record(step.name, inResultZone(result, settings), err, true)
+	}
+
+	for _, step := range parserChain {
+		if !isParserEnabled(settings, step.name) {
+			continue
+		}
+
+		// Each parser gets a fresh context, as state such as hasTime must not leak
+		result, err := step.parse(newChainContext(input, opts, settings, nil))
+		if err != nil {
+			err = withErrorInput(err, input)
+		}
+		t.record(step.name, inResultZone(result, settings), err, err == nil || isSpecificError(err))
+	}
+
+	if t.selected >= 0 && t.entries[t.selected].Matched && hasRangeChecks(opts) {
+		parsed := t.entries[t.selected].Result
+		result, err := checkDateRange(rangeInput, parsed, opts)
+		if err != nil || !result.Equal(parsed) {
+			t.entries[t.selected].Selected = false
+			t.selected = -1
+			t.record("range", result, err, true)
+		}
+	}
+
+	return t.entries
+}
+
+// inResultZone converts result to UTC when Settings.NormalizeToUTC is set,
+// as ParseDate does.
+func inResultZone(result time.Time, settings *Settings) time.Time {
+	if settings.NormalizeToUTC {
+		return result.UTC()
+	}
+	return result
+}
+
+// tracer collects the entries of a Trace.
+type tracer struct {
+	entries  []TraceEntry
+	selected int // index of the Selected entry, -1 before one is
+}
+
+// record appends the outcome of a step. If selectable is true and no
+// entry is selected yet, the new entry becomes the selected one.
+func (t *tracer) record(name string, result time.Time, err error, selectable bool) {
+	entry := TraceEntry{Parser: name, Matched: err == nil, Err: err}
+	if err == nil {
+		entry.Result = result
+	}
+	if t.selected < 0 && selectable {
+		entry.Selected = true
+		t.selected = len(t.entries)
+	}
+	t.entries = append(t.entries, entry)
+}
@@ -0,0 +1,105 @@
+package translations
+
+import (
+	"time"
+	"unicode"
+)
+
+// NewBengaliTranslation creates the Bengali (Bangladesh/India) language translation.
+func NewBengaliTranslation() *Language {
+	return &Language{
+		Code:             "bn",
+		Name:             "Bengali",
+		DecimalSeparator: ".",
+		DefaultDateOrder: "DMY",
+		Script:           unicode.Bengali,
+		// Bengali digits ০-৯, converted to ASCII before parsing
+		Numerals: map[rune]int{
+			'০': 0,
+			'১': 1,
+			'২': 2,
+			'৩': 3,
+			'৪': 4,
+			'৫': 5,
+			'৬': 6,
+			'৭': 7,
+			'৮': 8,
+			'৯': 9,
+		},
+		// "য়" may be precomposed (U+09DF) or য + nukta; both spellings are listed
+		Months: map[string]time.Month{
+			"জানুয়ারি":    time.January,
+			"জানুয়ারি":   time.January,
+			"জানুয়ারী":    time.January,
+			"জানুয়ারী":   time.January,
+			"ফেব্রুয়ারি":  time.February,
+			"ফেব্রুয়ারি": time.February,
+			"ফেব্রুয়ারী":  time.February,
+			"ফেব্রুয়ারী": time.February,
+			"মার্চ":       time.March,
+			"এপ্রিল":      time.April,
+			"মে":          time.May,
+			"জুন":         time.June,
+			"জুলাই":       time.July,
+			"আগস্ট":       time.August,
+			"আগষ্ট":       time.August,
+			"সেপ্টেম্বর":  time.September,
+			"অক্টোবর":     time.October,
+			"নভেম্বর":     time.November,
+			"ডিসেম্বর":    time.December,
+		},
+		Weekdays: map[string]time.Weekday{
+			"সোমবার":      time.Monday,
+			"মঙ্গলবার":    time.Tuesday,
+			"বুধবার":      time.Wednesday,
+			"বৃহস্পতিবার": time.Thursday,
+			"শুক্রবার":    time.Friday,
+			"শনিবার":      time.Saturday,
+			"রবিবার":      time.Sunday,
+			"রোববার":      time.Sunday,
+		},
+		// Day ordinals: ১লা, ২রা, ৪ঠা, ৫ই, ২১শে
+		OrdinalSuffixes:  []string{"লা", "রা", "ঠা", "ই", "শে"},
+		ListConjunctions: []string{"ও", "এবং"},
+		RelativeTerms: &RelativeTerms{
+			Yesterday:          "গতকাল",
+			Today:              "আজ",
+			Tomorrow:           "আগামীকাল",
+			Now:                "এখন",
+			DayAfterTomorrow:   []string{"আগামী পরশু"},
+			DayBeforeYesterday: []string{"গত পরশু"},
+			// Both follow the amount: "৩ দিন আগে" (3 days ago), "৩ দিন পরে" (in 3 days)
+			Ago:  []string{"আগে"},
+			In:   []string{"পরে"},
+			Next: []string{"আগামী"},
+			Last: []string{"গত"},
+			This: []string{"এই"},
+			// Time units, with the locative forms used after next/last ("আগামী সপ্তাহে")
+			Second:      []string{"সেকেন্ড"},
+			Minute:      []string{"মিনিট"},
+			Hour:        []string{"ঘণ্টা", "ঘন্টা"},
+			Day:         []string{"দিন", "দিনে"},
+			Week:        []string{"সপ্তাহ", "সপ্তাহে"},
+			Month:       []string{"মাস", "মাসে"},
+			Quarter:     []string{"ত্রৈমাসিক"},
+			Year:        []string{"বছর", "বছরে"},
+			Decade:      []string{"দশক"},
+			BusinessDay: []string{"কর্মদিবস"},
+			// Period boundaries
+			Beginning: []string{"শুরু"},
+			End:       []string{"শেষ"},
+			Start:     []string{"শুরু"},
+			First:     []string{"প্রথম"},
+			Since:     []string{"থেকে"},
+			Until:     []string{"পর্যন্ত"},
+		},
+		TimeTerms: &TimeTerms{
+			Noon:        []string{"দুপুর"},
+			Midnight:    []string{"মধ্যরাত"},
+			AM:          []string{"am", "a.m."},
+			PM:          []string{"pm", "p.m."},
+			Around:      []string{"প্রায়"},
+			AroundAfter: []string{"নাগাদ"},
+		},
+	}
+}
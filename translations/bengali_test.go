@@ -0,0 +1,81 @@
+package translations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coredds/godateparser"
+)
+
+// Tests for Bengali language support (bn)
+
+func TestBengali_Dates(t *testing.T) {
+	settings := &godateparser.Settings{Languages: []string{"bn"}}
+
+	tests := []struct {
+		input     string
+		wantMonth time.Month
+		wantDay   int
+		wantYear  int
+	}{
+		// Native digits are read as ASCII digits
+		{"১৫ জানুয়ারি ২০২৪", time.January, 15, 2024},
+		{"১লা জানুয়ারি ২০২৪", time.January, 1, 2024},
+		{"২১শে ফেব্রুয়ারি ২০২৪", time.February, 21, 2024},
+		{"ডিসেম্বর ২৫, ২০২৪", time.December, 25, 2024},
+		{"১৫/০১/২০২৪", time.January, 15, 2024},
+		{"২০২৪-০১-১৫", time.January, 15, 2024},
+		// ASCII digits work as well
+		{"15 আগস্ট 2024", time.August, 15, 2024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Month() != tt.wantMonth || result.Day() != tt.wantDay || result.Year() != tt.wantYear {
+				t.Errorf("godateparser.ParseDate(%q) = %v-%v-%v, want %v-%v-%v",
+					tt.input, result.Year(), result.Month(), result.Day(),
+					tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestBengali_Relative(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC) // Tuesday
+	settings := &godateparser.Settings{
+		Languages:    []string{"bn"},
+		RelativeBase: base,
+	}
+
+	tests := []struct {
+		input     string
+		wantMonth time.Month
+		wantDay   int
+	}{
+		{"গতকাল", time.October, 14},
+		{"আজ", time.October, 15},
+		{"আগামীকাল", time.October, 16},
+		{"৩ দিন আগে", time.October, 12},
+		{"৩ দিন পরে", time.October, 18},
+		{"আগামী সপ্তাহে", time.October, 21},
+		{"গত মাস", time.September, 1},
+		{"সোমবার", time.October, 21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Month() != tt.wantMonth || result.Day() != tt.wantDay {
+				t.Errorf("godateparser.ParseDate(%q) = %v %v, want %v %v",
+					tt.input, result.Month(), result.Day(), tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
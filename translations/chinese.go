@@ -7,8 +7,15 @@ import (
 // NewChineseTranslation creates the Chinese Simplified (China) language translation.
 func NewChineseTranslation() *Language {
 	return &Language{
-		Code: "zh",
-		Name: "Chinese",
+		Code:             "zh",
+		Name:             "Chinese",
+		DecimalSeparator: ".",
+		DefaultDateOrder: "YMD",
+		Numerals: map[rune]int{
+			'〇': 0, '零': 0, '一': 1, '二': 2, '两': 2, '三': 3, '四': 4,
+			'五': 5, '六': 6, '七': 7, '八': 8, '九': 9,
+			'十': 10, '百': 100, '千': 1000, '万': 10000,
+		},
 		Months: map[string]time.Month{
 			// Full names (numeric + 月)
 			"一月": time.January, "1月": time.January,
@@ -43,10 +50,15 @@ func NewChineseTranslation() *Language {
 			"日": time.Sunday, "天": time.Sunday,
 		},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "昨天",
-			Today:     "今天",
-			Tomorrow:  "明天",
-			Now:       "现在",
+			Yesterday:          "昨天",
+			Today:              "今天",
+			Tomorrow:           "明天",
+			YesterdayVariants:  []string{"昨日"},
+			TodayVariants:      []string{"今日"},
+			TomorrowVariants:   []string{"明日"},
+			Now:                "现在",
+			DayAfterTomorrow:   []string{"后天", "後天"},
+			DayBeforeYesterday: []string{"前天"},
 			// "前" for past (3天前 = 3 days ago)
 			Ago: []string{"前", "之前"},
 			// "后" for future (3天后 = in 3 days)
@@ -58,32 +70,44 @@ func NewChineseTranslation() *Language {
 			// "这" for this
 			This: []string{"这", "这个", "本"},
 			// Time units
-			Second:    []string{"秒", "秒钟"},
-			Minute:    []string{"分钟", "分"},
-			Hour:      []string{"小时", "个小时", "钟头"},
-			Day:       []string{"天", "日"},
-			Week:      []string{"周", "星期", "礼拜"},
-			Fortnight: []string{"两周", "两星期"},
-			Month:     []string{"月", "个月"},
-			Quarter:   []string{"季度", "季"},
-			Year:      []string{"年"},
-			Decade:    []string{"十年"},
+			Second:      []string{"秒", "秒钟"},
+			Minute:      []string{"分钟", "分"},
+			Hour:        []string{"小时", "个小时", "钟头"},
+			Day:         []string{"天", "日"},
+			Week:        []string{"周", "星期", "礼拜"},
+			Fortnight:   []string{"两周", "两星期"},
+			Month:       []string{"月", "个月"},
+			Quarter:     []string{"季度", "季"},
+			Year:        []string{"年"},
+			Decade:      []string{"十年"},
+			BusinessDay: []string{"工作日", "个工作日", "個工作日"},
 			// Period boundaries
 			Beginning: []string{"初", "开始", "始"},
 			End:       []string{"末", "底", "尾", "结束"},
 			Start:     []string{"初", "开始"},
 			First:     []string{"第一"},
+			Since:     []string{"以来", "之后", "以后", "起"},
+			Until:     []string{"之前", "以前", "为止"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:     []string{"每", "每个", "每逢"},
+			Alternate: []string{"隔一个", "隔个"},
+			Biweekly:  []string{"隔周", "每两周", "每隔一周", "双周"},
 		},
 		TimeTerms: &TimeTerms{
-			Noon:     []string{"中午", "正午"},
-			Midnight: []string{"午夜", "半夜", "凌晨"},
-			Quarter:  []string{"一刻", "刻"},
-			Half:     []string{"半"},
-			Past:     []string{},    // Chinese doesn't use "past" in the same way
-			To:       []string{"差"}, // 差10分3点 = 10 minutes to 3
-			OClock:   []string{"点", "点钟"},
-			AM:       []string{"上午", "早上", "凌晨"},
-			PM:       []string{"下午", "晚上", "傍晚"},
+			Noon:        []string{"中午", "正午"},
+			Midnight:    []string{"午夜", "半夜", "凌晨"},
+			Quarter:     []string{"一刻", "刻"},
+			Half:        []string{"半"},
+			Past:        []string{},    // Chinese doesn't use "past" in the same way
+			To:          []string{"差"}, // 差10分3点 = 10 minutes to 3
+			OClock:      []string{"点", "点钟"},
+			AM:          []string{"上午", "早上", "凌晨"},
+			PM:          []string{"下午", "晚上", "傍晚"},
+			Around:      []string{"大约", "大概", "约"},
+			AroundAfter: []string{"左右"},
+			Exact:       []string{"准时", "正好"},
+			ExactAfter:  []string{"整", "正"},
 		},
 	}
 }
@@ -0,0 +1,100 @@
+package translations
+
+import (
+	"time"
+)
+
+// NewCroatianTranslation creates the Croatian (Croatia) language translation.
+func NewCroatianTranslation() *Language {
+	return &Language{
+		Code:             "hr",
+		Name:             "Croatian",
+		DecimalSeparator: ",",
+		DefaultDateOrder: "DMY",
+		Months: map[string]time.Month{
+			// Nominative
+			"siječanj": time.January, "sijecanj": time.January,
+			"veljača": time.February, "veljaca": time.February,
+			"ožujak": time.March, "ozujak": time.March,
+			"travanj":  time.April,
+			"svibanj":  time.May,
+			"lipanj":   time.June,
+			"srpanj":   time.July,
+			"kolovoz":  time.August,
+			"rujan":    time.September,
+			"listopad": time.October,
+			"studeni":  time.November,
+			"prosinac": time.December,
+			// Genitive, as written in dates ("15. prosinca 2024.")
+			"siječnja": time.January, "sijecnja": time.January,
+			"veljače": time.February, "veljace": time.February,
+			"ožujka": time.March, "ozujka": time.March,
+			"travnja":   time.April,
+			"svibnja":   time.May,
+			"lipnja":    time.June,
+			"srpnja":    time.July,
+			"kolovoza":  time.August,
+			"rujna":     time.September,
+			"listopada": time.October,
+			"studenoga": time.November, "studenog": time.November,
+			"prosinca": time.December,
+		},
+		Weekdays: map[string]time.Weekday{
+			"ponedjeljak": time.Monday,
+			"utorak":      time.Tuesday,
+			"srijeda":     time.Wednesday, "srijedu": time.Wednesday,
+			"četvrtak": time.Thursday, "cetvrtak": time.Thursday,
+			"petak":  time.Friday,
+			"subota": time.Saturday, "subotu": time.Saturday,
+			"nedjelja": time.Sunday, "nedjelju": time.Sunday,
+		},
+		// Ijekavian spellings that Serbian (ekavian) and Slovenian write differently
+		Indicators:       []string{"jučer", "jucer", "prekjučer", "ponedjeljak", "srijeda", "srijedu", "nedjelja", "nedjelju", "prije", "tjedan", "tjedna", "mjesec", "mjeseca"},
+		OrdinalSuffixes:  []string{"."},
+		YearSuffix:       ".",
+		ListConjunctions: []string{"i"},
+		RelativeTerms: &RelativeTerms{
+			Yesterday:          "jučer",
+			Today:              "danas",
+			Tomorrow:           "sutra",
+			Now:                "sada",
+			DayAfterTomorrow:   []string{"prekosutra"},
+			DayBeforeYesterday: []string{"prekjučer", "prekjucer"},
+			Ago:                []string{"prije"},
+			In:                 []string{"za"},
+			// Adjectives agree with the unit's gender and case (sljedeći tjedan, sljedeća godina, prošle godine)
+			Next: []string{"sljedeći", "sljedeća", "sljedeće", "sljedećeg", "idući", "iduća", "iduće", "idućeg"},
+			Last: []string{"prošli", "prošla", "prošle", "prošlog", "prošloga", "prošlo"},
+			This: []string{"ovaj", "ova", "ovo", "ove", "ovog"},
+			// Time units with case forms
+			Second:      []string{"sekunda", "sekunde", "sekundi", "sekundu"},
+			Minute:      []string{"minuta", "minute", "minuti", "minutu"},
+			Hour:        []string{"sat", "sata", "sati"},
+			Day:         []string{"dan", "dana"},
+			Week:        []string{"tjedan", "tjedna", "tjedana"},
+			Fortnight:   []string{"dva tjedna"},
+			Month:       []string{"mjesec", "mjeseca", "mjeseci"},
+			Quarter:     []string{"tromjesečje", "tromjesečja", "kvartal", "kvartala"},
+			Year:        []string{"godina", "godine", "godinu", "godini"},
+			Decade:      []string{"desetljeće", "desetljeća"},
+			BusinessDay: []string{"radni dan", "radna dana", "radnih dana"},
+			// Period boundaries
+			Beginning: []string{"početak", "početka"},
+			End:       []string{"kraj", "kraja"},
+			Start:     []string{"početak", "početka"},
+			First:     []string{"prvi", "prva", "prvog"},
+			Since:     []string{"od"},
+			Until:     []string{"do"},
+			After:     []string{"nakon", "poslije"},
+			Before:    []string{"prije"},
+		},
+		TimeTerms: &TimeTerms{
+			Noon:     []string{"podne"},
+			Midnight: []string{"ponoć"},
+			AM:       []string{"am", "ujutro"},
+			PM:       []string{"pm", "popodne", "navečer"},
+			At:       []string{"u"},
+			Around:   []string{"oko", "otprilike u"},
+		},
+	}
+}
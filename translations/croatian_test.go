@@ -0,0 +1,86 @@
+package translations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coredds/godateparser"
+)
+
+// Tests for Croatian language support (hr)
+
+func TestCroatian_Months(t *testing.T) {
+	settings := &godateparser.Settings{Languages: []string{"hr"}}
+
+	tests := []struct {
+		input     string
+		wantMonth time.Month
+		wantDay   int
+		wantYear  int
+	}{
+		// Dates use the genitive month and a dot after the day and year
+		{"15. prosinca 2024.", time.December, 15, 2024},
+		{"1. siječnja 2025.", time.January, 1, 2025},
+		{"8. ožujka 2024", time.March, 8, 2024},
+		{"30. studenoga 2024.", time.November, 30, 2024},
+		{"30. studenog 2024", time.November, 30, 2024},
+		{"20. kolovoza 2024", time.August, 20, 2024},
+		// Nominative
+		{"prosinac 2024", time.December, 1, 2024},
+		{"listopad 2024", time.October, 1, 2024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Month() != tt.wantMonth || result.Day() != tt.wantDay || result.Year() != tt.wantYear {
+				t.Errorf("godateparser.ParseDate(%q) = %v-%v-%v, want %v-%v-%v",
+					tt.input, result.Year(), result.Month(), result.Day(),
+					tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestCroatian_Relative(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC) // Tuesday
+	settings := &godateparser.Settings{
+		Languages:    []string{"hr"},
+		RelativeBase: base,
+	}
+
+	tests := []struct {
+		input     string
+		wantYear  int
+		wantMonth time.Month
+		wantDay   int
+	}{
+		{"jučer", 2024, time.October, 14},
+		{"danas", 2024, time.October, 15},
+		{"sutra", 2024, time.October, 16},
+		{"prekosutra", 2024, time.October, 17},
+		{"prije 3 dana", 2024, time.October, 12},
+		{"za 2 tjedna", 2024, time.October, 28},
+		{"sljedeći tjedan", 2024, time.October, 21},
+		{"prošli mjesec", 2024, time.September, 1},
+		{"prošle godine", 2023, time.October, 15},
+		{"ponedjeljak", 2024, time.October, 21},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Year() != tt.wantYear || result.Month() != tt.wantMonth || result.Day() != tt.wantDay {
+				t.Errorf("godateparser.ParseDate(%q) = %v-%v-%v, want %v-%v-%v",
+					tt.input, result.Year(), result.Month(), result.Day(),
+					tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
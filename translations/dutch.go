@@ -7,8 +7,10 @@ import (
 // NewDutchTranslation creates the Dutch (Netherlands) language translation.
 func NewDutchTranslation() *Language {
 	return &Language{
-		Code: "nl",
-		Name: "Dutch",
+		Code:             "nl",
+		Name:             "Dutch",
+		DecimalSeparator: ",",
+		DefaultDateOrder: "DMY",
 		Months: map[string]time.Month{
 			// Full names
 			"januari":   time.January,
@@ -54,11 +56,23 @@ func NewDutchTranslation() *Language {
 			"za": time.Saturday,
 			"zo": time.Sunday,
 		},
+		// Ordinal day suffixes: 1e, 1ste, 2de
+		OrdinalSuffixes:  []string{"ste", "de", "e"},
+		DayArticles:      []string{"de", "op de"},
+		ListConjunctions: []string{"en"},
+		NumberWords: map[string]int{
+			"een": 1, "één": 1, "twee": 2, "drie": 3, "vier": 4, "vijf": 5, "zes": 6,
+			"zeven": 7, "acht": 8, "negen": 9, "tien": 10, "elf": 11, "twaalf": 12,
+			"dertien": 13, "veertien": 14, "vijftien": 15, "zestien": 16,
+			"zeventien": 17, "achttien": 18, "negentien": 19, "twintig": 20,
+		},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "gisteren",
-			Today:     "vandaag",
-			Tomorrow:  "morgen",
-			Now:       "nu",
+			Yesterday:          "gisteren",
+			Today:              "vandaag",
+			Tomorrow:           "morgen",
+			Now:                "nu",
+			DayAfterTomorrow:   []string{"overmorgen"},
+			DayBeforeYesterday: []string{"eergisteren"},
 			// "geleden" for past (2 dagen geleden = 2 days ago)
 			Ago: []string{"geleden"},
 			// "over" for future (over 3 weken = in 3 weeks)
@@ -70,21 +84,36 @@ func NewDutchTranslation() *Language {
 			// "deze" for this
 			This: []string{"deze", "dit"},
 			// Time units with plural forms
-			Second:    []string{"seconde", "seconden"},
-			Minute:    []string{"minuut", "minuten"},
-			Hour:      []string{"uur", "uren"},
-			Day:       []string{"dag", "dagen"},
-			Week:      []string{"week", "weken"},
-			Fortnight: []string{"veertien dagen", "twee weken"},
-			Month:     []string{"maand", "maanden"},
-			Quarter:   []string{"kwartaal", "kwartalen"},
-			Year:      []string{"jaar", "jaren"},
-			Decade:    []string{"decennium", "decennia", "tien jaar"},
+			Second:      []string{"seconde", "seconden"},
+			Minute:      []string{"minuut", "minuten"},
+			Hour:        []string{"uur", "uren"},
+			Day:         []string{"dag", "dagen"},
+			Week:        []string{"week", "weken"},
+			Fortnight:   []string{"veertien dagen", "twee weken"},
+			Month:       []string{"maand", "maanden"},
+			Quarter:     []string{"kwartaal", "kwartalen"},
+			Year:        []string{"jaar", "jaren"},
+			Decade:      []string{"decennium", "decennia", "tien jaar"},
+			BusinessDay: []string{"werkdag", "werkdagen"},
 			// Period boundaries
-			Beginning: []string{"begin", "start"},
-			End:       []string{"einde", "eind"},
-			Start:     []string{"begin", "start"},
-			First:     []string{"eerste"},
+			Beginning:  []string{"begin", "start"},
+			End:        []string{"einde", "eind"},
+			Start:      []string{"begin", "start"},
+			First:      []string{"eerste"},
+			Since:      []string{"sinds", "na", "vanaf"},
+			Until:      []string{"tot", "voor"},
+			Deadline:   []string{"uiterlijk op", "uiterlijk", "niet later dan"},
+			OnOrAbout:  []string{"op of omstreeks"},
+			After:      []string{"na"},
+			Before:     []string{"voor"},
+			Countdown:  []string{"tot"},
+			Few:        []string{"een paar", "enkele", "een aantal", "verscheidene"},
+			WindowLead: []string{"in de", "binnen de", "de"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:     []string{"elke", "iedere"},
+			Alternate: []string{"tweede", "andere"},
+			Biweekly:  []string{"tweewekelijks", "om de week", "om de twee weken", "elke twee weken"},
 		},
 		TimeTerms: &TimeTerms{
 			Noon:     []string{"middag", "twaalf uur 's middags"},
@@ -98,6 +127,16 @@ func NewDutchTranslation() *Language {
 			OClock: []string{"uur"},
 			AM:     []string{"am", "a.m.", "'s ochtends", "'s morgens", "ochtend", "morgen"},
 			PM:     []string{"pm", "p.m.", "'s middags", "'s avonds", "'s nachts", "middag", "avond", "nacht"},
+			At:     []string{"om"},
+			// "half vier" is 3:30
+			HalfToNext: true,
+			Around:     []string{"rond", "omstreeks", "ongeveer om", "tegen"},
+			Exact:      []string{"precies", "exact", "stipt"},
+			ExactAfter: []string{"precies", "stipt"},
+			DayParts: map[string]string{
+				"lunchtijd": "12:30", "theetijd": "16:00", "etenstijd": "18:00",
+				"zonsopgang": "06:00", "zonsondergang": "18:00", "schemering": "18:00",
+			},
 		},
 	}
 }
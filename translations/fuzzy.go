@@ -0,0 +1,167 @@
+package translations
+
+import (
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// fuzzyMinLength is the shortest word, in runes, that fuzzy matching
+// considers. Shorter words are too close to other words ("Mar" and "May",
+// "Jun" and "Jul") to correct safely.
+const fuzzyMinLength = 5
+
+// ParseMonthFuzzy is like ParseMonth but also accepts a month name with a
+// single typo: one inserted, deleted, substituted or swapped letter
+// ("Decembr", "Setpember"). Words shorter than five letters must match
+// exactly, and a typo that is equally close to two different months is
+// rejected.
+func ParseMonthFuzzy(input string, languages ...*Language) (time.Month, bool) {
+	if month, ok := ParseMonth(input, languages...); ok {
+		return month, true
+	}
+	name, ok := closestName(strings.ToLower(strings.TrimSpace(input)), languages, false)
+	if !ok {
+		return 0, false
+	}
+	return ParseMonth(name, languages...)
+}
+
+// ParseWeekdayFuzzy is like ParseWeekday but also accepts a weekday name
+// with a single typo ("Wendesday", "Thurday"), under the same rules as
+// ParseMonthFuzzy.
+func ParseWeekdayFuzzy(input string, languages ...*Language) (time.Weekday, bool) {
+	if weekday, ok := ParseWeekday(input, languages...); ok {
+		return weekday, true
+	}
+	name, ok := closestName(strings.ToLower(strings.TrimSpace(input)), languages, true)
+	if !ok {
+		return 0, false
+	}
+	return ParseWeekday(name, languages...)
+}
+
+// CorrectNameTypos rewrites every word of input that is a single typo away
+// from a month or weekday name of the given languages into that name, so
+// "Decembr 25" becomes "december 25". Words that already are a known name,
+// are shorter than five letters, or are equally close to two different
+// months or weekdays are left alone.
+func CorrectNameTypos(input string, languages ...*Language) string {
+	var b strings.Builder
+	b.Grow(len(input))
+
+	for i := 0; i < len(input); {
+		r, size := utf8.DecodeRuneInString(input[i:])
+		if !unicode.IsLetter(r) {
+			b.WriteRune(r)
+			i += size
+			continue
+		}
+
+		j := i
+		for j < len(input) {
+			r, size := utf8.DecodeRuneInString(input[j:])
+			if !unicode.IsLetter(r) {
+				break
+			}
+			j += size
+		}
+		b.WriteString(correctWord(input[i:j], languages))
+		i = j
+	}
+
+	return b.String()
+}
+
+// correctWord returns the month or weekday name word is a typo of, or word.
+func correctWord(word string, languages []*Language) string {
+	lower := strings.ToLower(word)
+	if _, ok := ParseMonth(lower, languages...); ok {
+		return word
+	}
+	if _, ok := ParseWeekday(lower, languages...); ok {
+		return word
+	}
+
+	month, monthOK := closestName(lower, languages, false)
+	weekday, weekdayOK := closestName(lower, languages, true)
+	switch {
+	case monthOK && !weekdayOK:
+		return month
+	case weekdayOK && !monthOK:
+		return weekday
+	}
+	return word
+}
+
+// closestName finds the month (or weekday) name one edit away from word.
+// It reports false if there is none, or if names of different months
+// (weekdays) are equally close.
+func closestName(word string, languages []*Language, weekdays bool) (string, bool) {
+	if utf8.RuneCountInString(word) < fuzzyMinLength {
+		return "", false
+	}
+
+	found := ""
+	value := -1
+	for _, lang := range languages {
+		names := make(map[string]int)
+		if weekdays {
+			for name, weekday := range lang.Weekdays {
+				names[name] = int(weekday)
+			}
+		} else {
+			for name, month := range lang.Months {
+				names[name] = int(month)
+			}
+		}
+
+		for name, v := range names {
+			if utf8.RuneCountInString(name) < fuzzyMinLength || editDistance(word, name) > 1 {
+				continue
+			}
+			if value >= 0 && v != value {
+				return "", false // ambiguous
+			}
+			if found == "" || name < found {
+				found = name // pick deterministically among spellings of one value
+			}
+			value = v
+		}
+	}
+
+	return found, found != ""
+}
+
+// editDistance returns the optimal string alignment distance between a and
+// b: the number of rune insertions, deletions, substitutions and adjacent
+// transpositions needed to turn one into the other.
+func editDistance(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+
+	// Three rolling rows: two rows back (for transpositions), previous and current
+	prev2 := make([]int, len(rb)+1)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+			if i > 1 && j > 1 && ra[i-1] == rb[j-2] && ra[i-2] == rb[j-1] {
+				curr[j] = min(curr[j], prev2[j-2]+1)
+			}
+		}
+		prev2, prev, curr = prev, curr, prev2
+	}
+
+	return prev[len(rb)]
+}
@@ -0,0 +1,87 @@
+package translations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coredds/godateparser/translations"
+)
+
+func TestParseMonthFuzzy(t *testing.T) {
+	english := translations.NewEnglishTranslation()
+	spanish := translations.NewSpanishTranslation()
+
+	tests := []struct {
+		input  string
+		want   time.Month
+		wantOK bool
+	}{
+		{"December", time.December, true},
+		{"Decembr", time.December, true},
+		{"Decemberr", time.December, true},
+		{"Decenber", time.December, true},
+		{"Setpember", time.September, true},
+		{"diciembr", time.December, true},
+		{"Dcembr", 0, false},
+		{"Dezembre", 0, false},
+		{"Jnue", 0, false}, // too short to correct
+		{"Mayo", time.May, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := translations.ParseMonthFuzzy(tt.input, english, spanish)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ParseMonthFuzzy(%q) = %v, %v, want %v, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestParseWeekdayFuzzy(t *testing.T) {
+	english := translations.NewEnglishTranslation()
+
+	tests := []struct {
+		input  string
+		want   time.Weekday
+		wantOK bool
+	}{
+		{"Wendesday", time.Wednesday, true},
+		{"Thurday", time.Thursday, true},
+		{"Fridya", time.Friday, true},
+		{"Wensday", 0, false},
+		{"Thrsdy", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, ok := translations.ParseWeekdayFuzzy(tt.input, english)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("ParseWeekdayFuzzy(%q) = %v, %v, want %v, %v", tt.input, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestCorrectNameTypos(t *testing.T) {
+	english := translations.NewEnglishTranslation()
+
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"Decembr 25, 2024", "december 25, 2024"},
+		{"next Wendesday at 3pm", "next wednesday at 3pm"},
+		{"December 25, 2024", "December 25, 2024"},
+		{"3 days ago", "3 days ago"},
+		{"Dcembr 25", "Dcembr 25"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := translations.CorrectNameTypos(tt.input, english); got != tt.want {
+				t.Errorf("CorrectNameTypos(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
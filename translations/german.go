@@ -7,8 +7,10 @@ import (
 // NewGermanTranslation creates the German (Germany) language translation.
 func NewGermanTranslation() *Language {
 	return &Language{
-		Code: "de",
-		Name: "German",
+		Code:             "de",
+		Name:             "German",
+		DecimalSeparator: ",",
+		DefaultDateOrder: "DMY",
 		Months: map[string]time.Month{
 			// Full names
 			"januar":  time.January,
@@ -54,11 +56,26 @@ func NewGermanTranslation() *Language {
 			"sa": time.Saturday,
 			"so": time.Sunday,
 		},
+		// Ordinal day suffix: 1. März
+		OrdinalSuffixes:  []string{"."},
+		DayArticles:      []string{"am", "den", "der"},
+		ListConjunctions: []string{"und"},
+		NumberWords: map[string]int{
+			"eins": 1, "ein": 1, "zwei": 2, "drei": 3, "vier": 4, "fünf": 5, "fuenf": 5,
+			"sechs": 6, "sieben": 7, "acht": 8, "neun": 9, "zehn": 10, "elf": 11,
+			"zwölf": 12, "zwoelf": 12, "dreizehn": 13, "vierzehn": 14, "fünfzehn": 15,
+			"sechzehn": 16, "siebzehn": 17, "achtzehn": 18, "neunzehn": 19, "zwanzig": 20,
+			"einundzwanzig": 21, "zweiundzwanzig": 22, "dreiundzwanzig": 23,
+			"vierundzwanzig": 24, "fünfundzwanzig": 25, "sechsundzwanzig": 26,
+			"siebenundzwanzig": 27, "achtundzwanzig": 28, "neunundzwanzig": 29,
+		},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "gestern",
-			Today:     "heute",
-			Tomorrow:  "morgen",
-			Now:       "jetzt",
+			Yesterday:          "gestern",
+			Today:              "heute",
+			Tomorrow:           "morgen",
+			Now:                "jetzt",
+			DayAfterTomorrow:   []string{"übermorgen", "uebermorgen"},
+			DayBeforeYesterday: []string{"vorgestern"},
 			// "vor" for past (vor 2 Tagen = 2 days ago)
 			Ago: []string{"vor"},
 			// "in" for future (in 3 Wochen = in 3 weeks)
@@ -70,21 +87,38 @@ func NewGermanTranslation() *Language {
 			// Gender variations for "this" (dieser/diese/dieses)
 			This: []string{"dieser", "diese", "dieses"},
 			// Time units with plural forms
-			Second:    []string{"sekunde", "sekunden"},
-			Minute:    []string{"minute", "minuten"},
-			Hour:      []string{"stunde", "stunden"},
-			Day:       []string{"tag", "tage", "tagen"},
-			Week:      []string{"woche", "wochen"},
-			Fortnight: []string{"vierzehn tage", "zwei wochen"},
-			Month:     []string{"monat", "monate", "monaten"},
-			Quarter:   []string{"quartal", "quartale"},
-			Year:      []string{"jahr", "jahre", "jahren"},
-			Decade:    []string{"jahrzehnt", "jahrzehnte", "dekade", "dekaden"},
+			Second:      []string{"sekunde", "sekunden"},
+			Minute:      []string{"minute", "minuten"},
+			Hour:        []string{"stunde", "stunden"},
+			Day:         []string{"tag", "tage", "tagen"},
+			Week:        []string{"woche", "wochen"},
+			Fortnight:   []string{"vierzehn tage", "zwei wochen"},
+			Month:       []string{"monat", "monate", "monaten"},
+			Quarter:     []string{"quartal", "quartale", "quartalen"},
+			Year:        []string{"jahr", "jahre", "jahren"},
+			Decade:      []string{"jahrzehnt", "jahrzehnte", "jahrzehnten", "dekade", "dekaden"},
+			BusinessDay: []string{"werktag", "werktage", "werktagen", "arbeitstag", "arbeitstage", "arbeitstagen"},
 			// Period boundaries
-			Beginning: []string{"anfang", "beginn", "start"},
-			End:       []string{"ende", "schluss"},
-			Start:     []string{"anfang", "beginn", "start"},
-			First:     []string{"erster", "erste", "erstes"},
+			Beginning:  []string{"anfang", "beginn", "start"},
+			End:        []string{"ende", "schluss"},
+			Start:      []string{"anfang", "beginn", "start"},
+			First:      []string{"erster", "erste", "erstes"},
+			Since:      []string{"seit", "nach", "ab"},
+			Until:      []string{"bis", "vor"},
+			Deadline:   []string{"bis spätestens", "spätestens am", "spätestens"},
+			OnOrAbout:  []string{"am oder um den", "um den"},
+			After:      []string{"nach"},
+			Before:     []string{"vor"},
+			Countdown:  []string{"bis"},
+			Few:        []string{"ein paar", "einige", "mehrere"},
+			WindowNext: []string{"nächsten", "naechsten", "kommenden"},
+			WindowLast: []string{"letzten", "vergangenen"},
+			WindowLead: []string{"in den", "während der", "innerhalb der", "die", "den"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:     []string{"jeden", "jede", "jedes", "alle"},
+			Alternate: []string{"zweiten", "zweite"},
+			Biweekly:  []string{"zweiwöchentlich", "zweiwöchig", "vierzehntägig", "alle zwei wochen", "alle 14 tage"},
 		},
 		TimeTerms: &TimeTerms{
 			Noon:     []string{"mittag", "12 uhr mittags"},
@@ -98,6 +132,16 @@ func NewGermanTranslation() *Language {
 			OClock: []string{"uhr"},
 			AM:     []string{"uhr", "morgens", "vormittags"},
 			PM:     []string{"uhr", "nachmittags", "abends", "nachts"},
+			At:     []string{"um"},
+			// "halb zehn" is 9:30
+			HalfToNext: true,
+			Around:     []string{"gegen", "ungefähr um", "etwa um", "circa", "ca."},
+			Exact:      []string{"genau", "exakt", "pünktlich", "punkt"},
+			ExactAfter: []string{"genau", "pünktlich"},
+			DayParts: map[string]string{
+				"mittagszeit": "12:00", "kaffeezeit": "15:00", "teezeit": "16:00", "abendbrotzeit": "18:30",
+				"morgengrauen": "06:00", "sonnenaufgang": "06:00", "abenddämmerung": "18:00", "sonnenuntergang": "18:00",
+			},
 		},
 	}
 }
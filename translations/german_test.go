@@ -181,8 +181,8 @@ func TestGerman_RelativeIn(t *testing.T) {
 	}{
 		{"in 1 Tag", "in 1 Tag", 2024, 16},
 		{"in 2 Tagen", "in 2 Tagen", 2024, 17},
-		{"in 1 Woche", "in 1 Woche", 2024, 22},
-		{"in 2 Wochen", "in 2 Wochen", 2024, 29},
+		{"in 1 Woche", "in 1 Woche", 2024, 17},
+		{"in 2 Wochen", "in 2 Wochen", 2024, 24},
 	}
 
 	for _, tt := range tests {
@@ -216,12 +216,12 @@ func TestGerman_RelativeNextLast(t *testing.T) {
 		wantMonth time.Month
 		wantDay   int
 	}{
-		{"nächste Woche", "nächste Woche", 2024, time.June, 22},
-		{"letzte Woche", "letzte Woche", 2024, time.June, 8},
-		{"nächster Monat", "nächster Monat", 2024, time.July, 15},
-		{"letzter Monat", "letzter Monat", 2024, time.May, 15},
+		{"nächste Woche", "nächste Woche", 2024, time.June, 17},
+		{"letzte Woche", "letzte Woche", 2024, time.June, 3},
+		{"nächster Monat", "nächster Monat", 2024, time.July, 1},
+		{"letzter Monat", "letzter Monat", 2024, time.May, 1},
 		// Without umlaut
-		{"naechste Woche", "naechste Woche", 2024, time.June, 22},
+		{"naechste Woche", "naechste Woche", 2024, time.June, 17},
 	}
 
 	for _, tt := range tests {
@@ -298,6 +298,14 @@ func TestGerman_TimeExpressions(t *testing.T) {
 		{"Mittag", "Mittag", 12, 0},
 		{"Mitternacht", "Mitternacht", 0, 0},
 		{"15:30", "15:30", 15, 30},
+		{"halb zehn", "halb zehn", 9, 30},
+		{"halb eins", "halb eins", 12, 30},
+		{"fünf vor halb zehn", "fünf vor halb zehn", 9, 25},
+		{"fünf nach halb zehn", "fünf nach halb zehn", 9, 35},
+		{"zehn nach sechs", "zehn nach sechs", 6, 10},
+		{"zwanzig vor acht", "zwanzig vor acht", 7, 40},
+		{"viertel nach drei", "viertel nach drei", 3, 15},
+		{"viertel vor drei", "viertel vor drei", 2, 45},
 	}
 
 	for _, tt := range tests {
@@ -446,7 +454,7 @@ func TestGerman_WithoutUmlaut(t *testing.T) {
 		wantDay   int
 	}{
 		{"Marz instead of März", "15 Marz 2024", 2024, time.March, 15},
-		{"naechste instead of nächste", "naechste Woche", 2024, time.June, 22},
+		{"naechste instead of nächste", "naechste Woche", 2024, time.June, 17},
 	}
 
 	for _, tt := range tests {
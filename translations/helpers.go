@@ -1,13 +1,17 @@
 package translations
 
 import (
+	"sort"
 	"strings"
 	"time"
+	"unicode"
 )
 
 // ParseMonth attempts to parse a month name in any supported language.
+// A single trailing period is ignored, so "Dec." and "dic." are months.
 func ParseMonth(input string, languages ...*Language) (time.Month, bool) {
-	input = strings.ToLower(strings.TrimSpace(input))
+	input = strings.ToLower(strings.TrimSpace(NormalizeSpaces(NormalizeWidth(input))))
+	input = strings.TrimSuffix(input, ".")
 
 	for _, lang := range languages {
 		if month, ok := lang.Months[input]; ok {
@@ -18,8 +22,10 @@ func ParseMonth(input string, languages ...*Language) (time.Month, bool) {
 }
 
 // ParseWeekday attempts to parse a weekday name in any supported language.
+// A single trailing period is ignored, so "Mon." and "lun." are weekdays.
 func ParseWeekday(input string, languages ...*Language) (time.Weekday, bool) {
-	input = strings.ToLower(strings.TrimSpace(input))
+	input = strings.ToLower(strings.TrimSpace(NormalizeSpaces(NormalizeWidth(input))))
+	input = strings.TrimSuffix(input, ".")
 
 	for _, lang := range languages {
 		if weekday, ok := lang.Weekdays[input]; ok {
@@ -29,12 +35,203 @@ func ParseWeekday(input string, languages ...*Language) (time.Weekday, bool) {
 	return 0, false
 }
 
+// ParseNativeNumber parses a number written in the language's native numerals,
+// e.g. "三" (3), "十五" (15) or "二十四" (24) in Chinese and Japanese. Runs of
+// digits without multipliers are read positionally ("二〇二四" is 2024).
+// It reports false if the language has no native numerals or s contains
+// any other character.
+func ParseNativeNumber(s string, lang *Language) (int, bool) {
+	if lang == nil || len(lang.Numerals) == 0 || s == "" {
+		return 0, false
+	}
+
+	// total holds completed 万 groups, section the current group, digits the pending digits
+	total, section, digits := 0, 0, -1
+	for _, r := range s {
+		value, ok := lang.Numerals[r]
+		if !ok {
+			return 0, false
+		}
+
+		switch {
+		case value < 10:
+			if digits < 0 {
+				digits = 0
+			}
+			digits = digits*10 + value
+		case value < 10000:
+			if digits < 0 {
+				digits = 1 // "十五": a bare multiplier counts once
+			}
+			section += digits * value
+			digits = -1
+		default:
+			section += max(digits, 0)
+			if section == 0 {
+				section = 1
+			}
+			total += section * value
+			section, digits = 0, -1
+		}
+	}
+
+	return total + section + max(digits, 0), true
+}
+
+// ParseNumberWord parses a number spelled out in the language's NumberWords,
+// e.g. "ten" (10) or "fünfundzwanzig" (25). A multiple of ten followed by a
+// unit, with a hyphen or a space, is added up: "twenty-five" is 25. It
+// reports false if the language has no number words or s is not one.
+func ParseNumberWord(s string, lang *Language) (int, bool) {
+	if lang == nil || len(lang.NumberWords) == 0 {
+		return 0, false
+	}
+	s = strings.ToLower(strings.TrimSpace(s))
+	if n, ok := lang.NumberWords[s]; ok {
+		return n, true
+	}
+
+	tensWord, unitWord, ok := strings.Cut(strings.ReplaceAll(s, "-", " "), " ")
+	if !ok {
+		return 0, false
+	}
+	tens, ok := lang.NumberWords[tensWord]
+	if !ok || tens < 20 || tens%10 != 0 {
+		return 0, false
+	}
+	unit, ok := lang.NumberWords[strings.TrimSpace(unitWord)]
+	if !ok || unit < 1 || unit > 9 {
+		return 0, false
+	}
+	return tens + unit, true
+}
+
+// NormalizeSpaces replaces non-ASCII spaces, such as the no-break space
+// (U+00A0), the narrow no-break space (U+202F) and the ideographic space
+// (U+3000), with ASCII spaces, so "31\u00A0December" matches like "31 December".
+func NormalizeSpaces(input string) string {
+	return strings.Map(func(r rune) rune {
+		if r > unicode.MaxASCII && unicode.IsSpace(r) {
+			return ' '
+		}
+		return r
+	}, input)
+}
+
+// StripNamePeriods removes the period after every month or weekday name of
+// the given languages written as an abbreviation with a trailing period, so
+// "Mon., Dec. 30, 2024" becomes "Mon, Dec 30, 2024". Periods after other
+// words and after numbers, as in "31.12.", are kept.
+func StripNamePeriods(input string, languages ...*Language) string {
+	var b strings.Builder
+	b.Grow(len(input))
+
+	wordStart := -1
+	for i, r := range input {
+		if unicode.IsLetter(r) {
+			if wordStart < 0 {
+				wordStart = i
+			}
+			b.WriteRune(r)
+			continue
+		}
+		if r == '.' && wordStart >= 0 && isNameWord(input[wordStart:i], languages) {
+			wordStart = -1
+			continue
+		}
+		wordStart = -1
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// isNameWord reports whether word is a month or weekday name.
+func isNameWord(word string, languages []*Language) bool {
+	if _, ok := ParseMonth(word, languages...); ok {
+		return true
+	}
+	_, ok := ParseWeekday(word, languages...)
+	return ok
+}
+
+// NormalizeWidth replaces full-width ASCII characters, common in Chinese and
+// Japanese text, with their ASCII forms: "２０２４／１２／３１" becomes
+// "2024/12/31" and "１５：３０" becomes "15:30".
+func NormalizeWidth(input string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= '！' && r <= '～' {
+			return r - '！' + '!'
+		}
+		return r
+	}, input)
+}
+
+// NormalizeDigits replaces the native digits of the given languages with
+// ASCII digits, e.g. "১৫" becomes "15" in Bengali. Only positional digit sets
+// are converted; languages whose numerals include multipliers, such as the
+// Chinese "十", are skipped as their numbers are not digit strings.
+func NormalizeDigits(input string, languages ...*Language) string {
+	var digits map[rune]int
+	for _, lang := range languages {
+		if !hasPositionalDigits(lang) {
+			continue
+		}
+		if digits == nil {
+			digits = make(map[rune]int)
+		}
+		for r, value := range lang.Numerals {
+			digits[r] = value
+		}
+	}
+	if digits == nil {
+		return input
+	}
+
+	return strings.Map(func(r rune) rune {
+		if value, ok := digits[r]; ok {
+			return '0' + rune(value)
+		}
+		return r
+	}, input)
+}
+
+// hasPositionalDigits reports whether lang's numerals are plain digits 0-9.
+func hasPositionalDigits(lang *Language) bool {
+	if lang == nil || len(lang.Numerals) == 0 {
+		return false
+	}
+	for _, value := range lang.Numerals {
+		if value >= 10 {
+			return false
+		}
+	}
+	return true
+}
+
+// NativeNumeralPattern builds a regex character class matching the language's
+// native numeral characters, or "" if it has none.
+func NativeNumeralPattern(lang *Language) string {
+	if lang == nil || len(lang.Numerals) == 0 {
+		return ""
+	}
+
+	chars := make([]string, 0, len(lang.Numerals))
+	for r := range lang.Numerals {
+		chars = append(chars, string(r))
+	}
+	sort.Strings(chars)
+	return "[" + strings.Join(chars, "") + "]"
+}
+
 // MatchesRelativeTerm checks if input matches any relative term in the given category.
+// Terms may be single words ("übermorgen") or phrases ("the day after
+// tomorrow"); runs of whitespace in input and terms compare equal.
 func MatchesRelativeTerm(input string, terms []string) bool {
-	input = strings.ToLower(strings.TrimSpace(input))
+	input = strings.Join(strings.Fields(strings.ToLower(input)), " ")
 
 	for _, term := range terms {
-		if term != "" && strings.EqualFold(term, input) {
+		if term != "" && strings.EqualFold(strings.Join(strings.Fields(term), " "), input) {
 			return true
 		}
 	}
@@ -61,25 +258,13 @@ func BuildTimeUnitPattern(languages []*Language) string {
 		if lang.RelativeTerms == nil {
 			continue
 		}
-
-		addUnits := func(terms []string) {
-			for _, term := range terms {
-				if term != "" {
-					units[term] = true
+		for _, unit := range lang.RelativeTerms.Units() {
+			for _, form := range unit.Forms {
+				if form != "" {
+					units[form] = true
 				}
 			}
 		}
-
-		addUnits(lang.RelativeTerms.Second)
-		addUnits(lang.RelativeTerms.Minute)
-		addUnits(lang.RelativeTerms.Hour)
-		addUnits(lang.RelativeTerms.Day)
-		addUnits(lang.RelativeTerms.Week)
-		addUnits(lang.RelativeTerms.Fortnight)
-		addUnits(lang.RelativeTerms.Month)
-		addUnits(lang.RelativeTerms.Quarter)
-		addUnits(lang.RelativeTerms.Year)
-		addUnits(lang.RelativeTerms.Decade)
 	}
 
 	// Build pattern
@@ -94,7 +279,9 @@ func BuildTimeUnitPattern(languages []*Language) string {
 	return pattern
 }
 
-// NormalizeTimeUnit normalizes a time unit string to English for internal processing.
+// NormalizeTimeUnit normalizes a time unit string to English for internal
+// processing. Every form listed for a unit maps to it, so the Russian
+// "день", "дня" and "дней" (1, 2-4 and 5+ days) all give "day".
 func NormalizeTimeUnit(input string, languages []*Language) string {
 	input = strings.ToLower(strings.TrimSpace(input))
 
@@ -102,45 +289,10 @@ func NormalizeTimeUnit(input string, languages []*Language) string {
 		if lang.RelativeTerms == nil {
 			continue
 		}
-
-		checkUnit := func(terms []string, _ string) bool {
-			for _, term := range terms {
-				if strings.EqualFold(term, input) {
-					return true
-				}
+		for _, unit := range lang.RelativeTerms.Units() {
+			if MatchesRelativeTerm(input, unit.Forms) {
+				return unit.Unit
 			}
-			return false
-		}
-
-		if checkUnit(lang.RelativeTerms.Second, "second") {
-			return "second"
-		}
-		if checkUnit(lang.RelativeTerms.Minute, "minute") {
-			return "minute"
-		}
-		if checkUnit(lang.RelativeTerms.Hour, "hour") {
-			return "hour"
-		}
-		if checkUnit(lang.RelativeTerms.Day, "day") {
-			return "day"
-		}
-		if checkUnit(lang.RelativeTerms.Week, "week") {
-			return "week"
-		}
-		if checkUnit(lang.RelativeTerms.Fortnight, "fortnight") {
-			return "fortnight"
-		}
-		if checkUnit(lang.RelativeTerms.Month, "month") {
-			return "month"
-		}
-		if checkUnit(lang.RelativeTerms.Quarter, "quarter") {
-			return "quarter"
-		}
-		if checkUnit(lang.RelativeTerms.Year, "year") {
-			return "year"
-		}
-		if checkUnit(lang.RelativeTerms.Decade, "decade") {
-			return "decade"
 		}
 	}
 
@@ -188,3 +340,47 @@ func GetMonthPattern(languages []*Language) string {
 
 	return pattern
 }
+
+// ParseLanguageTag splits a BCP 47 language tag such as "en-GB", "pt_BR" or
+// "zh-Hant-TW" into its lowercase language code and its uppercase region
+// ("" if the tag has none). Script and variant subtags are dropped, so
+// "zh-Hant" is plain "zh".
+func ParseLanguageTag(tag string) (code, region string) {
+	subtags := strings.FieldsFunc(tag, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 0 {
+		return "", ""
+	}
+
+	code = strings.ToLower(subtags[0])
+	for _, subtag := range subtags[1:] {
+		// Regions are two letters ("GB") or three digits ("419")
+		if len(subtag) == 2 || (len(subtag) == 3 && subtag[0] >= '0' && subtag[0] <= '9') {
+			region = strings.ToUpper(subtag)
+			break
+		}
+	}
+	return code, region
+}
+
+// Numeric date orders of regions that do not write day first
+var regionDateOrders = map[string]string{
+	// Month first
+	"US": "MDY", "PH": "MDY", "FM": "MDY", "MH": "MDY", "PW": "MDY",
+	"GU": "MDY", "AS": "MDY", "MP": "MDY", "VI": "MDY", "UM": "MDY",
+	// Year first
+	"CN": "YMD", "TW": "YMD", "JP": "YMD", "KR": "YMD", "KP": "YMD",
+	"MN": "YMD", "HU": "YMD", "LT": "YMD",
+}
+
+// RegionDateOrder returns the numeric date order customary in region:
+// "MDY" for the United States ("US"), "YMD" for China ("CN"), "DMY" for
+// other regions such as Great Britain ("GB"), and "" for an empty region.
+func RegionDateOrder(region string) string {
+	if region == "" {
+		return ""
+	}
+	if order, ok := regionDateOrders[strings.ToUpper(region)]; ok {
+		return order
+	}
+	return "DMY"
+}
@@ -1,6 +1,7 @@
 package translations_test
 
 import (
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -36,6 +37,33 @@ func TestParseMonth(t *testing.T) {
 			wantMonth: time.December,
 			wantOK:    true,
 		},
+		{
+			name:      "English abbreviation with period",
+			input:     "Dec.",
+			languages: []*translations.Language{english},
+			wantMonth: time.December,
+			wantOK:    true,
+		},
+		{
+			name:      "Spanish abbreviation with period",
+			input:     "dic.",
+			languages: []*translations.Language{spanish},
+			wantMonth: time.December,
+			wantOK:    true,
+		},
+		{
+			name:      "French abbreviation with period",
+			input:     "déc.",
+			languages: []*translations.Language{french},
+			wantMonth: time.December,
+			wantOK:    true,
+		},
+		{
+			name:      "only a single period is stripped",
+			input:     "Dec..",
+			languages: []*translations.Language{english},
+			wantOK:    false,
+		},
 		{
 			name:      "English lowercase",
 			input:     "december",
@@ -130,6 +158,27 @@ func TestParseWeekday(t *testing.T) {
 			wantWeekday: time.Monday,
 			wantOK:      true,
 		},
+		{
+			name:        "English abbreviation with period",
+			input:       "Mon.",
+			languages:   []*translations.Language{english},
+			wantWeekday: time.Monday,
+			wantOK:      true,
+		},
+		{
+			name:        "Spanish abbreviation with period",
+			input:       "mié.",
+			languages:   []*translations.Language{spanish},
+			wantWeekday: time.Wednesday,
+			wantOK:      true,
+		},
+		{
+			name:        "Russian abbreviation with period",
+			input:       "пн.",
+			languages:   []*translations.Language{russian},
+			wantWeekday: time.Monday,
+			wantOK:      true,
+		},
 		{
 			name:        "English abbreviated weekday",
 			input:       "Mon",
@@ -210,6 +259,124 @@ func TestParseWeekday(t *testing.T) {
 	}
 }
 
+// Test ParseNativeNumber
+
+func TestParseNativeNumber(t *testing.T) {
+	chinese := translations.NewChineseTranslation()
+	japanese := translations.NewJapaneseTranslation()
+	english := translations.NewEnglishTranslation()
+	bengali := translations.NewBengaliTranslation()
+
+	tests := []struct {
+		name   string
+		input  string
+		lang   *translations.Language
+		want   int
+		wantOK bool
+	}{
+		{"single digit", "三", japanese, 3, true},
+		{"bare ten", "十", chinese, 10, true},
+		{"teen", "十五", japanese, 15, true},
+		{"tens and units", "二十四", chinese, 24, true},
+		{"hundreds with zero", "一百零五", chinese, 105, true},
+		{"thousands", "三千二百", japanese, 3200, true},
+		{"ten thousand", "一万二千", japanese, 12000, true},
+		{"positional digits", "二〇二四", chinese, 2024, true},
+		{"chinese liang", "两", chinese, 2, true},
+		{"mixed with other text", "三日", japanese, 0, false},
+		{"ascii digits", "15", chinese, 0, false},
+		{"empty", "", chinese, 0, false},
+		{"no native numerals", "三", english, 0, false},
+		{"bengali digits", "২০২৪", bengali, 2024, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := translations.ParseNativeNumber(tt.input, tt.lang)
+			if ok != tt.wantOK {
+				t.Errorf("ParseNativeNumber(%q) ok = %v, want %v", tt.input, ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("ParseNativeNumber(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseNumberWord(t *testing.T) {
+	english := translations.NewEnglishTranslation()
+	german := translations.NewGermanTranslation()
+	chinese := translations.NewChineseTranslation()
+
+	tests := []struct {
+		name   string
+		input  string
+		lang   *translations.Language
+		want   int
+		wantOK bool
+	}{
+		{"single word", "ten", english, 10, true},
+		{"capitalized", "Twelve", english, 12, true},
+		{"hyphenated compound", "twenty-five", english, 25, true},
+		{"spaced compound", "twenty five", english, 25, true},
+		{"german compound", "fünfundzwanzig", german, 25, true},
+		{"unit before tens", "five twenty", english, 0, false},
+		{"two units", "five five", english, 0, false},
+		{"not a number", "past", english, 0, false},
+		{"digits", "10", english, 0, false},
+		{"no number words", "十", chinese, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := translations.ParseNumberWord(tt.input, tt.lang)
+			if ok != tt.wantOK {
+				t.Errorf("ParseNumberWord(%q) ok = %v, want %v", tt.input, ok, tt.wantOK)
+			}
+			if got != tt.want {
+				t.Errorf("ParseNumberWord(%q) = %d, want %d", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeDigits(t *testing.T) {
+	bengali := translations.NewBengaliTranslation()
+	chinese := translations.NewChineseTranslation()
+
+	tests := []struct {
+		name  string
+		input string
+		langs []*translations.Language
+		want  string
+	}{
+		{"all bengali digits", "০১২৩৪৫৬৭৮৯", []*translations.Language{bengali}, "0123456789"},
+		{"date", "১৫ জানুয়ারি ২০২৪", []*translations.Language{bengali}, "15 জানুয়ারি 2024"},
+		{"ascii unchanged", "2024-01-15", []*translations.Language{bengali}, "2024-01-15"},
+		{"digits of other languages kept", "২০২৪", []*translations.Language{chinese}, "২০২৪"},
+		{"multiplier numerals kept", "十五日", []*translations.Language{chinese, bengali}, "十五日"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := translations.NormalizeDigits(tt.input, tt.langs...); got != tt.want {
+				t.Errorf("NormalizeDigits(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	// Every Bengali digit maps back to the ASCII digit of the same value
+	for r, value := range bengali.Numerals {
+		want := string(rune('0' + value))
+		if got := translations.NormalizeDigits(string(r), bengali); got != want {
+			t.Errorf("NormalizeDigits(%q) = %q, want %q", string(r), got, want)
+		}
+		if n, ok := translations.ParseNativeNumber(string(r), bengali); !ok || n != value {
+			t.Errorf("ParseNativeNumber(%q) = %d, %v, want %d", string(r), n, ok, value)
+		}
+	}
+}
+
 // Test MatchesRelativeTerm
 
 func TestMatchesRelativeTerm(t *testing.T) {
@@ -261,6 +428,18 @@ func TestMatchesRelativeTerm(t *testing.T) {
 			terms: []string{"", "yesterday", ""},
 			want:  true,
 		},
+		{
+			name:  "Phrase with extra whitespace",
+			input: "the  day after\ttomorrow",
+			terms: []string{"übermorgen", "the day after tomorrow"},
+			want:  true,
+		},
+		{
+			name:  "Single-word term",
+			input: "Übermorgen",
+			terms: []string{"übermorgen", "the day after tomorrow"},
+			want:  true,
+		},
 		{
 			name:  "Partial match doesn't count",
 			input: "yesterday morning",
@@ -695,3 +874,175 @@ func TestNormalizeTimeUnit(t *testing.T) {
 		})
 	}
 }
+
+func TestParseLanguageTag(t *testing.T) {
+	tests := []struct {
+		tag        string
+		wantCode   string
+		wantRegion string
+	}{
+		{"en", "en", ""},
+		{"en-US", "en", "US"},
+		{"en_gb", "en", "GB"},
+		{"pt-BR", "pt", "BR"},
+		{"zh-Hant", "zh", ""},
+		{"zh-Hant-TW", "zh", "TW"},
+		{"es-419", "es", "419"},
+		{"", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.tag, func(t *testing.T) {
+			code, region := translations.ParseLanguageTag(tt.tag)
+			if code != tt.wantCode || region != tt.wantRegion {
+				t.Errorf("ParseLanguageTag(%q) = (%q, %q), want (%q, %q)", tt.tag, code, region, tt.wantCode, tt.wantRegion)
+			}
+		})
+	}
+}
+
+func TestRegionDateOrder(t *testing.T) {
+	tests := map[string]string{
+		"US": "MDY",
+		"ph": "MDY",
+		"GB": "DMY",
+		"BR": "DMY",
+		"CN": "YMD",
+		"":   "",
+	}
+
+	for region, want := range tests {
+		if got := translations.RegionDateOrder(region); got != want {
+			t.Errorf("RegionDateOrder(%q) = %q, want %q", region, got, want)
+		}
+	}
+}
+
+func TestLanguage_Tokens(t *testing.T) {
+	en := translations.NewEnglishTranslation()
+	tokens := en.Tokens()
+
+	months := make(map[time.Month]bool)
+	for _, word := range tokens["months"] {
+		month, ok := translations.ParseMonth(word, en)
+		if !ok {
+			t.Errorf("month token %q does not parse", word)
+		}
+		months[month] = true
+	}
+	if len(months) != 12 {
+		t.Errorf("month tokens cover %d months, want 12", len(months))
+	}
+
+	weekdays := make(map[time.Weekday]bool)
+	for _, word := range tokens["weekdays"] {
+		weekday, ok := translations.ParseWeekday(word, en)
+		if !ok {
+			t.Errorf("weekday token %q does not parse", word)
+		}
+		weekdays[weekday] = true
+	}
+	if len(weekdays) != 7 {
+		t.Errorf("weekday tokens cover %d weekdays, want 7", len(weekdays))
+	}
+
+	for group, want := range map[string][]string{
+		"months":   {"january", "jan", "sept"},
+		"weekdays": {"monday", "mon", "thurs"},
+		"relative": {"yesterday", "ago", "next"},
+		"units":    {"day", "days", "business day"},
+	} {
+		for _, word := range want {
+			if !slices.Contains(tokens[group], word) {
+				t.Errorf("Tokens()[%q] is missing %q", group, word)
+			}
+		}
+		if !slices.IsSorted(tokens[group]) {
+			t.Errorf("Tokens()[%q] is not sorted", group)
+		}
+	}
+}
+
+func TestNormalizeWidth(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"２０２４年１２月３１日", "2024年12月31日"},
+		{"２０２４／１２／３１", "2024/12/31"},
+		{"１５：３０", "15:30"},
+		{"ＰＭ３時", "PM3時"},
+		{"2024年12月31日", "2024年12月31日"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := translations.NormalizeWidth(tt.input); got != tt.want {
+				t.Errorf("NormalizeWidth(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	japanese := translations.NewJapaneseTranslation()
+	if month, ok := translations.ParseMonth("１２月", japanese); !ok || month != time.December {
+		t.Errorf("ParseMonth(\"１２月\") = %v, %v, want December", month, ok)
+	}
+}
+
+func TestNormalizeSpaces(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"31\u00a0December\u00a02024", "31 December 2024"},
+		{"9:30\u202fAM", "9:30 AM"},
+		{"2024年\u300012月", "2024年 12月"},
+		{"tab\tand\nnewline", "tab\tand\nnewline"},
+		{"no spaces", "no spaces"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			if got := translations.NormalizeSpaces(tt.input); got != tt.want {
+				t.Errorf("NormalizeSpaces(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+
+	english := translations.NewEnglishTranslation()
+	if month, ok := translations.ParseMonth("\u00a0Sept\u202f", english); !ok || month != time.September {
+		t.Errorf("ParseMonth with no-break spaces = %v, %v, want September", month, ok)
+	}
+	if weekday, ok := translations.ParseWeekday("\u3000Friday", english); !ok || weekday != time.Friday {
+		t.Errorf("ParseWeekday with an ideographic space = %v, %v, want Friday", weekday, ok)
+	}
+}
+
+func TestStripNamePeriods(t *testing.T) {
+	english := translations.NewEnglishTranslation()
+	german := translations.NewGermanTranslation()
+	spanish := translations.NewSpanishTranslation()
+
+	tests := []struct {
+		input     string
+		languages []*translations.Language
+		want      string
+	}{
+		{"Mon., Dec. 30, 2024", []*translations.Language{english}, "Mon, Dec 30, 2024"},
+		{"Sept. 5", []*translations.Language{english}, "Sept 5"},
+		{"31. Dez. 2024", []*translations.Language{german}, "31. Dez 2024"},
+		{"31.12.", []*translations.Language{german}, "31.12."},
+		{"31.12.2024", []*translations.Language{german}, "31.12.2024"},
+		{"mié. 1 de ene. de 2025", []*translations.Language{spanish}, "mié 1 de ene de 2025"},
+		{"3 p.m. etc.", []*translations.Language{english}, "3 p.m. etc."},
+		{"Dec.. 5", []*translations.Language{english}, "Dec. 5"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := translations.StripNamePeriods(tt.input, tt.languages...); got != tt.want {
+				t.Errorf("StripNamePeriods(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
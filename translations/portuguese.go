@@ -7,8 +7,10 @@ import (
 // NewPortugueseTranslation creates the Portuguese (Brazil) language translation.
 func NewPortugueseTranslation() *Language {
 	return &Language{
-		Code: "pt",
-		Name: "Portuguese",
+		Code:             "pt",
+		Name:             "Portuguese",
+		DecimalSeparator: ",",
+		DefaultDateOrder: "DMY",
 		Months: map[string]time.Month{
 			// Full names
 			"janeiro":   time.January,
@@ -55,11 +57,18 @@ func NewPortugueseTranslation() *Language {
 			"sáb": time.Saturday, "sab": time.Saturday,
 			"dom": time.Sunday,
 		},
+		// Ordinal day suffixes: 1º, 1°, 1.º
+		OrdinalSuffixes:  []string{".º", "º", "°", ".ª", "ª"},
+		DayArticles:      []string{"dia", "o dia", "no dia"},
+		ListConjunctions: []string{"e"},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "ontem",
-			Today:     "hoje",
-			Tomorrow:  "amanhã",
-			Now:       "agora",
+			Yesterday:          "ontem",
+			Today:              "hoje",
+			Tomorrow:           "amanhã",
+			TomorrowVariants:   []string{"amanha"},
+			Now:                "agora",
+			DayAfterTomorrow:   []string{"depois de amanhã", "depois de amanha"},
+			DayBeforeYesterday: []string{"anteontem", "antes de ontem"},
 			// "atrás" or "há" for past (há 2 dias = 2 days ago)
 			Ago: []string{"atrás", "atras", "há", "ha"},
 			// "em" or "daqui a" for future (em 3 semanas = in 3 weeks, daqui a 3 dias = 3 days from now)
@@ -71,21 +80,39 @@ func NewPortugueseTranslation() *Language {
 			// Gender variations for "this"
 			This: []string{"este", "esta", "esse", "essa", "isto", "isso"},
 			// Time units with plural forms
-			Second:    []string{"segundo", "segundos"},
-			Minute:    []string{"minuto", "minutos"},
-			Hour:      []string{"hora", "horas"},
-			Day:       []string{"dia", "dias"},
-			Week:      []string{"semana", "semanas"},
-			Fortnight: []string{"quinzena", "quinzenas"},
-			Month:     []string{"mês", "meses", "mes"},
-			Quarter:   []string{"trimestre", "trimestres"},
-			Year:      []string{"ano", "anos"},
-			Decade:    []string{"década", "décadas", "decada", "decadas"},
+			Second:      []string{"segundo", "segundos"},
+			Minute:      []string{"minuto", "minutos"},
+			Hour:        []string{"hora", "horas"},
+			Day:         []string{"dia", "dias"},
+			Week:        []string{"semana", "semanas"},
+			Fortnight:   []string{"quinzena", "quinzenas"},
+			Month:       []string{"mês", "meses", "mes"},
+			Quarter:     []string{"trimestre", "trimestres"},
+			Year:        []string{"ano", "anos"},
+			Decade:      []string{"década", "décadas", "decada", "decadas"},
+			BusinessDay: []string{"dia útil", "dias úteis", "dia util", "dias uteis"},
 			// Period boundaries
-			Beginning: []string{"começo", "comeco", "início", "inicio", "princípio", "principio"},
-			End:       []string{"fim", "final"},
-			Start:     []string{"início", "inicio", "começo", "comeco"},
-			First:     []string{"primeiro", "primeira"},
+			Beginning:  []string{"começo", "comeco", "início", "inicio", "princípio", "principio"},
+			End:        []string{"fim", "final"},
+			Start:      []string{"início", "inicio", "começo", "comeco"},
+			First:      []string{"primeiro", "primeira"},
+			Since:      []string{"desde", "depois de", "a partir de"},
+			Until:      []string{"até", "ate", "antes de"},
+			Deadline:   []string{"o mais tardar em", "o mais tardar", "até no máximo"},
+			OnOrAbout:  []string{"em ou por volta de", "por volta de"},
+			After:      []string{"depois de", "depois do", "depois da", "após", "apos"},
+			Before:     []string{"antes de", "antes do", "antes da"},
+			Countdown:  []string{"para", "para o", "para a", "até", "ate"},
+			Couple:     []string{"um par de", "uns dois", "umas duas"},
+			Few:        []string{"alguns", "algumas", "uns", "umas", "vários", "várias", "varios", "varias"},
+			WindowNext: []string{"próximos", "próximas", "proximos", "proximas"},
+			WindowLast: []string{"últimos", "últimas", "ultimos", "ultimas", "passados", "passadas"},
+			WindowLead: []string{"nos", "nas", "durante os", "durante as", "os", "as"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:     []string{"toda", "todo", "todas as", "todos os", "a cada", "cada"},
+			Alternate: []string{"duas", "dois"},
+			Biweekly:  []string{"quinzenal", "quinzenalmente", "a cada duas semanas", "a cada quinze dias"},
 		},
 		TimeTerms: &TimeTerms{
 			Noon:     []string{"meio-dia", "meio dia", "meiodia"},
@@ -95,10 +122,18 @@ func NewPortugueseTranslation() *Language {
 			// "e" for past (3 e meia = half past 3)
 			Past: []string{"e"},
 			// "para" or "menos" for to (quinze para as 3 = quarter to 3, menos quinze = minus 15)
-			To:     []string{"para", "menos"},
-			OClock: []string{"em ponto", "horas"},
-			AM:     []string{"am", "a.m.", "da manhã", "da manha", "de manhã", "de manha"},
-			PM:     []string{"pm", "p.m.", "da tarde", "de tarde", "da noite", "de noite"},
+			To:         []string{"para", "menos"},
+			OClock:     []string{"em ponto", "horas"},
+			AM:         []string{"am", "a.m.", "da manhã", "da manha", "de manhã", "de manha"},
+			PM:         []string{"pm", "p.m.", "da tarde", "de tarde", "da noite", "de noite"},
+			At:         []string{"às", "as", "à"},
+			Around:     []string{"por volta das", "por volta da", "por volta do", "cerca das", "cerca da", "lá pelas", "perto das", "perto da"},
+			Exact:      []string{"exatamente", "precisamente", "pontualmente"},
+			ExactAfter: []string{"exatamente"},
+			DayParts: map[string]string{
+				"hora do almoço": "12:00", "hora do chá": "16:00", "hora do lanche": "16:00", "hora do jantar": "20:00",
+				"amanhecer": "06:00", "anoitecer": "18:00", "pôr do sol": "18:00",
+			},
 		},
 	}
 }
@@ -24,6 +24,11 @@ func init() {
 		GlobalRegistry.Register(NewItalianTranslation())
 		GlobalRegistry.Register(NewDutchTranslation())
 		GlobalRegistry.Register(NewRussianTranslation())
+		GlobalRegistry.Register(NewSwahiliTranslation())
+		GlobalRegistry.Register(NewTagalogTranslation())
+		GlobalRegistry.Register(NewCroatianTranslation())
+		GlobalRegistry.Register(NewSerbianTranslation())
+		GlobalRegistry.Register(NewBengaliTranslation())
 	})
 }
 
@@ -37,7 +42,19 @@ func DetectLanguage(input string) string {
 	return GlobalRegistry.DetectLanguage(input)
 }
 
+// DetectLanguageScores is a convenience function to score the languages of
+// the input against the global registry
+func DetectLanguageScores(input string) map[string]int {
+	return GlobalRegistry.DetectLanguageScores(input)
+}
+
 // SupportedLanguages returns all supported language codes
 func SupportedLanguages() []string {
 	return GlobalRegistry.SupportedLanguages()
 }
+
+// CoverageReport returns the vocabulary coverage of every language in the
+// global registry, keyed by language code
+func CoverageReport() map[string]LanguageCoverage {
+	return GlobalRegistry.CoverageReport()
+}
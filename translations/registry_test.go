@@ -2,7 +2,9 @@ package translations_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/coredds/godateparser"
 	"github.com/coredds/godateparser/translations"
 )
 
@@ -170,6 +172,11 @@ func TestRegistry_DetectLanguage(t *testing.T) {
 	registry.Register(translations.NewRussianTranslation())
 	registry.Register(translations.NewChineseTranslation())
 	registry.Register(translations.NewJapaneseTranslation())
+	registry.Register(translations.NewSwahiliTranslation())
+	registry.Register(translations.NewTagalogTranslation())
+	registry.Register(translations.NewCroatianTranslation())
+	registry.Register(translations.NewSerbianTranslation())
+	registry.Register(translations.NewBengaliTranslation())
 
 	tests := []struct {
 		name  string
@@ -321,6 +328,110 @@ func TestRegistry_DetectLanguage(t *testing.T) {
 			want:  "ja",
 		},
 
+		// Swahili detection
+		{
+			name:  "Swahili weekday Monday",
+			input: "Jumatatu",
+			want:  "sw",
+		},
+		{
+			name:  "Swahili weekday Tuesday",
+			input: "Jumanne ijayo",
+			want:  "sw",
+		},
+		{
+			name:  "Swahili month",
+			input: "15 Desemba 2024",
+			want:  "sw",
+		},
+
+		// Tagalog detection: months overlap with Spanish, relative words do not
+		{
+			name:  "Tagalog yesterday",
+			input: "kahapon",
+			want:  "tl",
+		},
+		{
+			name:  "Tagalog tomorrow",
+			input: "bukas",
+			want:  "tl",
+		},
+		{
+			name:  "Tagalog weekday",
+			input: "Huwebes",
+			want:  "tl",
+		},
+		{
+			name:  "Tagalog month",
+			input: "25 Disyembre 2024",
+			want:  "tl",
+		},
+		{
+			name:  "Shared month stays Spanish",
+			input: "15 de agosto",
+			want:  "es",
+		},
+
+		// Croatian and Serbian: ijekavian vs ekavian spellings and month names
+		{
+			name:  "Croatian genitive month",
+			input: "15. prosinca 2024.",
+			want:  "hr",
+		},
+		{
+			name:  "Croatian yesterday",
+			input: "jučer",
+			want:  "hr",
+		},
+		{
+			name:  "Croatian weekday",
+			input: "ponedjeljak",
+			want:  "hr",
+		},
+		{
+			name:  "Serbian genitive month",
+			input: "15. decembra 2024.",
+			want:  "sr",
+		},
+		{
+			name:  "Serbian yesterday",
+			input: "juče",
+			want:  "sr",
+		},
+		{
+			name:  "Serbian weekday",
+			input: "ponedeljak",
+			want:  "sr",
+		},
+		// Bengali detection by script
+		{
+			name:  "Bengali date with native digits",
+			input: "১৫ জানুয়ারি ২০২৪",
+			want:  "bn",
+		},
+		{
+			name:  "Bengali relative",
+			input: "গতকাল",
+			want:  "bn",
+		},
+		{
+			name:  "Bengali digits only",
+			input: "১৫/০১/২০২৪",
+			want:  "bn",
+		},
+
+		// Slovenian is not supported and must not be mistaken for either
+		{
+			name:  "Slovenian yesterday",
+			input: "včeraj",
+			want:  "en",
+		},
+		{
+			name:  "Slovenian weekday",
+			input: "ponedeljek",
+			want:  "en",
+		},
+
 		// Default fallback (Note: may vary due to scoring, these test basic fallback behavior)
 		{
 			name:  "Empty input returns default",
@@ -339,6 +450,28 @@ func TestRegistry_DetectLanguage(t *testing.T) {
 	}
 }
 
+func TestRegistry_DetectLanguageScores(t *testing.T) {
+	registry := translations.NewRegistry()
+	registry.Register(translations.NewSpanishTranslation())
+	registry.Register(translations.NewFrenchTranslation())
+
+	scores := registry.DetectLanguageScores("15 de diciembre de 2024")
+	if scores["es"] == 0 {
+		t.Fatalf("DetectLanguageScores() = %v, want a score for es", scores)
+	}
+	if scores["es"] <= scores["fr"] {
+		t.Errorf("DetectLanguageScores() es = %d, want more than fr = %d", scores["es"], scores["fr"])
+	}
+	if got := registry.DetectLanguage("15 de diciembre de 2024"); got != "es" {
+		t.Errorf("DetectLanguage() = %q, want es", got)
+	}
+
+	// Languages without evidence are left out
+	if scores := registry.DetectLanguageScores("2024"); len(scores) != 0 {
+		t.Errorf("DetectLanguageScores(%q) = %v, want no scores", "2024", scores)
+	}
+}
+
 func TestRegistry_SupportedLanguages(t *testing.T) {
 	registry := translations.NewRegistry()
 
@@ -379,6 +512,60 @@ func TestRegistry_SupportedLanguages(t *testing.T) {
 	}
 }
 
+func TestCoverageReport(t *testing.T) {
+	report := translations.CoverageReport()
+
+	if len(report) != len(translations.SupportedLanguages()) {
+		t.Errorf("CoverageReport() has %d languages, want %d", len(report), len(translations.SupportedLanguages()))
+	}
+
+	for _, code := range translations.SupportedLanguages() {
+		coverage, ok := report[code]
+		if !ok {
+			t.Errorf("CoverageReport() has no entry for %q", code)
+			continue
+		}
+		if !coverage.Months || !coverage.Weekdays {
+			t.Errorf("%s: Months = %v, Weekdays = %v, want complete (missing %v)", code, coverage.Months, coverage.Weekdays, coverage.Missing)
+		}
+		if !coverage.Relatives {
+			t.Errorf("%s: Relatives = false, missing %v", code, coverage.Missing)
+		}
+	}
+
+	english := report["en"]
+	if !english.Abbreviations || !english.Units || !english.NumberWords || len(english.Missing) != 0 {
+		t.Errorf("en coverage = %+v, want complete", english)
+	}
+}
+
+func TestLanguageCoverage_Missing(t *testing.T) {
+	lang := &translations.Language{
+		Code:   "xx",
+		Months: map[string]time.Month{"january": time.January, "jan": time.January},
+		Weekdays: map[string]time.Weekday{
+			"sunday": time.Sunday, "monday": time.Monday, "tuesday": time.Tuesday, "wednesday": time.Wednesday,
+			"thursday": time.Thursday, "friday": time.Friday, "saturday": time.Saturday,
+		},
+	}
+
+	coverage := lang.Coverage()
+	if coverage.Months || !coverage.Weekdays || coverage.Relatives || coverage.Units || coverage.NumberWords {
+		t.Errorf("Coverage() = %+v", coverage)
+	}
+
+	want := map[string]bool{"month February": true, "month December": true, "relative terms": true, "number words": true}
+	for _, gap := range coverage.Missing {
+		delete(want, gap)
+		if gap == "month January" || gap == "abbreviation January" {
+			t.Errorf("Missing has %q, which is covered", gap)
+		}
+	}
+	if len(want) > 0 {
+		t.Errorf("Missing = %v, want it to include %v", coverage.Missing, want)
+	}
+}
+
 func TestGlobalRegistry(t *testing.T) {
 	// Test that the global registry is initialized
 	lang := translations.GetLanguage("en")
@@ -402,8 +589,8 @@ func TestGlobalRegistry(t *testing.T) {
 		t.Fatal("SupportedLanguages() returned empty slice")
 	}
 
-	// Should have all 10 languages
-	expectedLangs := []string{"en", "es", "pt", "fr", "de", "it", "nl", "ru", "zh", "ja"}
+	// Should have all 15 languages
+	expectedLangs := []string{"en", "es", "pt", "fr", "de", "it", "nl", "ru", "zh", "ja", "sw", "tl", "hr", "sr", "bn"}
 	found := make(map[string]bool)
 	for _, code := range supported {
 		found[code] = true
@@ -415,3 +602,50 @@ func TestGlobalRegistry(t *testing.T) {
 		}
 	}
 }
+
+func TestRelativeDays_ShippedLanguages(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	// Every spelling of yesterday, today and tomorrow, by language
+	tests := []struct {
+		lang                       string
+		yesterday, today, tomorrow []string
+	}{
+		{"en", []string{"yesterday", "Yesterday"}, []string{"today", "TODAY"}, []string{"tomorrow"}},
+		{"es", []string{"ayer"}, []string{"hoy"}, []string{"mañana", "manana", "Mañana"}},
+		{"pt", []string{"ontem"}, []string{"hoje"}, []string{"amanhã", "amanha"}},
+		{"fr", []string{"hier"}, []string{"aujourd'hui", "aujourd’hui", "aujourdhui"}, []string{"demain"}},
+		{"de", []string{"gestern"}, []string{"heute", "Heute"}, []string{"morgen"}},
+		{"it", []string{"ieri"}, []string{"oggi"}, []string{"domani"}},
+		{"nl", []string{"gisteren"}, []string{"vandaag"}, []string{"morgen"}},
+		{"ru", []string{"вчера", "Вчера"}, []string{"сегодня"}, []string{"завтра"}},
+		{"zh", []string{"昨天", "昨日"}, []string{"今天", "今日"}, []string{"明天", "明日"}},
+		{"ja", []string{"昨日", "きのう", "さくじつ"}, []string{"今日", "きょう", "本日"}, []string{"明日", "あした", "あす", "みょうにち"}},
+	}
+
+	for _, tt := range tests {
+		// The language's own terms are among those tested
+		terms := translations.GetLanguage(tt.lang).RelativeTerms
+		if terms == nil || terms.Yesterday != tt.yesterday[0] || terms.Today != tt.today[0] || terms.Tomorrow != tt.tomorrow[0] {
+			t.Errorf("%s: relative day terms = %+v, want %q, %q, %q first", tt.lang, terms, tt.yesterday[0], tt.today[0], tt.tomorrow[0])
+		}
+
+		for offset, words := range [][]string{tt.yesterday, tt.today, tt.tomorrow} {
+			want := base.AddDate(0, 0, offset-1)
+			for _, word := range words {
+				t.Run(tt.lang+" "+word, func(t *testing.T) {
+					got, err := godateparser.ParseDate(word, &godateparser.Settings{
+						Languages:    []string{tt.lang},
+						RelativeBase: base,
+					})
+					if err != nil {
+						t.Fatalf("ParseDate(%q) error = %v", word, err)
+					}
+					if !got.Equal(want) {
+						t.Errorf("ParseDate(%q) = %v, want %v", word, got, want)
+					}
+				})
+			}
+		}
+	}
+}
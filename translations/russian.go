@@ -7,8 +7,10 @@ import (
 // NewRussianTranslation creates the Russian language translation.
 func NewRussianTranslation() *Language {
 	return &Language{
-		Code: "ru",
-		Name: "Russian",
+		Code:             "ru",
+		Name:             "Russian",
+		DecimalSeparator: ",",
+		DefaultDateOrder: "DMY",
 		Months: map[string]time.Month{
 			// Full names (nominative case)
 			"январь":   time.January,
@@ -81,11 +83,16 @@ func NewRussianTranslation() *Language {
 			"сб": time.Saturday,
 			"вс": time.Sunday,
 		},
+		// Ordinal day suffixes: 1-го, 1-е
+		OrdinalSuffixes:  []string{"-го", "-е", "-ое"},
+		ListConjunctions: []string{"и"},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "вчера",
-			Today:     "сегодня",
-			Tomorrow:  "завтра",
-			Now:       "сейчас",
+			Yesterday:          "вчера",
+			Today:              "сегодня",
+			Tomorrow:           "завтра",
+			Now:                "сейчас",
+			DayAfterTomorrow:   []string{"послезавтра"},
+			DayBeforeYesterday: []string{"позавчера"},
 			// "назад" for past (2 дня назад = 2 days ago)
 			Ago: []string{"назад", "тому назад"},
 			// "через" for future (через 3 недели = in 3 weeks)
@@ -97,21 +104,35 @@ func NewRussianTranslation() *Language {
 			// Gender/number variations for "this"
 			This: []string{"этот", "эта", "это", "эти", "текущий", "текущая", "текущее", "текущие"},
 			// Time units with plural forms (nominative, genitive singular, genitive plural, accusative)
-			Second:    []string{"секунда", "секунды", "секунд", "секунду"},
-			Minute:    []string{"минута", "минуты", "минут", "минуту"},
-			Hour:      []string{"час", "часа", "часов"},
-			Day:       []string{"день", "дня", "дней"},
-			Week:      []string{"неделя", "недели", "недель", "неделю"},
-			Fortnight: []string{"две недели", "двух недель"},
-			Month:     []string{"месяц", "месяца", "месяцев"},
-			Quarter:   []string{"квартал", "квартала", "кварталов"},
-			Year:      []string{"год", "года", "лет"},
-			Decade:    []string{"десятилетие", "десятилетия", "десятилетий", "декада", "декады", "декад"},
+			Second:      []string{"секунда", "секунды", "секунд", "секунду"},
+			Minute:      []string{"минута", "минуты", "минут", "минуту"},
+			Hour:        []string{"час", "часа", "часов"},
+			Day:         []string{"день", "дня", "дней", "сутки", "суток"},
+			Week:        []string{"неделя", "недели", "недель", "неделю"},
+			Fortnight:   []string{"две недели", "двух недель"},
+			Month:       []string{"месяц", "месяца", "месяцев"},
+			Quarter:     []string{"квартал", "квартала", "кварталов"},
+			Year:        []string{"год", "года", "лет"},
+			Decade:      []string{"десятилетие", "десятилетия", "десятилетий", "декада", "декады", "декад"},
+			BusinessDay: []string{"рабочий день", "рабочих дня", "рабочих дней", "рабочие дни"},
 			// Period boundaries
-			Beginning: []string{"начало", "начала"},
-			End:       []string{"конец", "конца"},
-			Start:     []string{"начало", "начала"},
-			First:     []string{"первый", "первая", "первое", "первые"},
+			Beginning:  []string{"начало", "начала"},
+			End:        []string{"конец", "конца"},
+			Start:      []string{"начало", "начала"},
+			First:      []string{"первый", "первая", "первое", "первые"},
+			Since:      []string{"с", "со", "после", "начиная с"},
+			Until:      []string{"до", "по"},
+			After:      []string{"после"},
+			Before:     []string{"до"},
+			Couple:     []string{"пару", "пара"},
+			Few:        []string{"несколько"},
+			WindowNext: []string{"ближайшие"},
+			WindowLead: []string{"за", "в течение", "на", "в"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:     []string{"каждый", "каждую", "каждое", "по"},
+			Alternate: []string{"второй", "вторую", "второе"},
+			Biweekly:  []string{"раз в две недели", "каждые две недели"},
 		},
 		TimeTerms: &TimeTerms{
 			Noon:     []string{"полдень", "полудень", "12 часов дня"},
@@ -125,6 +146,12 @@ func NewRussianTranslation() *Language {
 			OClock: []string{"часов", "час", "часа"},
 			AM:     []string{"утра", "ночи"},
 			PM:     []string{"дня", "вечера"},
+			At:     []string{"в", "во"},
+			// "половина пятого" is 4:30
+			HalfToNext: true,
+			Around:     []string{"около", "примерно в", "приблизительно в", "примерно"},
+			Exact:      []string{"ровно", "точно"},
+			ExactAfter: []string{"ровно", "точно"},
 		},
 	}
 }
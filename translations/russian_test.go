@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/coredds/godateparser"
+	"github.com/coredds/godateparser/translations"
 )
 
 // Tests for Russian language support (ru-RU)
@@ -169,8 +170,8 @@ func TestRussian_RelativeIn(t *testing.T) {
 	}{
 		{"через 1 день", 16},
 		{"через 2 дня", 17},
-		{"через 1 неделю", 22},
-		{"через 2 недели", 29},
+		{"через 1 неделю", 21},
+		{"через 2 недели", 28},
 		{"спустя 3 дня", 18},
 	}
 
@@ -199,12 +200,12 @@ func TestRussian_RelativeNextLast(t *testing.T) {
 		wantMonth time.Month
 		wantDay   int
 	}{
-		{"следующая неделя", time.October, 22},
-		{"прошлая неделя", time.October, 8},
-		{"следующий месяц", time.November, 15},
-		{"прошлый месяц", time.September, 15},
-		{"будущая неделя", time.October, 22},
-		{"предыдущая неделя", time.October, 8},
+		{"следующая неделя", time.October, 21},
+		{"прошлая неделя", time.October, 7},
+		{"следующий месяц", time.November, 1},
+		{"прошлый месяц", time.September, 1},
+		{"будущая неделя", time.October, 21},
+		{"предыдущая неделя", time.October, 7},
 	}
 
 	for _, tt := range tests {
@@ -352,11 +353,11 @@ func TestRussian_PeriodBoundaries(t *testing.T) {
 		{"начало недели", time.October, 14, 2024}, // Monday of current week
 
 		// Next/last periods
-		{"следующий месяц", time.November, 15, 2024},
-		{"следующая неделя", time.October, 22, 2024},
+		{"следующий месяц", time.November, 1, 2024},
+		{"следующая неделя", time.October, 21, 2024},
 		{"следующий год", time.October, 15, 2025},
-		{"прошлый месяц", time.September, 15, 2024},
-		{"прошлая неделя", time.October, 8, 2024},
+		{"прошлый месяц", time.September, 1, 2024},
+		{"прошлая неделя", time.October, 7, 2024},
 		{"прошлый год", time.October, 15, 2023},
 
 		// Beginning/end of next/last periods
@@ -498,6 +499,53 @@ func TestRussian_PluralForms(t *testing.T) {
 	}
 }
 
+func TestRussian_UnitForms(t *testing.T) {
+	// Every number form of a unit normalizes to it and keeps its amount
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := &godateparser.Settings{
+		Languages:    []string{"ru"},
+		RelativeBase: base,
+	}
+	russian := []*translations.Language{translations.NewRussianTranslation()}
+
+	tests := []struct {
+		input    string
+		unit     string
+		wantUnit string
+		want     time.Time
+	}{
+		{"1 день назад", "день", "day", base.AddDate(0, 0, -1)},
+		{"2 дня назад", "дня", "day", base.AddDate(0, 0, -2)},
+		{"5 дней назад", "дней", "day", base.AddDate(0, 0, -5)},
+		{"21 день назад", "день", "day", base.AddDate(0, 0, -21)},
+		{"2 суток назад", "суток", "day", base.AddDate(0, 0, -2)},
+		{"через 1 час", "час", "hour", base.Add(time.Hour)},
+		{"через 3 часа", "часа", "hour", base.Add(3 * time.Hour)},
+		{"через 5 часов", "часов", "hour", base.Add(5 * time.Hour)},
+		{"1 год назад", "год", "year", base.AddDate(-1, 0, 0)},
+		{"2 года назад", "года", "year", base.AddDate(-2, 0, 0)},
+		{"5 лет назад", "лет", "year", base.AddDate(-5, 0, 0)},
+		{"через 1 неделю", "неделю", "week", time.Date(2024, 10, 21, 0, 0, 0, 0, time.UTC)}, // start of that week
+		{"через 5 недель", "недель", "week", time.Date(2024, 11, 18, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := translations.NormalizeTimeUnit(tt.unit, russian); got != tt.wantUnit {
+				t.Errorf("NormalizeTimeUnit(%q) = %q, want %q", tt.unit, got, tt.wantUnit)
+			}
+
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if !result.Equal(tt.want) {
+				t.Errorf("godateparser.ParseDate(%q) = %v, want %v", tt.input, result, tt.want)
+			}
+		})
+	}
+}
+
 func BenchmarkRussian_SimpleDate(b *testing.B) {
 	settings := &godateparser.Settings{Languages: []string{"ru"}}
 	for i := 0; i < b.N; i++ {
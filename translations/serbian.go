@@ -0,0 +1,104 @@
+package translations
+
+import (
+	"time"
+)
+
+// NewSerbianTranslation creates the Serbian (Serbia) language translation,
+// written in the Latin script.
+func NewSerbianTranslation() *Language {
+	return &Language{
+		Code:             "sr",
+		Name:             "Serbian",
+		DecimalSeparator: ",",
+		DefaultDateOrder: "DMY",
+		Months: map[string]time.Month{
+			// Nominative
+			"januar":    time.January,
+			"februar":   time.February,
+			"mart":      time.March,
+			"april":     time.April,
+			"maj":       time.May,
+			"jun":       time.June,
+			"jul":       time.July,
+			"avgust":    time.August,
+			"septembar": time.September,
+			"oktobar":   time.October,
+			"novembar":  time.November,
+			"decembar":  time.December,
+			// Genitive, as written in dates ("15. decembra 2024.")
+			"januara":   time.January,
+			"februara":  time.February,
+			"marta":     time.March,
+			"aprila":    time.April,
+			"maja":      time.May,
+			"juna":      time.June,
+			"jula":      time.July,
+			"avgusta":   time.August,
+			"septembra": time.September,
+			"oktobra":   time.October,
+			"novembra":  time.November,
+			"decembra":  time.December,
+		},
+		Weekdays: map[string]time.Weekday{
+			"ponedeljak": time.Monday,
+			"utorak":     time.Tuesday,
+			"sreda":      time.Wednesday, "sredu": time.Wednesday,
+			"četvrtak": time.Thursday, "cetvrtak": time.Thursday,
+			"petak":  time.Friday,
+			"subota": time.Saturday, "subotu": time.Saturday,
+			"nedelja": time.Sunday, "nedelju": time.Sunday,
+		},
+		// Ekavian spellings and month names that Croatian and Slovenian write differently
+		Indicators: []string{
+			"juče", "juce", "prekjuče", "ponedeljak", "pre", "mesec", "meseca",
+			"mart", "septembar", "oktobar", "novembar", "decembar", "septembra", "oktobra", "novembra", "decembra",
+		},
+		OrdinalSuffixes:  []string{"."},
+		YearSuffix:       ".",
+		ListConjunctions: []string{"i"},
+		RelativeTerms: &RelativeTerms{
+			Yesterday:          "juče",
+			Today:              "danas",
+			Tomorrow:           "sutra",
+			Now:                "sada",
+			DayAfterTomorrow:   []string{"prekosutra"},
+			DayBeforeYesterday: []string{"prekjuče", "prekjuce"},
+			Ago:                []string{"pre"},
+			In:                 []string{"za"},
+			// Adjectives agree with the unit's gender and case (sledeći mesec, sledeće nedelje)
+			Next: []string{"sledeći", "sledeća", "sledeće", "sledećeg", "naredni", "naredna", "naredne", "narednog"},
+			Last: []string{"prošli", "prošla", "prošle", "prošlog", "prošlo"},
+			This: []string{"ovaj", "ova", "ovo", "ove", "ovog"},
+			// "nedelja" means both week and Sunday; unit patterns are tried first
+			Second:      []string{"sekunda", "sekunde", "sekundi", "sekundu"},
+			Minute:      []string{"minut", "minuta", "minute", "minutu"},
+			Hour:        []string{"sat", "sata", "sati"},
+			Day:         []string{"dan", "dana"},
+			Week:        []string{"nedelja", "nedelje", "nedelju", "sedmica", "sedmice", "sedmicu"},
+			Fortnight:   []string{"dve nedelje"},
+			Month:       []string{"mesec", "meseca", "meseci"},
+			Quarter:     []string{"kvartal", "kvartala"},
+			Year:        []string{"godina", "godine", "godinu", "godini"},
+			Decade:      []string{"decenija", "decenije", "deceniju"},
+			BusinessDay: []string{"radni dan", "radna dana", "radnih dana"},
+			// Period boundaries
+			Beginning: []string{"početak", "početka"},
+			End:       []string{"kraj", "kraja"},
+			Start:     []string{"početak", "početka"},
+			First:     []string{"prvi", "prva", "prvog"},
+			Since:     []string{"od"},
+			Until:     []string{"do"},
+			After:     []string{"nakon", "posle"},
+			Before:    []string{"pre"},
+		},
+		TimeTerms: &TimeTerms{
+			Noon:     []string{"podne"},
+			Midnight: []string{"ponoć"},
+			AM:       []string{"am", "ujutru"},
+			PM:       []string{"pm", "popodne", "uveče"},
+			At:       []string{"u"},
+			Around:   []string{"oko", "otprilike u"},
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package translations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coredds/godateparser"
+)
+
+// Tests for Serbian Latin language support (sr)
+
+func TestSerbian_Months(t *testing.T) {
+	settings := &godateparser.Settings{Languages: []string{"sr"}}
+
+	tests := []struct {
+		input     string
+		wantMonth time.Month
+		wantDay   int
+		wantYear  int
+	}{
+		// Dates use the genitive month and a dot after the day and year
+		{"15. decembra 2024.", time.December, 15, 2024},
+		{"1. januara 2025.", time.January, 1, 2025},
+		{"8. marta 2024", time.March, 8, 2024},
+		{"20. avgusta 2024", time.August, 20, 2024},
+		// Nominative
+		{"decembar 2024", time.December, 1, 2024},
+		{"3. mart 2024", time.March, 3, 2024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Month() != tt.wantMonth || result.Day() != tt.wantDay || result.Year() != tt.wantYear {
+				t.Errorf("godateparser.ParseDate(%q) = %v-%v-%v, want %v-%v-%v",
+					tt.input, result.Year(), result.Month(), result.Day(),
+					tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestSerbian_Relative(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC) // Tuesday
+	settings := &godateparser.Settings{
+		Languages:    []string{"sr"},
+		RelativeBase: base,
+	}
+
+	tests := []struct {
+		input     string
+		wantYear  int
+		wantMonth time.Month
+		wantDay   int
+	}{
+		{"juče", 2024, time.October, 14},
+		{"danas", 2024, time.October, 15},
+		{"sutra", 2024, time.October, 16},
+		{"pre 3 dana", 2024, time.October, 12},
+		{"za 2 nedelje", 2024, time.October, 28},
+		// "nedelje" after a modifier is the week, not Sunday
+		{"sledeće nedelje", 2024, time.October, 21},
+		{"prošlog meseca", 2024, time.September, 1},
+		{"ponedeljak", 2024, time.October, 21},
+		{"nedelja", 2024, time.October, 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Year() != tt.wantYear || result.Month() != tt.wantMonth || result.Day() != tt.wantDay {
+				t.Errorf("godateparser.ParseDate(%q) = %v-%v-%v, want %v-%v-%v",
+					tt.input, result.Year(), result.Month(), result.Day(),
+					tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
@@ -7,8 +7,10 @@ import (
 // NewSpanishTranslation creates the Spanish language translation.
 func NewSpanishTranslation() *Language {
 	return &Language{
-		Code: "es",
-		Name: "Spanish",
+		Code:             "es",
+		Name:             "Spanish",
+		DecimalSeparator: ",",
+		DefaultDateOrder: "DMY",
 		Months: map[string]time.Month{
 			// Full names
 			"enero":      time.January,
@@ -55,11 +57,18 @@ func NewSpanishTranslation() *Language {
 			"sáb": time.Saturday, "sab": time.Saturday,
 			"dom": time.Sunday,
 		},
+		// Ordinal day suffixes: 1º, 1°, 1.º
+		OrdinalSuffixes:  []string{".º", "º", "°", ".ª", "ª"},
+		DayArticles:      []string{"el", "el día", "el dia", "día", "dia"},
+		ListConjunctions: []string{"y", "e"},
 		RelativeTerms: &RelativeTerms{
-			Yesterday: "ayer",
-			Today:     "hoy",
-			Tomorrow:  "mañana",
-			Now:       "ahora",
+			Yesterday:          "ayer",
+			Today:              "hoy",
+			Tomorrow:           "mañana",
+			TomorrowVariants:   []string{"manana"},
+			Now:                "ahora",
+			DayAfterTomorrow:   []string{"pasado mañana", "pasado manana"},
+			DayBeforeYesterday: []string{"anteayer", "antier", "antes de ayer"},
 			// "hace" for past (hace 2 días = 2 days ago)
 			Ago: []string{"hace"},
 			// "en" for future (en 3 semanas = in 3 weeks)
@@ -71,21 +80,39 @@ func NewSpanishTranslation() *Language {
 			// Gender variations for "this"
 			This: []string{"este", "esta", "esto"},
 			// Time units with plural forms
-			Second:    []string{"segundo", "segundos"},
-			Minute:    []string{"minuto", "minutos"},
-			Hour:      []string{"hora", "horas"},
-			Day:       []string{"día", "días", "dia", "dias"},
-			Week:      []string{"semana", "semanas"},
-			Fortnight: []string{"quincena", "quincenas"},
-			Month:     []string{"mes", "meses"},
-			Quarter:   []string{"trimestre", "trimestres"},
-			Year:      []string{"año", "años", "ano", "anos"},
-			Decade:    []string{"década", "décadas", "decada", "decadas"},
+			Second:      []string{"segundo", "segundos"},
+			Minute:      []string{"minuto", "minutos"},
+			Hour:        []string{"hora", "horas"},
+			Day:         []string{"día", "días", "dia", "dias"},
+			Week:        []string{"semana", "semanas"},
+			Fortnight:   []string{"quincena", "quincenas"},
+			Month:       []string{"mes", "meses"},
+			Quarter:     []string{"trimestre", "trimestres"},
+			Year:        []string{"año", "años", "ano", "anos"},
+			Decade:      []string{"década", "décadas", "decada", "decadas"},
+			BusinessDay: []string{"día hábil", "días hábiles", "dia habil", "dias habiles", "día laborable", "días laborables"},
 			// Period boundaries
-			Beginning: []string{"comienzo", "inicio", "principio"},
-			End:       []string{"fin", "final"},
-			Start:     []string{"inicio", "comienzo"},
-			First:     []string{"primer", "primero", "primera"},
+			Beginning:  []string{"comienzo", "inicio", "principio"},
+			End:        []string{"fin", "final"},
+			Start:      []string{"inicio", "comienzo"},
+			First:      []string{"primer", "primero", "primera"},
+			Since:      []string{"desde", "después de", "despues de", "a partir de"},
+			Until:      []string{"hasta", "antes de"},
+			Deadline:   []string{"a más tardar el", "a más tardar", "a mas tardar", "no más tarde del", "no más tarde de"},
+			OnOrAbout:  []string{"en o alrededor del", "en o alrededor de"},
+			After:      []string{"después de", "despues de", "después del", "despues del"},
+			Before:     []string{"antes de", "antes del"},
+			Countdown:  []string{"para el", "para", "hasta el", "hasta"},
+			Couple:     []string{"un par de"},
+			Few:        []string{"unos cuantos", "unas cuantas", "unos", "unas", "varios", "varias"},
+			WindowNext: []string{"próximos", "próximas", "proximos", "proximas", "siguientes"},
+			WindowLast: []string{"últimos", "últimas", "ultimos", "ultimas", "pasados", "pasadas"},
+			WindowLead: []string{"en los", "en las", "durante los", "durante las", "los", "las"},
+
+			// Recurring schedules: "every other Tuesday", "biweekly"
+			Every:     []string{"cada", "todos los", "todas las"},
+			Alternate: []string{"dos"},
+			Biweekly:  []string{"quincenal", "quincenalmente", "cada dos semanas", "cada quince días", "cada quince dias"},
 		},
 		TimeTerms: &TimeTerms{
 			Noon:     []string{"mediodía", "mediodia", "medio día", "medio dia"},
@@ -95,10 +122,18 @@ func NewSpanishTranslation() *Language {
 			// "y" for past (3 y cuarto = quarter past 3)
 			Past: []string{"y"},
 			// "menos" for to (menos cuarto = quarter to)
-			To:     []string{"menos", "para"},
-			OClock: []string{"en punto"},
-			AM:     []string{"am", "a.m.", "de la mañana", "de la manana"},
-			PM:     []string{"pm", "p.m.", "de la tarde", "de la noche"},
+			To:         []string{"menos", "para"},
+			OClock:     []string{"en punto"},
+			AM:         []string{"am", "a.m.", "de la mañana", "de la manana"},
+			PM:         []string{"pm", "p.m.", "de la tarde", "de la noche"},
+			At:         []string{"a las", "a la", "a"},
+			Around:     []string{"alrededor de las", "alrededor de la", "alrededor del", "sobre las", "sobre la", "hacia las", "hacia la", "a eso de las", "a eso de la", "aproximadamente a las"},
+			Exact:      []string{"exactamente", "justo"},
+			ExactAfter: []string{"exactamente"},
+			DayParts: map[string]string{
+				"hora de comer": "14:00", "hora de la merienda": "17:30", "hora de cenar": "21:00",
+				"amanecer": "06:00", "atardecer": "18:00", "anochecer": "19:00",
+			},
 		},
 	}
 }
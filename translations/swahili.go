@@ -0,0 +1,87 @@
+package translations
+
+import (
+	"time"
+)
+
+// NewSwahiliTranslation creates the Swahili (East Africa) language translation.
+func NewSwahiliTranslation() *Language {
+	return &Language{
+		Code:             "sw",
+		Name:             "Swahili",
+		DecimalSeparator: ".",
+		DefaultDateOrder: "DMY",
+		// Units come before numbers and modifiers: "siku 3 zilizopita", "wiki ijayo"
+		UnitFirst: true,
+		Months: map[string]time.Month{
+			"januari":  time.January,
+			"februari": time.February,
+			"machi":    time.March,
+			"aprili":   time.April,
+			"mei":      time.May,
+			"juni":     time.June,
+			"julai":    time.July,
+			"agosti":   time.August,
+			"septemba": time.September,
+			"oktoba":   time.October,
+			"novemba":  time.November,
+			"desemba":  time.December,
+		},
+		Weekdays: map[string]time.Weekday{
+			"jumatatu": time.Monday,
+			"jumanne":  time.Tuesday,
+			"jumatano": time.Wednesday,
+			"alhamisi": time.Thursday,
+			"ijumaa":   time.Friday,
+			"jumamosi": time.Saturday,
+			"jumapili": time.Sunday,
+		},
+		// Weekday names and relative words shared with no other supported language
+		Indicators:       []string{"jumatatu", "jumanne", "jumatano", "alhamisi", "ijumaa", "jumamosi", "jumapili", "jana", "kesho", "leo", "juzi"},
+		ListConjunctions: []string{"na"},
+		RelativeTerms: &RelativeTerms{
+			Yesterday:          "jana",
+			Today:              "leo",
+			Tomorrow:           "kesho",
+			Now:                "sasa",
+			DayAfterTomorrow:   []string{"kesho kutwa"},
+			DayBeforeYesterday: []string{"juzi"},
+			// Past suffix agrees with the unit's noun class (siku 3 zilizopita, mwaka 1 uliopita)
+			Ago: []string{"zilizopita", "iliyopita", "uliopita", "iliopita"},
+			// "baada ya" for future (baada ya siku 3 = in 3 days)
+			In: []string{"baada ya", "katika"},
+			// Modifiers follow the unit (wiki ijayo = next week, mwezi ujao = next month)
+			Next: []string{"ijayo", "ujao", "inayokuja", "unaokuja"},
+			Last: []string{"iliyopita", "uliopita", "iliopita"},
+			This: []string{"hii", "huu", "hiki"},
+			// Time units with singular and plural forms
+			Second:      []string{"sekunde"},
+			Minute:      []string{"dakika"},
+			Hour:        []string{"saa"},
+			Day:         []string{"siku"},
+			Week:        []string{"wiki"},
+			Fortnight:   []string{"wiki mbili"},
+			Month:       []string{"mwezi", "miezi"},
+			Quarter:     []string{"robo mwaka"},
+			Year:        []string{"mwaka", "miaka"},
+			Decade:      []string{"muongo", "miongo"},
+			BusinessDay: []string{"siku ya kazi", "siku za kazi"},
+			// Period boundaries
+			Beginning: []string{"mwanzo"},
+			End:       []string{"mwisho"},
+			Start:     []string{"mwanzo"},
+			First:     []string{"kwanza"},
+			Since:     []string{"tangu", "kuanzia"},
+			Until:     []string{"hadi", "mpaka"},
+			After:     []string{"baada ya"},
+			Before:    []string{"kabla ya"},
+		},
+		TimeTerms: &TimeTerms{
+			Noon:     []string{"adhuhuri"},
+			Midnight: []string{"usiku wa manane"},
+			AM:       []string{"am", "a.m."},
+			PM:       []string{"pm", "p.m."},
+			Around:   []string{"takriban", "karibu"},
+		},
+	}
+}
@@ -0,0 +1,142 @@
+package translations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coredds/godateparser"
+	"github.com/coredds/godateparser/translations"
+)
+
+// Tests for Swahili language support (sw)
+
+func TestSwahili_Months(t *testing.T) {
+	settings := &godateparser.Settings{Languages: []string{"sw"}}
+
+	tests := []struct {
+		input     string
+		wantMonth time.Month
+		wantDay   int
+		wantYear  int
+	}{
+		{"1 Januari 2025", time.January, 1, 2025},
+		{"31 Desemba 2024", time.December, 31, 2024},
+		{"15 machi 2024", time.March, 15, 2024},
+		{"10 agosti 2024", time.August, 10, 2024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Month() != tt.wantMonth || result.Day() != tt.wantDay || result.Year() != tt.wantYear {
+				t.Errorf("godateparser.ParseDate(%q) = %v-%v-%v, want %v-%v-%v",
+					tt.input, result.Year(), result.Month(), result.Day(),
+					tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestSwahili_Weekdays(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC) // Tuesday
+	settings := &godateparser.Settings{
+		Languages:    []string{"sw"},
+		RelativeBase: base,
+	}
+
+	tests := []struct {
+		input       string
+		wantWeekday time.Weekday
+	}{
+		{"Jumatatu", time.Monday},
+		{"jumanne", time.Tuesday},
+		{"jumatano", time.Wednesday},
+		{"alhamisi", time.Thursday},
+		{"ijumaa", time.Friday},
+		{"jumamosi", time.Saturday},
+		{"jumapili", time.Sunday},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Weekday() != tt.wantWeekday {
+				t.Errorf("godateparser.ParseDate(%q) weekday = %v, want %v",
+					tt.input, result.Weekday(), tt.wantWeekday)
+			}
+		})
+	}
+}
+
+func TestSwahili_Relative(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := &godateparser.Settings{
+		Languages:    []string{"sw"},
+		RelativeBase: base,
+	}
+
+	tests := []struct {
+		input     string
+		wantMonth time.Month
+		wantDay   int
+	}{
+		{"jana", time.October, 14},
+		{"leo", time.October, 15},
+		{"kesho", time.October, 16},
+		{"kesho kutwa", time.October, 17},
+		{"juzi", time.October, 13},
+		// Unit comes before the amount
+		{"siku 3 zilizopita", time.October, 12},
+		{"wiki 1 iliyopita", time.October, 8},
+		{"baada ya siku 3", time.October, 18},
+		{"baada ya miezi 2", time.December, 15},
+		// Modifier comes after the unit
+		{"wiki ijayo", time.October, 21},
+		{"mwezi ujao", time.November, 1},
+		{"mwezi uliopita", time.September, 1},
+		{"wiki iliyopita", time.October, 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Month() != tt.wantMonth || result.Day() != tt.wantDay {
+				t.Errorf("godateparser.ParseDate(%q) = %v %v, want %v %v",
+					tt.input, result.Month(), result.Day(), tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestSwahili_NormalizeTimeUnit(t *testing.T) {
+	lang := translations.NewSwahiliTranslation()
+
+	tests := []struct {
+		unit string
+		want string
+	}{
+		{"siku", "day"},
+		{"wiki", "week"},
+		{"mwezi", "month"},
+		{"miezi", "month"},
+		{"mwaka", "year"},
+		{"miaka", "year"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.unit, func(t *testing.T) {
+			if got := translations.NormalizeTimeUnit(tt.unit, []*translations.Language{lang}); got != tt.want {
+				t.Errorf("NormalizeTimeUnit(%q) = %q, want %q", tt.unit, got, tt.want)
+			}
+		})
+	}
+}
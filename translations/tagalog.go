@@ -0,0 +1,90 @@
+package translations
+
+import (
+	"time"
+)
+
+// NewTagalogTranslation creates the Filipino/Tagalog (Philippines) language translation.
+func NewTagalogTranslation() *Language {
+	return &Language{
+		Code:             "tl",
+		Name:             "Tagalog",
+		DecimalSeparator: ".",
+		DefaultDateOrder: "MDY",
+		// Month names are Spanish-derived; several are spelled as in Spanish (abril, mayo, agosto)
+		Months: map[string]time.Month{
+			"enero":     time.January,
+			"pebrero":   time.February,
+			"marso":     time.March,
+			"abril":     time.April,
+			"mayo":      time.May,
+			"hunyo":     time.June,
+			"hulyo":     time.July,
+			"agosto":    time.August,
+			"setyembre": time.September,
+			"oktubre":   time.October,
+			"nobyembre": time.November,
+			"disyembre": time.December,
+		},
+		Weekdays: map[string]time.Weekday{
+			"lunes":      time.Monday,
+			"martes":     time.Tuesday,
+			"miyerkules": time.Wednesday,
+			"huwebes":    time.Thursday,
+			"biyernes":   time.Friday,
+			"sabado":     time.Saturday,
+			"linggo":     time.Sunday,
+		},
+		// Words not shared with Spanish, used to tell the two apart
+		Indicators: []string{
+			"pebrero", "marso", "hunyo", "hulyo", "setyembre", "oktubre", "nobyembre", "disyembre",
+			"miyerkules", "huwebes", "biyernes", "linggo",
+			"kahapon", "ngayon", "bukas", "kamakalawa", "makalawa",
+		},
+		ListConjunctions: []string{"at"},
+		RelativeTerms: &RelativeTerms{
+			Yesterday:          "kahapon",
+			Today:              "ngayon",
+			Tomorrow:           "bukas",
+			Now:                "ngayon din",
+			DayAfterTomorrow:   []string{"sa makalawa", "makalawa"},
+			DayBeforeYesterday: []string{"kamakalawa"},
+			// "3 araw na ang nakalipas" (3 days ago)
+			Ago: []string{"na ang nakalipas", "ang nakalipas", "nakalipas", "na ang nakaraan"},
+			// "sa loob ng 3 araw" (within 3 days), "pagkalipas ng 3 araw" (after 3 days)
+			In:   []string{"sa loob ng", "pagkalipas ng", "makalipas ang"},
+			Next: []string{"sa susunod na", "susunod na", "darating na"},
+			Last: []string{"noong nakaraang", "nakaraang", "noong isang"},
+			This: []string{"ngayong", "itong"},
+			// "linggo" means both week and Sunday; unit patterns are tried first
+			Second:      []string{"segundo"},
+			Minute:      []string{"minuto"},
+			Hour:        []string{"oras"},
+			Day:         []string{"araw"},
+			Week:        []string{"linggo"},
+			Fortnight:   []string{"dalawang linggo"},
+			Month:       []string{"buwan"},
+			Quarter:     []string{"kwarter", "quarter"},
+			Year:        []string{"taon"},
+			Decade:      []string{"dekada"},
+			BusinessDay: []string{"araw ng trabaho"},
+			// Period boundaries
+			Beginning: []string{"simula"},
+			End:       []string{"katapusan", "dulo"},
+			Start:     []string{"simula"},
+			First:     []string{"unang"},
+			Since:     []string{"mula noong", "mula"},
+			Until:     []string{"hanggang"},
+			After:     []string{"pagkatapos ng"},
+			Before:    []string{"bago ang", "bago ng"},
+		},
+		TimeTerms: &TimeTerms{
+			Noon:     []string{"tanghali"},
+			Midnight: []string{"hatinggabi"},
+			AM:       []string{"am", "a.m.", "ng umaga"},
+			PM:       []string{"pm", "p.m.", "ng hapon", "ng gabi"},
+			At:       []string{"ng alas", "alas"},
+			Around:   []string{"bandang"},
+		},
+	}
+}
@@ -0,0 +1,113 @@
+package translations_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/coredds/godateparser"
+)
+
+// Tests for Filipino/Tagalog language support (tl)
+
+func TestTagalog_Months(t *testing.T) {
+	settings := &godateparser.Settings{Languages: []string{"tl"}}
+
+	tests := []struct {
+		input     string
+		wantMonth time.Month
+		wantDay   int
+		wantYear  int
+	}{
+		{"Enero 1, 2025", time.January, 1, 2025},
+		{"Disyembre 25, 2024", time.December, 25, 2024},
+		{"15 Hunyo 2024", time.June, 15, 2024},
+		{"Setyembre 10 2024", time.September, 10, 2024},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Month() != tt.wantMonth || result.Day() != tt.wantDay || result.Year() != tt.wantYear {
+				t.Errorf("godateparser.ParseDate(%q) = %v-%v-%v, want %v-%v-%v",
+					tt.input, result.Year(), result.Month(), result.Day(),
+					tt.wantYear, tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
+
+func TestTagalog_Weekdays(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC) // Tuesday
+	settings := &godateparser.Settings{
+		Languages:    []string{"tl"},
+		RelativeBase: base,
+	}
+
+	tests := []struct {
+		input       string
+		wantWeekday time.Weekday
+	}{
+		{"Lunes", time.Monday},
+		{"martes", time.Tuesday},
+		{"miyerkules", time.Wednesday},
+		{"huwebes", time.Thursday},
+		{"biyernes", time.Friday},
+		{"sabado", time.Saturday},
+		{"linggo", time.Sunday},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Weekday() != tt.wantWeekday {
+				t.Errorf("godateparser.ParseDate(%q) weekday = %v, want %v",
+					tt.input, result.Weekday(), tt.wantWeekday)
+			}
+		})
+	}
+}
+
+func TestTagalog_Relative(t *testing.T) {
+	base := time.Date(2024, 10, 15, 12, 0, 0, 0, time.UTC)
+	settings := &godateparser.Settings{
+		Languages:    []string{"tl"},
+		RelativeBase: base,
+	}
+
+	tests := []struct {
+		input     string
+		wantMonth time.Month
+		wantDay   int
+	}{
+		{"kahapon", time.October, 14},
+		{"ngayon", time.October, 15},
+		{"bukas", time.October, 16},
+		{"sa makalawa", time.October, 17},
+		{"kamakalawa", time.October, 13},
+		{"3 araw na ang nakalipas", time.October, 12},
+		{"sa loob ng 3 araw", time.October, 18},
+		{"pagkalipas ng 2 linggo", time.October, 28},
+		// "linggo" after a modifier is the week, not Sunday
+		{"susunod na linggo", time.October, 21},
+		{"nakaraang buwan", time.September, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result, err := godateparser.ParseDate(tt.input, settings)
+			if err != nil {
+				t.Fatalf("godateparser.ParseDate() error = %v", err)
+			}
+			if result.Month() != tt.wantMonth || result.Day() != tt.wantDay {
+				t.Errorf("godateparser.ParseDate(%q) = %v %v, want %v %v",
+					tt.input, result.Month(), result.Day(), tt.wantMonth, tt.wantDay)
+			}
+		})
+	}
+}
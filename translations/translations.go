@@ -3,8 +3,11 @@ package translations
 
 import (
 	"regexp"
+	"sort"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 )
 
 // Language represents a supported language with its translation data.
@@ -13,6 +16,17 @@ type Language struct {
 	Name             string
 	Months           map[string]time.Month
 	Weekdays         map[string]time.Weekday
+	OrdinalSuffixes  []string            // Suffixes written after a day number, e.g. "st", "er", "º"
+	DayArticles      []string            // Words before a day of the month written alone, e.g. "the" in "the 15th", "le" in "le 15"
+	YearSuffix       string              // Written after the year of a full date, e.g. "." in "15. prosinca 2024."
+	ListConjunctions []string            // Words joining the last item of a list, e.g. "and" in "Dec 1, 2 and 3"
+	DecimalSeparator string              // Decimal separator used in numbers: "." or ","
+	DefaultDateOrder string              // Preferred numeric date order: "MDY", "DMY" or "YMD"
+	Numerals         map[rune]int        // Native numeral characters: digits (三=3) and multipliers (十=10, 百=100)
+	NumberWords      map[string]int      // Spelled-out numbers for spoken times: "ten" = 10 in "ten to six"
+	UnitFirst        bool                // Units precede amounts and modifiers: "siku 3 zilizopita", "wiki ijayo"
+	Indicators       []string            // Words distinctive of the language; whole-word matches weigh most in DetectLanguage
+	Script           *unicode.RangeTable // Writing system used only by this language, e.g. unicode.Bengali
 	RelativeTerms    *RelativeTerms
 	TimeTerms        *TimeTerms
 	RelativePatterns []*LocalizedPattern
@@ -26,6 +40,15 @@ type RelativeTerms struct {
 	Tomorrow  string
 	Now       string
 
+	// Other spellings of the simple terms: unaccented, in kana or formal
+	YesterdayVariants []string // "きのう", "さくじつ"
+	TodayVariants     []string // "aujourd’hui", "本日"
+	TomorrowVariants  []string // "manana", "あした"
+
+	// Two days away, as a single word or a phrase
+	DayAfterTomorrow   []string // "the day after tomorrow", "übermorgen"
+	DayBeforeYesterday []string // "the day before yesterday", "vorgestern"
+
 	// Directional terms (with variations for gender/number)
 	Ago  []string // "ago", "hace"
 	In   []string // "in", "en"
@@ -33,7 +56,8 @@ type RelativeTerms struct {
 	Last []string // "last", "último", "última"
 	This []string // "this", "este", "esta"
 
-	// Period terms
+	// Period terms, listing every grammatical number and case form used
+	// after an amount ("день", "дня", "дней" for 1, 2-4 and 5+ days)
 	Second    []string // "second", "segundo"
 	Minute    []string // "minute", "minuto"
 	Hour      []string // "hour", "hora"
@@ -45,11 +69,228 @@ type RelativeTerms struct {
 	Year      []string // "year", "año"
 	Decade    []string // "decade", "década"
 
+	// Days that skip the weekend and holidays
+	BusinessDay []string // "business day", "día hábil", "Werktag"
+
 	// Period boundaries
 	Beginning []string // "beginning", "inicio", "comienzo"
 	End       []string // "end", "final", "fin"
 	Start     []string // "start", "inicio"
 	First     []string // "first", "primer", "primero"
+
+	// Open-ended range bounds, written before or after the date
+	Since []string // "since", "desde", "から"
+	Until []string // "until", "hasta", "まで"
+
+	// Phrases written before a date that make it a deadline or an
+	// approximate date
+	Deadline  []string // "by", "no later than", "a más tardar"
+	OnOrAbout []string // "on or about", "en o alrededor de"
+
+	// Duration offsets from a date or time, written between them
+	After  []string // "after", "después de": "45 minutes after 3pm"
+	Before []string // "before", "antes de": "2 days before December 31"
+
+	// Countdowns to a date, written between the duration and the date
+	Countdown []string // "until", "till", "para": "10 days until December 25"
+
+	// Vague amounts written in place of a number ("a couple of days ago")
+	Couple []string // "a couple of", "un par de": two
+	Few    []string // "a few", "several", "unos": Settings.FewAmount
+
+	// Windows of N units ending or starting now ("over the last 30 days"):
+	// modifiers accepted besides Next and Last, and the words that may lead
+	// the window
+	WindowNext []string // "coming", "próximos", "nächsten"
+	WindowLast []string // "past", "últimos", "letzten"
+	WindowLead []string // "in the", "over the", "en los", "in den"
+
+	// Recurring schedules ("every other Tuesday"): the word leading a
+	// schedule, words between it and a weekday that make every second
+	// week, and words for a schedule every two weeks
+	Every     []string // "every", "cada", "jeden"
+	Alternate []string // "other", "dos", "zweiten"
+	Biweekly  []string // "biweekly", "quincenal", "zweiwöchentlich"
+}
+
+// UnitForms pairs a canonical time unit with its localized forms.
+type UnitForms struct {
+	Unit  string   // Canonical English unit: "second", "day", "businessday", ...
+	Forms []string // Every localized form of the unit
+}
+
+// Units returns the language's time unit table, in the order units are
+// matched when normalizing.
+func (rt *RelativeTerms) Units() []UnitForms {
+	return []UnitForms{
+		{"second", rt.Second},
+		{"minute", rt.Minute},
+		{"hour", rt.Hour},
+		{"day", rt.Day},
+		{"week", rt.Week},
+		{"fortnight", rt.Fortnight},
+		{"month", rt.Month},
+		{"quarter", rt.Quarter},
+		{"year", rt.Year},
+		{"decade", rt.Decade},
+		{"businessday", rt.BusinessDay},
+	}
+}
+
+// Tokens returns the words the language recognizes, grouped as "months",
+// "weekdays", "relative" and "units", each sorted and free of duplicates.
+// It is meant for introspection: building autocomplete, or checking that a
+// new language covers every vocabulary.
+func (l *Language) Tokens() map[string][]string {
+	tokens := map[string][]string{
+		"months":   sortedKeys(l.Months),
+		"weekdays": sortedKeys(l.Weekdays),
+	}
+
+	var relative, units []string
+	if rt := l.RelativeTerms; rt != nil {
+		relative = append(relative, rt.Yesterday, rt.Today, rt.Tomorrow, rt.Now)
+		for _, terms := range [][]string{
+			rt.YesterdayVariants, rt.TodayVariants, rt.TomorrowVariants,
+			rt.DayAfterTomorrow, rt.DayBeforeYesterday,
+			rt.Ago, rt.In, rt.Next, rt.Last, rt.This,
+			rt.Beginning, rt.End, rt.Start, rt.First,
+			rt.Since, rt.Until, rt.After, rt.Before,
+		} {
+			relative = append(relative, terms...)
+		}
+		for _, unit := range rt.Units() {
+			units = append(units, unit.Forms...)
+		}
+	}
+	tokens["relative"] = uniqueSorted(relative)
+	tokens["units"] = uniqueSorted(units)
+	return tokens
+}
+
+// sortedKeys returns the keys of m in sorted order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// LanguageCoverage reports which vocabularies a language populates, to spot
+// incomplete translations. Missing names each gap, such as "month March",
+// "abbreviation May", "relative tomorrow", "unit fortnight" or
+// "number words".
+type LanguageCoverage struct {
+	Months        bool // All twelve months have a name
+	Abbreviations bool // Every month with a name over four letters also has a shorter one, such as "Dec"
+	Weekdays      bool // All seven weekdays have a name
+	Relatives     bool // Yesterday, today, tomorrow, and ago/in/next/last terms
+	Units         bool // Every time unit, second through business day, has a form
+	NumberWords   bool // Spelled-out numbers for spoken times
+	Missing       []string
+}
+
+// Coverage reports which of the language's vocabularies are populated.
+func (l *Language) Coverage() LanguageCoverage {
+	var missing []string
+
+	// The longest and shortest name of each month, in runes
+	longest := make(map[time.Month]int)
+	shortest := make(map[time.Month]int)
+	for name, month := range l.Months {
+		n := utf8.RuneCountInString(name)
+		if n > longest[month] {
+			longest[month] = n
+		}
+		if s, ok := shortest[month]; !ok || n < s {
+			shortest[month] = n
+		}
+	}
+	months, abbreviations := true, true
+	for month := time.January; month <= time.December; month++ {
+		if _, ok := longest[month]; !ok {
+			months = false
+			missing = append(missing, "month "+month.String())
+		}
+		// Names of four letters or fewer ("May", "juin") need no abbreviation
+		if longest[month] > 4 && shortest[month] >= longest[month] {
+			abbreviations = false
+			missing = append(missing, "abbreviation "+month.String())
+		}
+	}
+
+	seen := make(map[time.Weekday]bool)
+	for _, weekday := range l.Weekdays {
+		seen[weekday] = true
+	}
+	weekdays := true
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if !seen[day] {
+			weekdays = false
+			missing = append(missing, "weekday "+day.String())
+		}
+	}
+
+	relatives, units := true, true
+	if rt := l.RelativeTerms; rt != nil {
+		for _, term := range []struct {
+			name  string
+			empty bool
+		}{
+			{"yesterday", rt.Yesterday == ""},
+			{"today", rt.Today == ""},
+			{"tomorrow", rt.Tomorrow == ""},
+			{"ago", len(rt.Ago) == 0},
+			{"in", len(rt.In) == 0},
+			{"next", len(rt.Next) == 0},
+			{"last", len(rt.Last) == 0},
+		} {
+			if term.empty {
+				relatives = false
+				missing = append(missing, "relative "+term.name)
+			}
+		}
+		for _, unit := range rt.Units() {
+			if len(unit.Forms) == 0 {
+				units = false
+				missing = append(missing, "unit "+unit.Unit)
+			}
+		}
+	} else {
+		relatives, units = false, false
+		missing = append(missing, "relative terms")
+	}
+
+	numberWords := len(l.NumberWords) > 0
+	if !numberWords {
+		missing = append(missing, "number words")
+	}
+
+	return LanguageCoverage{
+		Months:        months,
+		Abbreviations: abbreviations,
+		Weekdays:      weekdays,
+		Relatives:     relatives,
+		Units:         units,
+		NumberWords:   numberWords,
+		Missing:       missing,
+	}
+}
+
+// uniqueSorted returns the non-empty words in sorted order, without duplicates.
+func uniqueSorted(words []string) []string {
+	seen := make(map[string]bool, len(words))
+	result := make([]string, 0, len(words))
+	for _, word := range words {
+		if word != "" && !seen[word] {
+			seen[word] = true
+			result = append(result, word)
+		}
+	}
+	sort.Strings(result)
+	return result
 }
 
 // TimeTerms contains localized time-related keywords.
@@ -63,6 +304,22 @@ type TimeTerms struct {
 	OClock   []string // "o'clock", "en punto"
 	AM       []string // "am", "de la mañana"
 	PM       []string // "pm", "de la tarde", "de la noche"
+	At       []string // "at", "a las": joins a day and a time ("tomorrow at 3pm")
+	// HalfToNext makes Half + hour the half hour before that hour, as in
+	// German "halb zehn" (9:30); otherwise it is the half hour after, as in
+	// British "half ten" (10:30)
+	HalfToNext bool
+	// Qualifiers marking a time as approximate, written before ("around 3pm",
+	// "vers midi") or after it ("noon-ish", "三点左右")
+	Around      []string
+	AroundAfter []string
+	// Markers stressing that a time is exact, written before ("exactly
+	// 3pm", "genau 15 Uhr") or after it ("3pm sharp", "midi pile")
+	Exact      []string
+	ExactAfter []string
+	// Named parts of the day and the time each stands for, as "HH:MM":
+	// "teatime": "16:00", "dusk": "18:00"
+	DayParts map[string]string
 }
 
 // LocalizedPattern represents a language-specific regex pattern.
@@ -76,6 +333,7 @@ type LocalizedPattern struct {
 // Registry holds all registered language translations.
 type Registry struct {
 	languages  map[string]*Language
+	order      []string // Codes in registration order, for deterministic detection ties
 	defaultVal string
 }
 
@@ -94,6 +352,9 @@ func NewRegistry() *Registry {
 
 // Register adds a language to the registry.
 func (r *Registry) Register(lang *Language) {
+	if _, ok := r.languages[lang.Code]; !ok {
+		r.order = append(r.order, lang.Code)
+	}
 	r.languages[lang.Code] = lang
 }
 
@@ -121,7 +382,31 @@ func (r *Registry) GetMultiple(codes []string) []*Language {
 
 // DetectLanguage attempts to detect the language of the input string.
 func (r *Registry) DetectLanguage(input string) string {
+	scores := r.DetectLanguageScores(input)
+
+	// Return language with highest score; ties go to the earliest registered
+	// language, as some words are shared ("mei" is May in Dutch and Swahili)
+	maxScore := 0
+	detectedLang := r.defaultVal
+	for _, code := range r.order {
+		if score := scores[code]; score > maxScore {
+			maxScore = score
+			detectedLang = code
+		}
+	}
+
+	return detectedLang
+}
+
+// DetectLanguageScores returns the evidence for each language in the input
+// string, keyed by language code; languages without any are left out. A
+// month or weekday name counts 10, a relative term 5, and a distinctive
+// word or the language's own script 20.
+func (r *Registry) DetectLanguageScores(input string) map[string]int {
 	input = strings.ToLower(input)
+	words := strings.FieldsFunc(input, func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
 
 	// Check for language-specific indicators
 	scores := make(map[string]int)
@@ -161,22 +446,36 @@ func (r *Registry) DetectLanguage(input string) string {
 			}
 		}
 
+		// Check the language's own script
+		if lang.Script != nil && strings.IndexFunc(input, func(r rune) bool { return unicode.Is(lang.Script, r) }) >= 0 {
+			score += 20
+		}
+
+		// Check distinctive words, which may be substrings of other languages' words
+		for _, word := range words {
+			for _, indicator := range lang.Indicators {
+				if word == indicator {
+					score += 20
+				}
+			}
+		}
+
 		if score > 0 {
 			scores[code] = score
 		}
 	}
 
-	// Return language with highest score
-	maxScore := 0
-	detectedLang := r.defaultVal
-	for code, score := range scores {
-		if score > maxScore {
-			maxScore = score
-			detectedLang = code
-		}
-	}
+	return scores
+}
 
-	return detectedLang
+// CoverageReport returns the Coverage of every registered language, keyed
+// by language code.
+func (r *Registry) CoverageReport() map[string]LanguageCoverage {
+	report := make(map[string]LanguageCoverage, len(r.languages))
+	for code, lang := range r.languages {
+		report[code] = lang.Coverage()
+	}
+	return report
 }
 
 // SupportedLanguages returns a list of all supported language codes.
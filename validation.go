@@ -33,6 +33,8 @@ func validateDateComponents(year, month, day int) error {
 			Month:  month,
 			Day:    day,
 			Reason: "month must be between 1 and 12",
+			field:  "month",
+			value:  month,
 		}
 	}
 
@@ -43,6 +45,8 @@ func validateDateComponents(year, month, day int) error {
 			Month:  month,
 			Day:    day,
 			Reason: "day must be between 1 and 31",
+			field:  "day",
+			value:  day,
 		}
 	}
 
@@ -55,6 +59,8 @@ func validateDateComponents(year, month, day int) error {
 			Month:  month,
 			Day:    day,
 			Reason: "invalid day for the given month/year",
+			field:  "day",
+			value:  day,
 		}
 	}
 
@@ -75,6 +81,8 @@ func validateDateTime(year, month, day, hour, minute, second int) error {
 			Month:  month,
 			Day:    day,
 			Reason: "hour must be between 0 and 23",
+			field:  "hour",
+			value:  hour,
 		}
 	}
 
@@ -84,6 +92,8 @@ func validateDateTime(year, month, day, hour, minute, second int) error {
 			Month:  month,
 			Day:    day,
 			Reason: "minute must be between 0 and 59",
+			field:  "minute",
+			value:  minute,
 		}
 	}
 
@@ -93,6 +103,8 @@ func validateDateTime(year, month, day, hour, minute, second int) error {
 			Month:  month,
 			Day:    day,
 			Reason: "second must be between 0 and 59",
+			field:  "second",
+			value:  second,
 		}
 	}
 
@@ -0,0 +1,107 @@
+package godateparser
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// warningNumericPattern matches a day-month pair with an optional year:
+	// "3/4", "03-04-24", "3.4.2024"; year-first dates are not matched
+	warningNumericPattern = regexp.MustCompile(`(?:^|[^\d/.-])(\d{1,2})([/.-])(\d{1,2})(?:([/.-])(\d{2}|\d{4}))?(?:$|[^\d/.-])`)
+
+	// warningYearPattern matches a four-digit year or a marked two-digit
+	// one ("'24", "FY24")
+	warningYearPattern = regexp.MustCompile(`(?i)\d{4}|['’]\d{2}\b|\bFY\s?\d{2}\b`)
+
+	// warningWordPattern matches a word
+	warningWordPattern = regexp.MustCompile(`\p{L}+`)
+)
+
+// ParseInfo is the result of ParseDateWithInfo.
+type ParseInfo struct {
+	// Date is the date ParseDate returns for the input.
+	Date time.Time
+
+	// Warnings lists the assumptions made while reading the input, when
+	// Settings.CollectWarnings is set:
+	//   - "assumed DateOrder=DMY": a numeric date such as "03/04/2024"
+	//     was read with the configured DateOrder, as either order is valid
+	//   - "two-digit year 24 read as 2024"
+	//   - "year inferred from base": the input has no year, so it was
+	//     taken from RelativeBase and PreferDatesFrom
+	//   - "ambiguous month word \"may\"": the input is nothing but a month
+	//     name that is also a common word
+	Warnings []string
+}
+
+// ParseDateWithInfo is like ParseDate but also reports, with
+// Settings.CollectWarnings, the assumptions behind the result, such as the
+// date order used for an ambiguous numeric date or a year taken from
+// RelativeBase. Warnings never turn into errors.
+// If opts is nil, DefaultSettings() is used.
+func ParseDateWithInfo(input string, opts *Settings) (ParseInfo, error) {
+	date, err := ParseDate(input, opts)
+	if err != nil {
+		return ParseInfo{}, err
+	}
+
+	info := ParseInfo{Date: date}
+	if opts != nil && opts.CollectWarnings {
+		info.Warnings = collectWarnings(input, opts)
+	}
+	return info, nil
+}
+
+// collectWarnings returns the warnings for input, which ParseDate accepted.
+func collectWarnings(input string, opts *Settings) []string {
+	settings := normalizeSettings(opts)
+	text := normalizeCharacters(strings.TrimSpace(input), settings)
+
+	var warnings []string
+	add := func(warning string) {
+		for _, w := range warnings {
+			if w == warning {
+				return
+			}
+		}
+		warnings = append(warnings, warning)
+	}
+
+	for _, m := range warningNumericPattern.FindAllStringSubmatch(text, -1) {
+		// Without a year only "3/4" is a date; "3.5" is a number
+		if m[5] == "" && m[2] != "/" || m[5] != "" && m[2] != m[4] {
+			continue
+		}
+		num1, _ := strconv.Atoi(m[1])
+		num2, _ := strconv.Atoi(m[3])
+		if isAmbiguousDate(num1, num2, 0) {
+			add("assumed DateOrder=" + settings.DateOrder)
+		}
+		if len(m[5]) == 2 {
+			yy, _ := strconv.Atoi(m[5])
+			add(fmt.Sprintf("two-digit year %s read as %d", m[5], parseTwoDigitYear(yy)))
+		}
+	}
+
+	// Year-less month and day, as read by the incomplete and ordinal parsers
+	if !warningYearPattern.MatchString(text) {
+		for _, entry := range Trace(input, opts) {
+			if entry.Selected && (entry.Parser == "incomplete" || entry.Parser == "ordinal") {
+				add("year inferred from base")
+			}
+		}
+	}
+
+	// A bare "May": nothing but the word makes it a month
+	if words := warningWordPattern.FindAllString(text, -1); len(words) == 1 && !strings.ContainsAny(text, "0123456789") {
+		if word := strings.ToLower(words[0]); ambiguousMonths[word] {
+			add(fmt.Sprintf("ambiguous month word %q", word))
+		}
+	}
+
+	return warnings
+}